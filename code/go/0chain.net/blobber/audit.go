@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/viper"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/writemarker"
+	"0chain.net/core/logging"
+)
+
+const auditWriteMarkersCmd = "audit-writemarkers"
+
+// auditWriteMarkers audits the write marker chains in the DB of the
+// configuration, without joining the network, and writes the report as
+// JSON. It exits with 1 if the report has breaks and 2 if the audit can't
+// be done.
+//
+//	blobber audit-writemarkers [--allocation id] [--output file]
+func auditWriteMarkers(args []string) {
+	fs := flag.NewFlagSet(auditWriteMarkersCmd, flag.ExitOnError)
+	allocationID := fs.String("allocation", "", "allocation to audit, all if empty")
+	output := fs.String("output", "", "report file, stdout if empty")
+	logDir := fs.String("log_dir", os.TempDir(), "log_dir")
+	fs.Parse(args) //nolint:errcheck // exits on error
+
+	fail := func(err error) {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", auditWriteMarkersCmd, err)
+		os.Exit(2)
+	}
+
+	config.SetupDefaultConfig()
+	config.SetupConfig()
+	logging.InitLogging("production", *logDir, "0chainBlobberAudit.log")
+	config.Configuration.SignatureScheme = viper.GetString("server_chain.signature_scheme")
+	setupWorkerConfig()
+
+	if err := datastore.GetStore().Open(); err != nil {
+		fail(err)
+	}
+
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	db := datastore.GetStore().GetTransaction(ctx)
+	report, err := writemarker.Audit(ctx, *allocationID)
+	db.Rollback()
+	datastore.GetStore().Close()
+	if err != nil {
+		fail(err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			fail(err)
+		}
+		defer f.Close()
+		w = f
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err = enc.Encode(report); err != nil {
+		fail(err)
+	}
+
+	if !report.OK {
+		os.Exit(1)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"0chain.net/blobbercore/datastore"
 	"0chain.net/blobbercore/filestore"
 	"0chain.net/blobbercore/handler"
+	"0chain.net/blobbercore/outbox"
 	"0chain.net/blobbercore/readmarker"
 	"0chain.net/blobbercore/writemarker"
 	"0chain.net/core/build"
@@ -33,6 +34,7 @@ import (
 	"0chain.net/core/node"
 	"0chain.net/core/logging"
 	. "0chain.net/core/logging"
+	"0chain.net/core/metrics"
 )
 
 var startTime time.Time
@@ -41,6 +43,9 @@ var filesDir *string
 var metadataDB *string
 
 func initHandlers(r *mux.Router) {
+	r.Use(metrics.Middleware)
+	// the metrics have the allocations, for the admin readers only
+	r.HandleFunc("/metrics", handler.WithAdminAuth(handler.AdminReader, metrics.Handler().ServeHTTP))
 	r.HandleFunc("/", func (w http.ResponseWriter, r *http.Request) {
 		mc := chain.GetServerChain()
 
@@ -82,13 +87,39 @@ func setupWorkerConfig() {
 
 	config.Configuration.WMRedeemFreq = viper.GetInt64("writemarker_redeem.frequency")
 	config.Configuration.WMRedeemNumWorkers = viper.GetInt("writemarker_redeem.num_workers")
+	config.Configuration.WMRedeemMaxRetries = viper.GetInt("writemarker_redeem.max_retries")
+	config.Configuration.WMRedeemRetryDelay = viper.GetInt64("writemarker_redeem.retry_delay")
+	config.Configuration.WMRedeemMaxRetryDelay = viper.GetInt64("writemarker_redeem.max_retry_delay")
 
 	config.Configuration.RMRedeemFreq = viper.GetInt64("readmarker_redeem.frequency")
 	config.Configuration.RMRedeemNumWorkers = viper.GetInt("readmarker_redeem.num_workers")
+	config.Configuration.RMRedeemBatch = viper.GetBool("readmarker_redeem.batch")
+	config.Configuration.RMRedeemBatchSize = viper.GetInt("readmarker_redeem.batch_size")
+	config.Configuration.RMRedeemMinValue = int64(viper.GetFloat64("readmarker_redeem.min_value") * 1e10)
+	config.Configuration.RMRedeemMaxAge = viper.GetInt64("readmarker_redeem.max_age")
+	config.Configuration.RMRedeemMaxPendingBlocks = viper.GetInt64("readmarker_redeem.max_pending_blocks")
 
 	config.Configuration.ChallengeResolveFreq = viper.GetInt64("challenge_response.frequency")
 	config.Configuration.ChallengeResolveNumWorkers = viper.GetInt("challenge_response.num_workers")
 	config.Configuration.ChallengeMaxRetires = viper.GetInt("challenge_response.max_retries")
+	config.Configuration.ChallengeValidatorTimeout = viper.GetInt64("challenge_response.validator_timeout")
+	config.Configuration.ChallengeValidatorRetryDelay = viper.GetInt64("challenge_response.validator_retry_delay")
+	config.Configuration.ChallengeStrictOrder = viper.GetBool("challenge_response.strict_order")
+	config.Configuration.ChallengeValidatorBatchSize = viper.GetInt("challenge_response.validator_batch_size")
+	config.Configuration.ChallengeValidatorGRPC = viper.GetBool("challenge_response.validator_grpc")
+
+	config.Configuration.AdminOperatorKeys = viper.GetStringSlice("admin.operator_keys")
+	config.Configuration.AdminReaderKeys = viper.GetStringSlice("admin.reader_keys")
+	config.Configuration.AdminTokenFile = viper.GetString("admin.token_file")
+
+	config.Configuration.SelfAuditFreq = viper.GetInt64("self_audit.frequency")
+	config.Configuration.SelfAuditNumChallenges = viper.GetInt("self_audit.num_challenges")
+
+	config.Configuration.TxnConfirmFreq = viper.GetInt64("txn_confirmation.frequency")
+	config.Configuration.TxnConfirmNumWorkers = viper.GetInt("txn_confirmation.num_workers")
+	config.Configuration.TxnConfirmInitialDelay = viper.GetInt64("txn_confirmation.initial_delay")
+	config.Configuration.TxnConfirmMaxBackoff = viper.GetInt64("txn_confirmation.max_backoff")
+	config.Configuration.TxnConfirmTimeout = viper.GetInt64("txn_confirmation.timeout")
 
 	config.Configuration.ColdStorageMinimumFileSize = viper.GetInt64("cold_storage.min_file_size")
 	config.Configuration.ColdStorageTimeLimitInHours = viper.GetInt64("cold_storage.file_time_limit_in_hours")
@@ -179,6 +210,7 @@ func setupWorkers() {
 	challenge.SetupWorkers(root)
 	readmarker.SetupWorkers(root)
 	writemarker.SetupWorkers(root)
+	outbox.SetupWorkers(root)
 	allocation.StartUpdateWorker(root,
 		config.Configuration.UpdateAllocationsInterval)
 }
@@ -213,6 +245,7 @@ func setupOnChain() {
 				panic(err)
 			}
 		} else {
+			handler.SetRegistered()
 			break
 		}
 
@@ -322,6 +355,15 @@ func setup(logDir string) error {
 // }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == auditWriteMarkersCmd {
+		auditWriteMarkers(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == redriveWriteMarkersCmd {
+		redriveWriteMarkers(os.Args[2:])
+		return
+	}
+
 	deploymentMode := flag.Int("deployment_mode", 2, "deployment_mode")
 	keysFile := flag.String("keys_file", "", "keys_file")
 	minioFile := flag.String("minio_file", "", "minio_file")
@@ -344,6 +386,7 @@ func main() {
 	} else {
 		logging.InitLogging("production", *logDir, "0chainBlobber.log")
 	}
+	logging.InitAuditLogging(*logDir, "0chainBlobberAudit.log")
 	config.Configuration.ChainID = viper.GetString("server_chain.id")
 	config.Configuration.SignatureScheme = viper.GetString("server_chain.signature_scheme")
 	setupWorkerConfig()
@@ -457,6 +500,7 @@ func main() {
 
 	grpcServer := handler.NewServerWithMiddlewares(rl)
 	handler.RegisterGRPCServices(r, grpcServer)
+	go handler.HealthCheckWorker(common.GetRootContext())
 
 	rHandler := handlers.CORS(originsOk, headersOk, methodsOk)(r)
 	if config.Development() {
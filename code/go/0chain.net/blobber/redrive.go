@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/writemarker"
+	"0chain.net/core/logging"
+)
+
+const redriveWriteMarkersCmd = "redrive-writemarkers"
+
+// redriveWriteMarkers re-drives the failed and dead-letter write markers of
+// an allocation in the DB of the configuration. The redeem worker of the
+// running blobber replays them in chain order. It exits with 1 if the
+// allocation has no stuck markers and 2 if the re-drive can't be done.
+//
+//	blobber redrive-writemarkers --allocation id
+func redriveWriteMarkers(args []string) {
+	fs := flag.NewFlagSet(redriveWriteMarkersCmd, flag.ExitOnError)
+	allocationID := fs.String("allocation", "", "allocation to re-drive")
+	logDir := fs.String("log_dir", os.TempDir(), "log_dir")
+	fs.Parse(args) //nolint:errcheck // exits on error
+
+	fail := func(err error) {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", redriveWriteMarkersCmd, err)
+		os.Exit(2)
+	}
+	if *allocationID == "" {
+		fail(errors.New("missing --allocation"))
+	}
+
+	config.SetupDefaultConfig()
+	config.SetupConfig()
+	logging.InitLogging("production", *logDir, "0chainBlobberRedrive.log")
+
+	if err := datastore.GetStore().Open(); err != nil {
+		fail(err)
+	}
+
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+	db := datastore.GetStore().GetTransaction(ctx)
+	count, err := writemarker.Redrive(ctx, *allocationID)
+	if err == nil {
+		err = db.Commit().Error
+	} else {
+		db.Rollback()
+	}
+	datastore.GetStore().Close()
+	if err != nil {
+		fail(err)
+	}
+
+	fmt.Printf("re-drove %d write markers of %s\n", count, *allocationID)
+	if count == 0 {
+		os.Exit(1)
+	}
+}
@@ -3,6 +3,7 @@ package allocation
 import (
 	"context"
 	"errors"
+	"path/filepath"
 
 	"0chain.net/blobbercore/datastore"
 	"0chain.net/blobbercore/reference"
@@ -142,6 +143,28 @@ func (cc *AllocationChangeCollector) ComputeProperties() {
 	}
 }
 
+// GetAffectedPaths returns the paths written by the changes of the connection.
+func (cc *AllocationChangeCollector) GetAffectedPaths() []string {
+	paths := make([]string, 0, len(cc.AllocationChanges))
+	for _, change := range cc.AllocationChanges {
+		switch c := change.(type) {
+		case *NewFileChange:
+			paths = append(paths, c.Path)
+		case *UpdateFileChange:
+			paths = append(paths, c.Path)
+		case *DeleteFileChange:
+			paths = append(paths, c.Path)
+		case *RenameFileChange:
+			paths = append(paths, c.Path, filepath.Join(filepath.Dir(c.Path), c.NewName))
+		case *CopyFileChange:
+			paths = append(paths, c.DestPath)
+		case *AttributesChange:
+			paths = append(paths, c.Path)
+		}
+	}
+	return paths
+}
+
 func (cc *AllocationChangeCollector) ApplyChanges(ctx context.Context, allocationRoot string) error {
 	for idx, change := range cc.Changes {
 		changeProcessor := cc.AllocationChanges[idx]
@@ -0,0 +1,23 @@
+package allocation
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// usedBytes is the size of the allocations open on the blobber, their series
+// deleted once they are finalized or expired.
+var usedBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "blobber_allocation_used_bytes",
+	Help: "Bytes of the open allocations stored by the blobber, as of their latest commit or request.",
+}, []string{"allocation"})
+
+// RecordUsedBytes sets the size of the allocation in the metrics.
+func RecordUsedBytes(allocationID string, size int64) {
+	usedBytes.WithLabelValues(allocationID).Set(float64(size))
+}
+
+// forgetUsedBytes deletes the series of the allocation from the metrics.
+func forgetUsedBytes(allocationID string) {
+	usedBytes.DeleteLabelValues(allocationID)
+}
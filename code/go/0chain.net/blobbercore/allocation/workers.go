@@ -161,6 +161,7 @@ func updateAllocation(ctx context.Context, a *Allocation) {
 
 	// send finalize allocation transaction
 	if shouldFinalize(sa) {
+		forgetUsedBytes(a.ID)
 		sendFinalizeAllocation(a)
 		return
 	}
@@ -282,6 +283,8 @@ func sendFinalizeAllocation(a *Allocation) {
 
 func cleanupAllocation(ctx context.Context, a *Allocation) {
 
+	forgetUsedBytes(a.ID)
+
 	var err error
 	if err = deleteInFakeConnection(ctx, a); err != nil {
 		Logger.Error("cleaning finalized allocation", zap.Error(err))
@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package blobbergrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// BlobberAdminClient is the client API for BlobberAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BlobberAdminClient interface {
+	// GetStats returns the stats of /_stats
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
+	// GetConfig returns the configuration of /_config
+	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
+	// CleanupDisk deletes the files of no reference, as /_cleanupdisk does
+	CleanupDisk(ctx context.Context, in *CleanupDiskRequest, opts ...grpc.CallOption) (*CleanupDiskResponse, error)
+}
+
+type blobberAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBlobberAdminClient(cc grpc.ClientConnInterface) BlobberAdminClient {
+	return &blobberAdminClient{cc}
+}
+
+func (c *blobberAdminClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
+	out := new(GetStatsResponse)
+	err := c.cc.Invoke(ctx, "/blobber.service.v1.BlobberAdmin/GetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobberAdminClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
+	out := new(GetConfigResponse)
+	err := c.cc.Invoke(ctx, "/blobber.service.v1.BlobberAdmin/GetConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobberAdminClient) CleanupDisk(ctx context.Context, in *CleanupDiskRequest, opts ...grpc.CallOption) (*CleanupDiskResponse, error) {
+	out := new(CleanupDiskResponse)
+	err := c.cc.Invoke(ctx, "/blobber.service.v1.BlobberAdmin/CleanupDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlobberAdminServer is the server API for BlobberAdmin service.
+// All implementations must embed UnimplementedBlobberAdminServer
+// for forward compatibility
+type BlobberAdminServer interface {
+	// GetStats returns the stats of /_stats
+	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
+	// GetConfig returns the configuration of /_config
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	// CleanupDisk deletes the files of no reference, as /_cleanupdisk does
+	CleanupDisk(context.Context, *CleanupDiskRequest) (*CleanupDiskResponse, error)
+	mustEmbedUnimplementedBlobberAdminServer()
+}
+
+// UnimplementedBlobberAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedBlobberAdminServer struct {
+}
+
+func (UnimplementedBlobberAdminServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedBlobberAdminServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedBlobberAdminServer) CleanupDisk(context.Context, *CleanupDiskRequest) (*CleanupDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CleanupDisk not implemented")
+}
+func (UnimplementedBlobberAdminServer) mustEmbedUnimplementedBlobberAdminServer() {}
+
+// UnsafeBlobberAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BlobberAdminServer will
+// result in compilation errors.
+type UnsafeBlobberAdminServer interface {
+	mustEmbedUnimplementedBlobberAdminServer()
+}
+
+func RegisterBlobberAdminServer(s *grpc.Server, srv BlobberAdminServer) {
+	s.RegisterService(&_BlobberAdmin_serviceDesc, srv)
+}
+
+func _BlobberAdmin_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobberAdminServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blobber.service.v1.BlobberAdmin/GetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobberAdminServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobberAdmin_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobberAdminServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blobber.service.v1.BlobberAdmin/GetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobberAdminServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobberAdmin_CleanupDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanupDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobberAdminServer).CleanupDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blobber.service.v1.BlobberAdmin/CleanupDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobberAdminServer).CleanupDisk(ctx, req.(*CleanupDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BlobberAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "blobber.service.v1.BlobberAdmin",
+	HandlerType: (*BlobberAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStats",
+			Handler:    _BlobberAdmin_GetStats_Handler,
+		},
+		{
+			MethodName: "GetConfig",
+			Handler:    _BlobberAdmin_GetConfig_Handler,
+		},
+		{
+			MethodName: "CleanupDisk",
+			Handler:    _BlobberAdmin_CleanupDisk_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
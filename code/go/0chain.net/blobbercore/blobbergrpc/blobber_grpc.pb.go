@@ -24,6 +24,22 @@ type BlobberClient interface {
 	GetObjectPath(ctx context.Context, in *GetObjectPathRequest, opts ...grpc.CallOption) (*GetObjectPathResponse, error)
 	GetReferencePath(ctx context.Context, in *GetReferencePathRequest, opts ...grpc.CallOption) (*GetReferencePathResponse, error)
 	GetObjectTree(ctx context.Context, in *GetObjectTreeRequest, opts ...grpc.CallOption) (*GetObjectTreeResponse, error)
+	GetChallengeHistory(ctx context.Context, in *GetChallengeHistoryRequest, opts ...grpc.CallOption) (*GetChallengeHistoryResponse, error)
+	// UploadFile takes the meta of the upload first, then the chunks of the
+	// file and last the ones of the thumbnail, if the meta names one.
+	UploadFile(ctx context.Context, opts ...grpc.CallOption) (Blobber_UploadFileClient, error)
+	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error)
+	RenameObject(ctx context.Context, in *RenameObjectRequest, opts ...grpc.CallOption) (*RenameObjectResponse, error)
+	CopyObject(ctx context.Context, in *CopyObjectRequest, opts ...grpc.CallOption) (*CopyObjectResponse, error)
+	UpdateObjectAttributes(ctx context.Context, in *UpdateObjectAttributesRequest, opts ...grpc.CallOption) (*UpdateObjectAttributesResponse, error)
+	CommitConnection(ctx context.Context, in *CommitConnectionRequest, opts ...grpc.CallOption) (*CommitConnectionResponse, error)
+	AddCommitMetaTxn(ctx context.Context, in *AddCommitMetaTxnRequest, opts ...grpc.CallOption) (*AddCommitMetaTxnResponse, error)
+	AddCollaborator(ctx context.Context, in *AddCollaboratorRequest, opts ...grpc.CallOption) (*AddCollaboratorResponse, error)
+	RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*RemoveCollaboratorResponse, error)
+	// DownloadFile takes the range of blocks to download with the read marker
+	// of its first blocks, then the read markers of the following ones, the
+	// blocks each pays for sent once it's accepted.
+	DownloadFile(ctx context.Context, opts ...grpc.CallOption) (Blobber_DownloadFileClient, error)
 }
 
 type blobberClient struct {
@@ -97,6 +113,152 @@ func (c *blobberClient) GetObjectTree(ctx context.Context, in *GetObjectTreeRequ
 	return out, nil
 }
 
+func (c *blobberClient) GetChallengeHistory(ctx context.Context, in *GetChallengeHistoryRequest, opts ...grpc.CallOption) (*GetChallengeHistoryResponse, error) {
+	out := new(GetChallengeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/blobber.service.v1.Blobber/GetChallengeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobberClient) UploadFile(ctx context.Context, opts ...grpc.CallOption) (Blobber_UploadFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Blobber_serviceDesc.Streams[0], "/blobber.service.v1.Blobber/UploadFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blobberUploadFileClient{stream}
+	return x, nil
+}
+
+type Blobber_UploadFileClient interface {
+	Send(*UploadFileRequest) error
+	CloseAndRecv() (*UploadFileResponse, error)
+	grpc.ClientStream
+}
+
+type blobberUploadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *blobberUploadFileClient) Send(m *UploadFileRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *blobberUploadFileClient) CloseAndRecv() (*UploadFileResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blobberClient) DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error) {
+	out := new(DeleteFileResponse)
+	err := c.cc.Invoke(ctx, "/blobber.service.v1.Blobber/DeleteFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobberClient) RenameObject(ctx context.Context, in *RenameObjectRequest, opts ...grpc.CallOption) (*RenameObjectResponse, error) {
+	out := new(RenameObjectResponse)
+	err := c.cc.Invoke(ctx, "/blobber.service.v1.Blobber/RenameObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobberClient) CopyObject(ctx context.Context, in *CopyObjectRequest, opts ...grpc.CallOption) (*CopyObjectResponse, error) {
+	out := new(CopyObjectResponse)
+	err := c.cc.Invoke(ctx, "/blobber.service.v1.Blobber/CopyObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobberClient) UpdateObjectAttributes(ctx context.Context, in *UpdateObjectAttributesRequest, opts ...grpc.CallOption) (*UpdateObjectAttributesResponse, error) {
+	out := new(UpdateObjectAttributesResponse)
+	err := c.cc.Invoke(ctx, "/blobber.service.v1.Blobber/UpdateObjectAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobberClient) CommitConnection(ctx context.Context, in *CommitConnectionRequest, opts ...grpc.CallOption) (*CommitConnectionResponse, error) {
+	out := new(CommitConnectionResponse)
+	err := c.cc.Invoke(ctx, "/blobber.service.v1.Blobber/CommitConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobberClient) AddCommitMetaTxn(ctx context.Context, in *AddCommitMetaTxnRequest, opts ...grpc.CallOption) (*AddCommitMetaTxnResponse, error) {
+	out := new(AddCommitMetaTxnResponse)
+	err := c.cc.Invoke(ctx, "/blobber.service.v1.Blobber/AddCommitMetaTxn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobberClient) AddCollaborator(ctx context.Context, in *AddCollaboratorRequest, opts ...grpc.CallOption) (*AddCollaboratorResponse, error) {
+	out := new(AddCollaboratorResponse)
+	err := c.cc.Invoke(ctx, "/blobber.service.v1.Blobber/AddCollaborator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobberClient) RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*RemoveCollaboratorResponse, error) {
+	out := new(RemoveCollaboratorResponse)
+	err := c.cc.Invoke(ctx, "/blobber.service.v1.Blobber/RemoveCollaborator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobberClient) DownloadFile(ctx context.Context, opts ...grpc.CallOption) (Blobber_DownloadFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Blobber_serviceDesc.Streams[1], "/blobber.service.v1.Blobber/DownloadFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blobberDownloadFileClient{stream}
+	return x, nil
+}
+
+type Blobber_DownloadFileClient interface {
+	Send(*DownloadFileRequest) error
+	Recv() (*DownloadFileResponse, error)
+	grpc.ClientStream
+}
+
+type blobberDownloadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *blobberDownloadFileClient) Send(m *DownloadFileRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *blobberDownloadFileClient) Recv() (*DownloadFileResponse, error) {
+	m := new(DownloadFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // BlobberServer is the server API for Blobber service.
 // All implementations must embed UnimplementedBlobberServer
 // for forward compatibility
@@ -108,6 +270,22 @@ type BlobberServer interface {
 	GetObjectPath(context.Context, *GetObjectPathRequest) (*GetObjectPathResponse, error)
 	GetReferencePath(context.Context, *GetReferencePathRequest) (*GetReferencePathResponse, error)
 	GetObjectTree(context.Context, *GetObjectTreeRequest) (*GetObjectTreeResponse, error)
+	GetChallengeHistory(context.Context, *GetChallengeHistoryRequest) (*GetChallengeHistoryResponse, error)
+	// UploadFile takes the meta of the upload first, then the chunks of the
+	// file and last the ones of the thumbnail, if the meta names one.
+	UploadFile(Blobber_UploadFileServer) error
+	DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error)
+	RenameObject(context.Context, *RenameObjectRequest) (*RenameObjectResponse, error)
+	CopyObject(context.Context, *CopyObjectRequest) (*CopyObjectResponse, error)
+	UpdateObjectAttributes(context.Context, *UpdateObjectAttributesRequest) (*UpdateObjectAttributesResponse, error)
+	CommitConnection(context.Context, *CommitConnectionRequest) (*CommitConnectionResponse, error)
+	AddCommitMetaTxn(context.Context, *AddCommitMetaTxnRequest) (*AddCommitMetaTxnResponse, error)
+	AddCollaborator(context.Context, *AddCollaboratorRequest) (*AddCollaboratorResponse, error)
+	RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*RemoveCollaboratorResponse, error)
+	// DownloadFile takes the range of blocks to download with the read marker
+	// of its first blocks, then the read markers of the following ones, the
+	// blocks each pays for sent once it's accepted.
+	DownloadFile(Blobber_DownloadFileServer) error
 	mustEmbedUnimplementedBlobberServer()
 }
 
@@ -136,6 +314,39 @@ func (UnimplementedBlobberServer) GetReferencePath(context.Context, *GetReferenc
 func (UnimplementedBlobberServer) GetObjectTree(context.Context, *GetObjectTreeRequest) (*GetObjectTreeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetObjectTree not implemented")
 }
+func (UnimplementedBlobberServer) GetChallengeHistory(context.Context, *GetChallengeHistoryRequest) (*GetChallengeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChallengeHistory not implemented")
+}
+func (UnimplementedBlobberServer) UploadFile(Blobber_UploadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
+}
+func (UnimplementedBlobberServer) DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFile not implemented")
+}
+func (UnimplementedBlobberServer) RenameObject(context.Context, *RenameObjectRequest) (*RenameObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameObject not implemented")
+}
+func (UnimplementedBlobberServer) CopyObject(context.Context, *CopyObjectRequest) (*CopyObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyObject not implemented")
+}
+func (UnimplementedBlobberServer) UpdateObjectAttributes(context.Context, *UpdateObjectAttributesRequest) (*UpdateObjectAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateObjectAttributes not implemented")
+}
+func (UnimplementedBlobberServer) CommitConnection(context.Context, *CommitConnectionRequest) (*CommitConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitConnection not implemented")
+}
+func (UnimplementedBlobberServer) AddCommitMetaTxn(context.Context, *AddCommitMetaTxnRequest) (*AddCommitMetaTxnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCommitMetaTxn not implemented")
+}
+func (UnimplementedBlobberServer) AddCollaborator(context.Context, *AddCollaboratorRequest) (*AddCollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollaborator not implemented")
+}
+func (UnimplementedBlobberServer) RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*RemoveCollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollaborator not implemented")
+}
+func (UnimplementedBlobberServer) DownloadFile(Blobber_DownloadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
+}
 func (UnimplementedBlobberServer) mustEmbedUnimplementedBlobberServer() {}
 
 // UnsafeBlobberServer may be embedded to opt out of forward compatibility for this service.
@@ -275,6 +486,220 @@ func _Blobber_GetObjectTree_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blobber_GetChallengeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChallengeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobberServer).GetChallengeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blobber.service.v1.Blobber/GetChallengeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobberServer).GetChallengeHistory(ctx, req.(*GetChallengeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blobber_UploadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BlobberServer).UploadFile(&blobberUploadFileServer{stream})
+}
+
+type Blobber_UploadFileServer interface {
+	SendAndClose(*UploadFileResponse) error
+	Recv() (*UploadFileRequest, error)
+	grpc.ServerStream
+}
+
+type blobberUploadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *blobberUploadFileServer) SendAndClose(m *UploadFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *blobberUploadFileServer) Recv() (*UploadFileRequest, error) {
+	m := new(UploadFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Blobber_DeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobberServer).DeleteFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blobber.service.v1.Blobber/DeleteFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobberServer).DeleteFile(ctx, req.(*DeleteFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blobber_RenameObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobberServer).RenameObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blobber.service.v1.Blobber/RenameObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobberServer).RenameObject(ctx, req.(*RenameObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blobber_CopyObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobberServer).CopyObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blobber.service.v1.Blobber/CopyObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobberServer).CopyObject(ctx, req.(*CopyObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blobber_UpdateObjectAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateObjectAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobberServer).UpdateObjectAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blobber.service.v1.Blobber/UpdateObjectAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobberServer).UpdateObjectAttributes(ctx, req.(*UpdateObjectAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blobber_CommitConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobberServer).CommitConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blobber.service.v1.Blobber/CommitConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobberServer).CommitConnection(ctx, req.(*CommitConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blobber_AddCommitMetaTxn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommitMetaTxnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobberServer).AddCommitMetaTxn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blobber.service.v1.Blobber/AddCommitMetaTxn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobberServer).AddCommitMetaTxn(ctx, req.(*AddCommitMetaTxnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blobber_AddCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobberServer).AddCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blobber.service.v1.Blobber/AddCollaborator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobberServer).AddCollaborator(ctx, req.(*AddCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blobber_RemoveCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobberServer).RemoveCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blobber.service.v1.Blobber/RemoveCollaborator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobberServer).RemoveCollaborator(ctx, req.(*RemoveCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blobber_DownloadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BlobberServer).DownloadFile(&blobberDownloadFileServer{stream})
+}
+
+type Blobber_DownloadFileServer interface {
+	Send(*DownloadFileResponse) error
+	Recv() (*DownloadFileRequest, error)
+	grpc.ServerStream
+}
+
+type blobberDownloadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *blobberDownloadFileServer) Send(m *DownloadFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *blobberDownloadFileServer) Recv() (*DownloadFileRequest, error) {
+	m := new(DownloadFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Blobber_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "blobber.service.v1.Blobber",
 	HandlerType: (*BlobberServer)(nil),
@@ -307,7 +732,55 @@ var _Blobber_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetObjectTree",
 			Handler:    _Blobber_GetObjectTree_Handler,
 		},
+		{
+			MethodName: "GetChallengeHistory",
+			Handler:    _Blobber_GetChallengeHistory_Handler,
+		},
+		{
+			MethodName: "DeleteFile",
+			Handler:    _Blobber_DeleteFile_Handler,
+		},
+		{
+			MethodName: "RenameObject",
+			Handler:    _Blobber_RenameObject_Handler,
+		},
+		{
+			MethodName: "CopyObject",
+			Handler:    _Blobber_CopyObject_Handler,
+		},
+		{
+			MethodName: "UpdateObjectAttributes",
+			Handler:    _Blobber_UpdateObjectAttributes_Handler,
+		},
+		{
+			MethodName: "CommitConnection",
+			Handler:    _Blobber_CommitConnection_Handler,
+		},
+		{
+			MethodName: "AddCommitMetaTxn",
+			Handler:    _Blobber_AddCommitMetaTxn_Handler,
+		},
+		{
+			MethodName: "AddCollaborator",
+			Handler:    _Blobber_AddCollaborator_Handler,
+		},
+		{
+			MethodName: "RemoveCollaborator",
+			Handler:    _Blobber_RemoveCollaborator_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadFile",
+			Handler:       _Blobber_UploadFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadFile",
+			Handler:       _Blobber_DownloadFile_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "blobber.proto",
 }
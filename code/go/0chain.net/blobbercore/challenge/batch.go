@@ -0,0 +1,266 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/core/common"
+	. "0chain.net/core/logging"
+	"0chain.net/core/util"
+
+	"go.uber.org/zap"
+)
+
+const VALIDATOR_BATCH_URL = "/v1/storage/challenge/batch"
+
+type batchTicketRequest struct {
+	Challenges []json.RawMessage `json:"challenges"`
+}
+
+type batchTicketResult struct {
+	ChallengeID string          `json:"challenge_id"`
+	Ticket      json.RawMessage `json:"ticket,omitempty"`
+	Error       *common.Error   `json:"error,omitempty"`
+}
+
+type batchTicketResponse struct {
+	Results []*batchTicketResult `json:"results"`
+}
+
+type ticketRequest struct {
+	ctx  context.Context
+	body []byte
+	done chan ticketResponse
+}
+
+type ticketResponse struct {
+	body []byte
+	err  error
+}
+
+// validatorQueue is the requests to a validator waiting for the one in
+// progress.
+type validatorQueue struct {
+	busy    bool
+	pending []*ticketRequest
+}
+
+// validatorBatcher sends the challenge requests to the validators, the
+// ones made while a request to the same validator is in progress together
+// in a batch request once it's done, of challenge_response.validator_batch_size
+// challenges at most. A validator without the batch endpoint is sent them
+// one by one. The requests are made over gRPC to the validators advertising
+// it, see validatorConns.
+type validatorBatcher struct {
+	mu      sync.Mutex
+	queues  map[string]*validatorQueue
+	noBatch map[string]bool
+}
+
+func newValidatorBatcher() *validatorBatcher {
+	return &validatorBatcher{
+		queues:  make(map[string]*validatorQueue),
+		noBatch: make(map[string]bool),
+	}
+}
+
+var validatorBatches = newValidatorBatcher()
+
+// post sends the challenge request to the validator of the base URL given
+// and returns its response, the validation ticket.
+func (b *validatorBatcher) post(ctx context.Context, validatorURL string,
+	body []byte) ([]byte, error) {
+
+	if config.Configuration.ChallengeValidatorBatchSize <= 1 {
+		return postOne(ctx, validatorURL, body)
+	}
+
+	req := &ticketRequest{ctx: ctx, body: body,
+		done: make(chan ticketResponse, 1)}
+	b.mu.Lock()
+	q, ok := b.queues[validatorURL]
+	if !ok {
+		q = new(validatorQueue)
+		b.queues[validatorURL] = q
+	}
+	q.pending = append(q.pending, req)
+	if !q.busy {
+		q.busy = true
+		go b.run(validatorURL, q)
+	}
+	b.mu.Unlock()
+
+	select {
+	case resp := <-req.done:
+		return resp.body, resp.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (b *validatorBatcher) run(validatorURL string, q *validatorQueue) {
+	for {
+		batch := b.next(q)
+		if len(batch) == 0 {
+			return
+		}
+		b.send(validatorURL, batch)
+	}
+}
+
+// next takes the requests waiting still wanted, a batch of them at most.
+// The queue isn't busy anymore if there is none.
+func (b *validatorBatcher) next(q *validatorQueue) []*ticketRequest {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	size := config.Configuration.ChallengeValidatorBatchSize
+	batch := make([]*ticketRequest, 0, len(q.pending))
+	for len(q.pending) > 0 && len(batch) < size {
+		req := q.pending[0]
+		q.pending = q.pending[1:]
+		if req.ctx.Err() == nil {
+			batch = append(batch, req)
+		}
+	}
+	if len(batch) == 0 {
+		q.busy = false
+	}
+	return batch
+}
+
+func (b *validatorBatcher) send(validatorURL string, batch []*ticketRequest) {
+	b.mu.Lock()
+	noBatch := b.noBatch[validatorURL]
+	b.mu.Unlock()
+
+	if len(batch) == 1 || noBatch {
+		var wg sync.WaitGroup
+		for _, req := range batch {
+			wg.Add(1)
+			go func(req *ticketRequest) {
+				defer wg.Done()
+				body, err := postOne(req.ctx, validatorURL, req.body)
+				req.done <- ticketResponse{body: body, err: err}
+			}(req)
+		}
+		wg.Wait()
+		return
+	}
+
+	results, err := postBatch(validatorURL, batch)
+	if cerr, ok := err.(*common.Error); ok && cerr.Code == util.HTTPNotFound {
+		Logger.Info("Validator takes no batch of challenges",
+			zap.String("validator", validatorURL))
+		b.mu.Lock()
+		b.noBatch[validatorURL] = true
+		b.mu.Unlock()
+		b.send(validatorURL, batch)
+		return
+	}
+	for i, req := range batch {
+		switch {
+		case err != nil:
+			req.done <- ticketResponse{err: err}
+		case results[i].Error != nil:
+			req.done <- ticketResponse{err: results[i].Error}
+		default:
+			req.done <- ticketResponse{body: results[i].Ticket}
+		}
+	}
+}
+
+// postOne sends the challenge request alone, over gRPC if the validator
+// serves it.
+func postOne(ctx context.Context, validatorURL string, body []byte) (
+	[]byte, error) {
+
+	if client := validatorGRPC.get(ctx, validatorURL); client != nil {
+		resp, err := postGRPC(ctx, client, body)
+		if !grpcUnavailable(err) {
+			return resp, err
+		}
+		Logger.Info("Validator gRPC service unavailable, using HTTP",
+			zap.String("validator", validatorURL), zap.Error(err))
+		validatorGRPC.forget(validatorURL)
+	}
+	return postValidator(ctx, validatorURL+VALIDATOR_URL, body)
+}
+
+// postBatch sends the requests to the validator in one, until the last of
+// their deadlines, and returns the result of each.
+func postBatch(validatorURL string, batch []*ticketRequest) (
+	[]*batchTicketResult, error) {
+
+	var latest time.Time
+	for _, req := range batch {
+		if deadline, ok := req.ctx.Deadline(); ok && deadline.After(latest) {
+			latest = deadline
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if !latest.IsZero() {
+		ctx, cancel = context.WithDeadline(ctx, latest)
+		defer cancel()
+	}
+
+	var (
+		results []*batchTicketResult
+		err     error
+		sent    bool
+	)
+	if client := validatorGRPC.get(ctx, validatorURL); client != nil {
+		results, err = postBatchGRPC(ctx, client, batch)
+		if sent = !grpcUnavailable(err); !sent {
+			Logger.Info("Validator gRPC service unavailable, using HTTP",
+				zap.String("validator", validatorURL), zap.Error(err))
+			validatorGRPC.forget(validatorURL)
+		}
+	}
+	if !sent {
+		results, err = postBatchHTTP(ctx, validatorURL, batch)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) != len(batch) {
+		return nil, common.NewErrorf("invalid_batch_response",
+			"%d results for %d challenges", len(results), len(batch))
+	}
+	for _, r := range results {
+		if r == nil || (r.Error == nil && len(r.Ticket) == 0) {
+			return nil, common.NewError("invalid_batch_response",
+				"a challenge without a ticket nor an error")
+		}
+	}
+	return results, nil
+}
+
+func postBatchHTTP(ctx context.Context, validatorURL string,
+	batch []*ticketRequest) ([]*batchTicketResult, error) {
+
+	data := batchTicketRequest{Challenges: make([]json.RawMessage, 0, len(batch))}
+	for _, req := range batch {
+		data.Challenges = append(data.Challenges, req.body)
+	}
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := postValidator(ctx, validatorURL+VALIDATOR_BATCH_URL, body)
+	if err != nil {
+		return nil, err
+	}
+	var results batchTicketResponse
+	if err = json.Unmarshal(resp, &results); err != nil {
+		return nil, common.NewErrorf("invalid_batch_response",
+			"decoding the validator response %q: %v", resp, err)
+	}
+	return results.Results, nil
+}
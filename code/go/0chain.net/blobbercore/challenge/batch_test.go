@@ -0,0 +1,141 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/core/common"
+	"0chain.net/core/logging"
+	"0chain.net/core/util"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestValidatorBatcher(t *testing.T) {
+	logging.Logger = zap.NewNop()
+	config.Configuration.ChallengeValidatorBatchSize = 2
+	defer func() { config.Configuration.ChallengeValidatorBatchSize = 0 }()
+	defer func(post func(context.Context, string, []byte) ([]byte, error)) {
+		postValidator = post
+	}(postValidator)
+
+	// answers each challenge with its body, the ones of "bad" with an error
+	answer := func(body []byte) (*batchTicketResult, error) {
+		if string(body) == `"bad"` {
+			return &batchTicketResult{Error: common.NewError("invalid_parameters", "bad")}, nil
+		}
+		return &batchTicketResult{Ticket: body}, nil
+	}
+
+	var (
+		mu      sync.Mutex
+		calls   []string
+		release = make(chan struct{})
+	)
+	postValidator = func(ctx context.Context, url string, data []byte) ([]byte, error) {
+		mu.Lock()
+		calls = append(calls, url)
+		first := len(calls) == 1
+		mu.Unlock()
+		if first {
+			<-release // the others queue up meanwhile
+		}
+		if strings.HasSuffix(url, VALIDATOR_URL) {
+			if string(data) == `"bad"` {
+				return nil, common.NewError("http_error", "bad")
+			}
+			return data, nil
+		}
+		if strings.HasPrefix(url, "http://old") {
+			return nil, common.NewError(util.HTTPNotFound, "404 page not found")
+		}
+		var batch batchTicketRequest
+		require.NoError(t, json.Unmarshal(data, &batch))
+		var resp batchTicketResponse
+		for _, c := range batch.Challenges {
+			r, _ := answer(c)
+			resp.Results = append(resp.Results, r)
+		}
+		return json.Marshal(resp)
+	}
+
+	for _, validator := range []string{"http://new", "http://old"} {
+		mu.Lock()
+		calls = nil
+		release = make(chan struct{})
+		mu.Unlock()
+
+		b := newValidatorBatcher()
+		bodies := []string{`"first"`, `"a"`, `"bad"`, `"b"`}
+		type result struct {
+			body string
+			err  error
+		}
+		results := make([]result, len(bodies))
+		var wg sync.WaitGroup
+		for i, body := range bodies {
+			wg.Add(1)
+			go func(i int, body string) {
+				defer wg.Done()
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				resp, err := b.post(ctx, validator, []byte(body))
+				results[i] = result{string(resp), err}
+			}(i, body)
+			if i == 0 {
+				require.Eventually(t, func() bool {
+					mu.Lock()
+					defer mu.Unlock()
+					return len(calls) == 1
+				}, time.Second, time.Millisecond)
+			}
+		}
+		require.Eventually(t, func() bool {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			return len(b.queues[validator].pending) == 3
+		}, time.Second, time.Millisecond)
+		close(release)
+		wg.Wait()
+		require.Eventually(t, func() bool {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			return !b.queues[validator].busy
+		}, time.Second, time.Millisecond)
+
+		for i, body := range bodies {
+			if body == `"bad"` {
+				require.Error(t, results[i].err, validator)
+				continue
+			}
+			require.NoError(t, results[i].err, validator)
+			require.Equal(t, body, results[i].body, validator)
+		}
+
+		mu.Lock()
+		var batches, singles int
+		for _, url := range calls {
+			if strings.HasSuffix(url, VALIDATOR_BATCH_URL) {
+				batches++
+			} else {
+				singles++
+			}
+		}
+		mu.Unlock()
+		if validator == "http://new" {
+			require.Equal(t, 1, batches, "3 queued in batches of 2 at most")
+			require.Equal(t, 2, singles, "the first and the one left")
+		} else {
+			require.Equal(t, 1, batches, fmt.Sprint(calls))
+			require.Equal(t, 4, singles, "sent one by one once not found")
+			require.True(t, b.noBatch[validator])
+		}
+	}
+}
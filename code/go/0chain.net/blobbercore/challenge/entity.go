@@ -22,6 +22,8 @@ const (
 	Accepted ChallengeStatus = iota + 1
 	Processed
 	Committed
+	// Expired challenges passed their deadline before being answered
+	Expired
 )
 
 const (
@@ -75,7 +77,7 @@ type ChallengeEntity struct {
 	ValidationTickets       []*ValidationTicket   `json:"validation_tickets" gorm:"-"`
 	ObjectPathString        datatypes.JSON        `json:"-" gorm:"column:object_path"`
 	ObjectPath              *reference.ObjectPath `json:"object_path" gorm:"-"`
-	Created                 common.Timestamp      `json:"created" gorm:"-"`
+	Created                 common.Timestamp      `json:"created" gorm:"column:created"`
 }
 
 func (ChallengeEntity) TableName() string {
@@ -0,0 +1,259 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/core/common"
+	. "0chain.net/core/logging"
+	"0chain.net/core/node"
+	validatorstorage "0chain.net/validatorcore/storage"
+	"0chain.net/validatorcore/validatorgrpc"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const VALIDATOR_STATUS_URL = "/v2/status"
+
+const (
+	// validatorStatusTTL is how long the gRPC port a validator advertises,
+	// or the lack of one, is relied on.
+	validatorStatusTTL = 10 * time.Minute
+	// validatorStatusRetry is how long a validator that didn't give its
+	// status is sent the challenges over HTTP before asking again.
+	validatorStatusRetry = time.Minute
+)
+
+// validatorConn is the gRPC connection to a validator, none if it doesn't
+// serve gRPC.
+type validatorConn struct {
+	port    int32
+	conn    *grpc.ClientConn
+	client  validatorgrpc.ValidatorClient
+	expires time.Time
+}
+
+// validatorConns are the gRPC connections to the validators, by base URL.
+type validatorConns struct {
+	mu    sync.Mutex
+	conns map[string]*validatorConn
+}
+
+func newValidatorConns() *validatorConns {
+	return &validatorConns{conns: make(map[string]*validatorConn)}
+}
+
+var validatorGRPC = newValidatorConns()
+
+// getValidatorStatus is the status request to a validator, replaced by the
+// tests.
+var getValidatorStatus = func(ctx context.Context, validatorURL string) (
+	*validatorgrpc.GetStatusResponse, error) {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		validatorURL+VALIDATOR_STATUS_URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, common.NewErrorf("http_error",
+			"Error from HTTP call. %d %s", resp.StatusCode, body)
+	}
+	st := new(validatorgrpc.GetStatusResponse)
+	err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, st)
+	if err != nil {
+		return nil, common.NewErrorf("invalid_validator_status",
+			"decoding the validator status %q: %v", body, err)
+	}
+	return st, nil
+}
+
+// dialValidator connects to the gRPC service of a validator, replaced by the
+// tests.
+var dialValidator = func(target string) (*grpc.ClientConn, error) {
+	return grpc.Dial(target, grpc.WithInsecure())
+}
+
+// get returns the gRPC client of the validator of the base URL given, nil
+// if it's to be sent the challenges over HTTP. The gRPC port is the one
+// the validator advertises in its status.
+func (vc *validatorConns) get(ctx context.Context,
+	validatorURL string) validatorgrpc.ValidatorClient {
+
+	if !config.Configuration.ChallengeValidatorGRPC {
+		return nil
+	}
+
+	vc.mu.Lock()
+	c, ok := vc.conns[validatorURL]
+	vc.mu.Unlock()
+	if ok && time.Now().Before(c.expires) {
+		return c.client
+	}
+
+	c = vc.connect(ctx, validatorURL, c)
+
+	vc.mu.Lock()
+	if old, ok := vc.conns[validatorURL]; ok && old.conn != nil &&
+		old.conn != c.conn {
+		old.conn.Close()
+	}
+	vc.conns[validatorURL] = c
+	vc.mu.Unlock()
+	return c.client
+}
+
+// connect asks the validator for its status and connects to the gRPC port
+// advertised, reusing the connection of the previous status if the same.
+func (vc *validatorConns) connect(ctx context.Context, validatorURL string,
+	prev *validatorConn) *validatorConn {
+
+	st, err := getValidatorStatus(ctx, validatorURL)
+	if err != nil {
+		Logger.Info("Validator status not available, using HTTP",
+			zap.String("validator", validatorURL), zap.Error(err))
+		return &validatorConn{expires: time.Now().Add(validatorStatusRetry)}
+	}
+	c := &validatorConn{port: st.GrpcPort,
+		expires: time.Now().Add(validatorStatusTTL)}
+	if c.port <= 0 {
+		return c
+	}
+	if prev != nil && prev.conn != nil && prev.port == c.port {
+		c.conn, c.client = prev.conn, prev.client
+		return c
+	}
+
+	u, err := url.Parse(validatorURL)
+	if err == nil {
+		target := net.JoinHostPort(u.Hostname(), strconv.Itoa(int(c.port)))
+		c.conn, err = dialValidator(target)
+	}
+	if err != nil {
+		Logger.Error("Connecting to the validator gRPC service",
+			zap.String("validator", validatorURL), zap.Error(err))
+		return &validatorConn{expires: time.Now().Add(validatorStatusRetry)}
+	}
+	c.client = validatorgrpc.NewValidatorClient(c.conn)
+	return c
+}
+
+// forget drops the connection to the validator, its status asked again by
+// the next request.
+func (vc *validatorConns) forget(validatorURL string) {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+
+	if c, ok := vc.conns[validatorURL]; ok && c.conn != nil {
+		c.conn.Close()
+	}
+	delete(vc.conns, validatorURL)
+}
+
+// grpcUnavailable tells whether the gRPC request failed for the service not
+// being reachable, in which case it's to be made over HTTP.
+func grpcUnavailable(err error) bool {
+	return status.Code(err) == codes.Unavailable
+}
+
+func grpcRequestContext() *validatorgrpc.RequestContext {
+	return &validatorgrpc.RequestContext{
+		Client:    node.Self.ID,
+		ClientKey: node.Self.PublicKey,
+	}
+}
+
+// challengeRequestToGRPC returns the message of the JSON challenge request.
+func challengeRequestToGRPC(body []byte) (*validatorgrpc.ChallengeRequest, error) {
+	var cr validatorstorage.ChallengeRequest
+	if err := json.Unmarshal(body, &cr); err != nil {
+		return nil, err
+	}
+	return validatorstorage.ChallengeRequestToGRPC(&cr)
+}
+
+// ticketFromGRPC returns the JSON of the ticket, as the validator answers
+// over HTTP.
+func ticketFromGRPC(t *validatorgrpc.ValidationTicket) ([]byte, error) {
+	if t == nil {
+		return nil, common.NewError("invalid_validation_ticket",
+			"no ticket in the validator response")
+	}
+	return json.Marshal(validatorstorage.ValidationTicketFromGRPC(t))
+}
+
+// postGRPC sends the challenge request to the validator over gRPC.
+func postGRPC(ctx context.Context, client validatorgrpc.ValidatorClient,
+	body []byte) ([]byte, error) {
+
+	r, err := challengeRequestToGRPC(body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.ValidateChallenge(ctx,
+		&validatorgrpc.ValidateChallengeRequest{
+			Context:   grpcRequestContext(),
+			Challenge: r,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return ticketFromGRPC(resp.Ticket)
+}
+
+// postBatchGRPC sends the requests to the validator in one over gRPC.
+func postBatchGRPC(ctx context.Context, client validatorgrpc.ValidatorClient,
+	batch []*ticketRequest) ([]*batchTicketResult, error) {
+
+	req := &validatorgrpc.ValidateChallengesRequest{
+		Context:    grpcRequestContext(),
+		Challenges: make([]*validatorgrpc.ChallengeRequest, 0, len(batch)),
+	}
+	for _, tr := range batch {
+		r, err := challengeRequestToGRPC(tr.body)
+		if err != nil {
+			return nil, err
+		}
+		req.Challenges = append(req.Challenges, r)
+	}
+	resp, err := client.ValidateChallenges(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*batchTicketResult, 0, len(resp.Results))
+	for _, r := range resp.Results {
+		result := &batchTicketResult{ChallengeID: r.ChallengeId}
+		if r.Error != nil {
+			result.Error = common.NewError(r.Error.Code, r.Error.Message)
+		} else if r.Ticket != nil {
+			if result.Ticket, err = ticketFromGRPC(r.Ticket); err != nil {
+				return nil, err
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,147 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/core/logging"
+	"0chain.net/validatorcore/validatorgrpc"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testValidator answers each challenge with a passed ticket, the ones of
+// "down" as if the service was unreachable.
+type testValidator struct {
+	validatorgrpc.UnimplementedValidatorServer
+}
+
+func (testValidator) ticket(r *validatorgrpc.ChallengeRequest) (
+	*validatorgrpc.ValidationTicket, error) {
+
+	if r.ChallengeId == "down" {
+		return nil, status.Error(codes.Unavailable, "down")
+	}
+	return &validatorgrpc.ValidationTicket{ChallengeId: r.ChallengeId,
+		Success: true, Timestamp: 1000}, nil
+}
+
+func (v testValidator) ValidateChallenge(ctx context.Context,
+	req *validatorgrpc.ValidateChallengeRequest) (*validatorgrpc.ValidateChallengeResponse, error) {
+
+	t, err := v.ticket(req.Challenge)
+	if err != nil {
+		return nil, err
+	}
+	return &validatorgrpc.ValidateChallengeResponse{Ticket: t}, nil
+}
+
+func (v testValidator) ValidateChallenges(ctx context.Context,
+	req *validatorgrpc.ValidateChallengesRequest) (*validatorgrpc.ValidateChallengesResponse, error) {
+
+	resp := new(validatorgrpc.ValidateChallengesResponse)
+	for _, r := range req.Challenges {
+		result := &validatorgrpc.ChallengeResult{ChallengeId: r.ChallengeId}
+		if r.ObjectPath == nil {
+			result.Error = &validatorgrpc.Error{Code: "invalid_parameters",
+				Message: "Empty object path or merkle path"}
+		} else if result.Ticket, _ = v.ticket(r); result.Ticket == nil {
+			return nil, status.Error(codes.Unavailable, "down")
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp, nil
+}
+
+func TestValidatorGRPC(t *testing.T) {
+	logging.Logger = zap.NewNop()
+	config.Configuration.ChallengeValidatorGRPC = true
+	config.Configuration.ChallengeValidatorBatchSize = 0
+	defer func() { config.Configuration.ChallengeValidatorGRPC = false }()
+	defer func(get func(context.Context, string) (*validatorgrpc.GetStatusResponse, error),
+		dial func(string) (*grpc.ClientConn, error),
+		post func(context.Context, string, []byte) ([]byte, error)) {
+		getValidatorStatus, dialValidator, postValidator = get, dial, post
+	}(getValidatorStatus, dialValidator, postValidator)
+
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	validatorgrpc.RegisterValidatorServer(server, testValidator{})
+	go server.Serve(lis) //nolint:errcheck // stopped by the test
+	defer server.Stop()
+
+	var probes, dials, posts int
+	getValidatorStatus = func(ctx context.Context, validatorURL string) (
+		*validatorgrpc.GetStatusResponse, error) {
+
+		probes++
+		if validatorURL == "http://old:5061" {
+			return nil, status.Error(codes.NotFound, "404 page not found")
+		}
+		return &validatorgrpc.GetStatusResponse{GrpcPort: 7041}, nil
+	}
+	dialValidator = func(target string) (*grpc.ClientConn, error) {
+		dials++
+		require.Equal(t, "new:7041", target)
+		return grpc.Dial(target, grpc.WithContextDialer(
+			func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+			grpc.WithInsecure())
+	}
+	postValidator = func(ctx context.Context, url string, data []byte) ([]byte, error) {
+		posts++
+		return json.Marshal(&ValidationTicket{ChallengeID: "http"})
+	}
+	validatorGRPC = newValidatorConns()
+	defer func() { validatorGRPC = newValidatorConns() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	body := func(id string) []byte {
+		return []byte(`{"challenge_id":"` + id + `","object_path":{"root_hash":"x","path":{}}}`)
+	}
+	ticket := func(resp []byte, err error) *ValidationTicket {
+		require.NoError(t, err)
+		vt := new(ValidationTicket)
+		require.NoError(t, json.Unmarshal(resp, vt))
+		return vt
+	}
+
+	vt := ticket(validatorBatches.post(ctx, "http://new:5061", body("c1")))
+	require.Equal(t, "c1", vt.ChallengeID)
+	require.True(t, vt.Result)
+	vt = ticket(validatorBatches.post(ctx, "http://new:5061", body("c2")))
+	require.Equal(t, "c2", vt.ChallengeID)
+	require.Equal(t, 1, probes, "the status kept")
+	require.Equal(t, 1, dials)
+	require.Equal(t, 0, posts)
+
+	// without a status the validator is sent the challenges over HTTP
+	vt = ticket(validatorBatches.post(ctx, "http://old:5061", body("c1")))
+	require.Equal(t, "http", vt.ChallengeID)
+	require.Equal(t, 1, posts)
+
+	// the service gone, over HTTP and asking the status again
+	vt = ticket(validatorBatches.post(ctx, "http://new:5061", body("down")))
+	require.Equal(t, "http", vt.ChallengeID)
+	vt = ticket(validatorBatches.post(ctx, "http://new:5061", body("c3")))
+	require.Equal(t, "c3", vt.ChallengeID)
+	require.Equal(t, 3, probes)
+	require.Equal(t, 2, dials)
+
+	batch := []*ticketRequest{{ctx: ctx, body: body("c4")},
+		{ctx: ctx, body: []byte(`{"challenge_id":"c5"}`)}}
+	results, err := postBatch("http://new:5061", batch)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, "c4", ticket(results[0].Ticket, nil).ChallengeID)
+	require.Equal(t, "invalid_parameters", results[1].Error.Code)
+}
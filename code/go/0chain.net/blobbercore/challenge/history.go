@@ -0,0 +1,342 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultHistoryLimit is the page size of the challenge history if
+	// none is asked
+	DefaultHistoryLimit = 50
+	// MaxHistoryLimit is the largest page of the challenge history
+	MaxHistoryLimit = 500
+)
+
+// challengeTime is the time of a challenge: of its creation on chain, or
+// when it was saved for the challenges from before the creation was kept.
+const challengeTime = `COALESCE(NULLIF(created, 0),
+	CAST(EXTRACT(EPOCH FROM created_at) AS BIGINT))`
+
+// challengeDay is the UTC day of a challenge, as YYYY-MM-DD.
+const challengeDay = `TO_CHAR(TO_TIMESTAMP(` + challengeTime + `)
+	AT TIME ZONE 'UTC', 'YYYY-MM-DD')`
+
+var statusNames = map[string]ChallengeStatus{
+	"accepted":  Accepted,
+	"processed": Processed,
+	"committed": Committed,
+	"expired":   Expired,
+}
+
+var resultNames = map[string]ChallengeResult{
+	"unknown": ChallengeUnknown,
+	"success": ChallengeSuccess,
+	"failure": ChallengeFailure,
+}
+
+func (s ChallengeStatus) String() string {
+	for name, status := range statusNames {
+		if status == s {
+			return name
+		}
+	}
+	return strconv.Itoa(int(s))
+}
+
+func (r ChallengeResult) String() string {
+	for name, result := range resultNames {
+		if result == r {
+			return name
+		}
+	}
+	return strconv.Itoa(int(r))
+}
+
+// ParseChallengeStatus returns the status of the name or number given.
+func ParseChallengeStatus(s string) (ChallengeStatus, error) {
+	if status, ok := statusNames[s]; ok {
+		return status, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < int(Accepted) || n > int(Expired) {
+		return 0, common.NewErrorf("invalid_parameters",
+			"invalid challenge status %q", s)
+	}
+	return ChallengeStatus(n), nil
+}
+
+// ParseChallengeResult returns the result of the name or number given.
+func ParseChallengeResult(s string) (ChallengeResult, error) {
+	if result, ok := resultNames[s]; ok {
+		return result, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < int(ChallengeUnknown) || n > int(ChallengeFailure) {
+		return 0, common.NewErrorf("invalid_parameters",
+			"invalid challenge result %q", s)
+	}
+	return ChallengeResult(n), nil
+}
+
+// HistoryFilter selects the challenges of the history. The zero values
+// select all.
+type HistoryFilter struct {
+	AllocationID string
+	Status       *ChallengeStatus
+	Result       *ChallengeResult
+	// From and To bound the time of the challenges, To excluded
+	From common.Timestamp
+	To   common.Timestamp
+	// ValidatorID selects the challenges of a ticket of the validator, of
+	// the ValidatorResult if given, "success" or "failure"
+	ValidatorID     string
+	ValidatorResult string
+	Offset          int
+	Limit           int
+}
+
+// Validate checks the filter and sets the page size if not given.
+func (f *HistoryFilter) Validate() error {
+	if f.Offset < 0 || f.Limit < 0 {
+		return common.NewError("invalid_parameters",
+			"negative offset or limit")
+	}
+	if f.Limit == 0 {
+		f.Limit = DefaultHistoryLimit
+	}
+	if f.Limit > MaxHistoryLimit {
+		f.Limit = MaxHistoryLimit
+	}
+	if f.To > 0 && f.From >= f.To {
+		return common.NewError("invalid_parameters", "empty time range")
+	}
+	switch f.ValidatorResult {
+	case "", "success", "failure":
+	default:
+		return common.NewErrorf("invalid_parameters",
+			"invalid validator result %q", f.ValidatorResult)
+	}
+	if f.ValidatorResult != "" && f.ValidatorID == "" {
+		return common.NewError("invalid_parameters",
+			"validator result without a validator")
+	}
+	return nil
+}
+
+func (f *HistoryFilter) apply(db *gorm.DB) (*gorm.DB, error) {
+	db = db.Table(ChallengeEntity{}.TableName())
+	if f.AllocationID != "" {
+		db = db.Where("allocation_id = ?", f.AllocationID)
+	}
+	if f.Status != nil {
+		db = db.Where("status = ?", int(*f.Status))
+	}
+	if f.Result != nil {
+		db = db.Where("result = ?", int(*f.Result))
+	}
+	if f.From > 0 {
+		db = db.Where(challengeTime+" >= ?", f.From)
+	}
+	if f.To > 0 {
+		db = db.Where(challengeTime+" < ?", f.To)
+	}
+	if f.ValidatorID != "" {
+		ticket := map[string]interface{}{"validator_id": f.ValidatorID}
+		if f.ValidatorResult != "" {
+			ticket["success"] = f.ValidatorResult == "success"
+		}
+		tickets, err := json.Marshal([]interface{}{ticket})
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where("CAST(validation_tickets AS JSONB) @> ?", string(tickets))
+	}
+	return db, nil
+}
+
+// PassRate is the outcome of the challenges answered of an allocation or a
+// day. Rate is of the passed of the ones with a result, 0 if none.
+type PassRate struct {
+	AllocationID string  `json:"allocation_id,omitempty"`
+	Day          string  `json:"day,omitempty"`
+	Total        int64   `json:"total"`
+	Passed       int64   `json:"passed"`
+	Failed       int64   `json:"failed"`
+	Expired      int64   `json:"expired"`
+	Rate         float64 `json:"rate"`
+}
+
+func (pr *PassRate) add(status ChallengeStatus, result ChallengeResult,
+	total int64) {
+
+	pr.Total += total
+	switch {
+	case status == Expired:
+		pr.Expired += total
+	case result == ChallengeSuccess:
+		pr.Passed += total
+	case result == ChallengeFailure:
+		pr.Failed += total
+	}
+	if answered := pr.Passed + pr.Failed; answered > 0 {
+		pr.Rate = float64(pr.Passed) / float64(answered)
+	}
+}
+
+// History is a page of the challenges of a filter, the latest first, with
+// the pass rates of all the challenges of the filter.
+type History struct {
+	Total       int64              `json:"total"`
+	Offset      int                `json:"offset"`
+	Limit       int                `json:"limit"`
+	Challenges  []*ChallengeEntity `json:"challenges"`
+	Allocations []*PassRate        `json:"allocations"`
+	Days        []*PassRate        `json:"days"`
+}
+
+// GetHistory returns the challenges of the filter, with their validation
+// tickets, and the pass rates per allocation and per day.
+func GetHistory(ctx context.Context, f *HistoryFilter) (*History, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+	db := datastore.GetStore().GetTransaction(ctx)
+	if _, err := f.apply(db); err != nil {
+		return nil, err
+	}
+	// the conditions are applied anew to each query, they'd pile up on a
+	// query reused
+	query := func() *gorm.DB {
+		q, _ := f.apply(db)
+		return q
+	}
+
+	h := &History{Offset: f.Offset, Limit: f.Limit}
+	if err := query().Count(&h.Total).Error; err != nil {
+		return nil, err
+	}
+
+	h.Challenges = make([]*ChallengeEntity, 0)
+	err := query().Order("sequence DESC").
+		Offset(f.Offset).Limit(f.Limit).Find(&h.Challenges).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, cr := range h.Challenges {
+		if err = cr.UnmarshalFields(); err != nil {
+			return nil, err
+		}
+	}
+
+	var rows []struct {
+		AllocationID string
+		Day          string
+		Status       ChallengeStatus
+		Result       ChallengeResult
+		Total        int64
+	}
+	err = query().
+		Select("allocation_id, " + challengeDay + " AS day, status, result, COUNT(*) AS total").
+		Group("allocation_id, day, status, result").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		byAllocation = make(map[string]*PassRate)
+		byDay        = make(map[string]*PassRate)
+	)
+	for _, r := range rows {
+		pr, ok := byAllocation[r.AllocationID]
+		if !ok {
+			pr = &PassRate{AllocationID: r.AllocationID}
+			byAllocation[r.AllocationID] = pr
+		}
+		pr.add(r.Status, r.Result, r.Total)
+
+		if pr, ok = byDay[r.Day]; !ok {
+			pr = &PassRate{Day: r.Day}
+			byDay[r.Day] = pr
+		}
+		pr.add(r.Status, r.Result, r.Total)
+	}
+
+	h.Allocations = make([]*PassRate, 0, len(byAllocation))
+	for _, pr := range byAllocation {
+		h.Allocations = append(h.Allocations, pr)
+	}
+	sort.Slice(h.Allocations, func(i, j int) bool {
+		return h.Allocations[i].AllocationID < h.Allocations[j].AllocationID
+	})
+	h.Days = make([]*PassRate, 0, len(byDay))
+	for _, pr := range byDay {
+		h.Days = append(h.Days, pr)
+	}
+	sort.Slice(h.Days, func(i, j int) bool {
+		return h.Days[i].Day < h.Days[j].Day
+	})
+	return h, nil
+}
+
+// HistoryHandler serves a page of the challenge history, filtered by the
+// parameters "allocation", "status", "result", "from" and "to" (unix
+// seconds), "validator" and "validator_result", paged by "offset" and
+// "limit".
+func HistoryHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	f := &HistoryFilter{
+		AllocationID:    r.FormValue("allocation"),
+		ValidatorID:     r.FormValue("validator"),
+		ValidatorResult: r.FormValue("validator_result"),
+	}
+	if s := r.FormValue("status"); s != "" {
+		status, err := ParseChallengeStatus(s)
+		if err != nil {
+			return nil, err
+		}
+		f.Status = &status
+	}
+	if s := r.FormValue("result"); s != "" {
+		result, err := ParseChallengeResult(s)
+		if err != nil {
+			return nil, err
+		}
+		f.Result = &result
+	}
+	var err error
+	var from, to, offset, limit int64
+	for name, dest := range map[string]*int64{"from": &from, "to": &to,
+		"offset": &offset, "limit": &limit} {
+
+		if s := r.FormValue(name); s != "" {
+			if *dest, err = strconv.ParseInt(s, 10, 64); err != nil {
+				return nil, common.NewErrorf("invalid_parameters",
+					"invalid %s: %v", name, err)
+			}
+		}
+	}
+	f.From, f.To = common.Timestamp(from), common.Timestamp(to)
+	f.Offset, f.Limit = int(offset), int(limit)
+
+	ctx = datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(ctx)
+	defer db.Rollback()
+
+	h, err := GetHistory(ctx, f)
+	if err != nil {
+		if _, ok := err.(*common.Error); ok {
+			return nil, err
+		}
+		return nil, common.NewError("challenge_history_failed", err.Error())
+	}
+	return h, nil
+}
@@ -0,0 +1,82 @@
+package challenge
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"0chain.net/blobbercore/datastore"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHistory(t *testing.T) {
+	mock := datastore.MockTheStore(t)
+	mock.ExpectBegin()
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+
+	failed := ChallengeFailure
+	filter := &HistoryFilter{
+		AllocationID:    "alloc",
+		Result:          &failed,
+		ValidatorID:     "v1",
+		ValidatorResult: "failure",
+	}
+	where := `WHERE allocation_id = $1 AND result = $2 AND ` +
+		`CAST(validation_tickets AS JSONB) @> $3`
+	tickets := `[{"success":false,"validator_id":"v1"}]`
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) FROM "challenges" `+where)).
+		WithArgs("alloc", int(failed), tickets).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "challenges" `+where+
+		` ORDER BY sequence DESC LIMIT 50`)).
+		WithArgs("alloc", int(failed), tickets).
+		WillReturnRows(sqlmock.NewRows([]string{"challenge_id", "status",
+			"result", "status_message", "validation_tickets"}).
+			AddRow("c1", Committed, ChallengeFailure, "", []byte(`[{"validator_id":"v1",
+				"success":false,"message":"Failed to verify the object path."}]`)))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT allocation_id, TO_CHAR(`)).
+		WithArgs("alloc", int(failed), tickets).
+		WillReturnRows(sqlmock.NewRows([]string{"allocation_id", "day",
+			"status", "result", "total"}).
+			AddRow("alloc", "2021-05-01", Committed, ChallengeSuccess, 3).
+			AddRow("alloc", "2021-05-01", Committed, ChallengeFailure, 1).
+			AddRow("alloc", "2021-05-02", Expired, ChallengeUnknown, 2))
+
+	h, err := GetHistory(ctx, filter)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	require.EqualValues(t, 3, h.Total)
+	require.Len(t, h.Challenges, 1)
+	require.Equal(t, "Failed to verify the object path.",
+		h.Challenges[0].ValidationTickets[0].Message)
+
+	require.Len(t, h.Allocations, 1)
+	require.Equal(t, &PassRate{AllocationID: "alloc", Total: 6, Passed: 3,
+		Failed: 1, Expired: 2, Rate: 0.75}, h.Allocations[0])
+	require.Len(t, h.Days, 2)
+	require.Equal(t, "2021-05-01", h.Days[0].Day)
+	require.Equal(t, 0.75, h.Days[0].Rate)
+	require.Equal(t, &PassRate{Day: "2021-05-02", Total: 2, Expired: 2},
+		h.Days[1])
+}
+
+func TestHistoryFilterValidate(t *testing.T) {
+	f := &HistoryFilter{Limit: 10000}
+	require.NoError(t, f.Validate())
+	require.Equal(t, MaxHistoryLimit, f.Limit)
+
+	require.Error(t, (&HistoryFilter{From: 10, To: 10}).Validate())
+	require.Error(t, (&HistoryFilter{ValidatorResult: "failure"}).Validate())
+	require.Error(t, (&HistoryFilter{ValidatorID: "v", ValidatorResult: "no"}).Validate())
+
+	status, err := ParseChallengeStatus("expired")
+	require.NoError(t, err)
+	require.Equal(t, Expired, status)
+	_, err = ParseChallengeStatus("5")
+	require.Error(t, err)
+	require.Equal(t, "failure", ChallengeFailure.String())
+}
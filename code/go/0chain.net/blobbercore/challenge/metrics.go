@@ -0,0 +1,59 @@
+package challenge
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	challengesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blobber_challenges_total",
+		Help: "Challenges by outcome: passed or failed by the validators, " +
+			"error if no result could be had (retried the next round), expired, committed.",
+	}, []string{"outcome"})
+	deadlineMargin = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "blobber_challenge_deadline_margin_seconds",
+		Help:    "Time the challenges committed had left before their deadline.",
+		Buckets: []float64{0, 30, 60, 120, 300, 600, 900, 1800, 3600},
+	})
+	nextDeadline = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "blobber_challenge_next_deadline_timestamp_seconds",
+		Help: "Closest deadline of the accepted challenges, 0 if none.",
+	})
+)
+
+// challengeProcessed accounts the outcome of the collection of the
+// validation tickets of the challenge.
+func challengeProcessed(cr *ChallengeEntity) {
+	switch {
+	case cr.Status != Processed:
+		challengesTotal.WithLabelValues("error").Inc()
+	case cr.Result == ChallengeSuccess:
+		challengesTotal.WithLabelValues("passed").Inc()
+	default:
+		challengesTotal.WithLabelValues("failed").Inc()
+	}
+}
+
+// challengeCommitted accounts the response of the challenge committed on the
+// chain, with the time left before its deadline.
+func challengeCommitted(cr *ChallengeEntity) {
+	challengesTotal.WithLabelValues("committed").Inc()
+	if deadline, ok := cr.Deadline(); ok {
+		deadlineMargin.Observe(time.Until(deadline).Seconds())
+	}
+}
+
+// setNextDeadline sets the closest deadline of the challenges given, sorted
+// by their deadline.
+func setNextDeadline(sorted []*ChallengeEntity) {
+	if len(sorted) > 0 {
+		if deadline, ok := sorted[0].Deadline(); ok {
+			nextDeadline.Set(float64(deadline.Unix()))
+			return
+		}
+	}
+	nextDeadline.Set(0)
+}
@@ -4,17 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"math/rand"
-	"time"
 
 	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/datastore"
 	"0chain.net/blobbercore/filestore"
+	"0chain.net/blobbercore/outbox"
 	"0chain.net/blobbercore/reference"
 	"0chain.net/blobbercore/writemarker"
 	"0chain.net/core/chain"
 	"0chain.net/core/common"
+	"0chain.net/core/lock"
 	. "0chain.net/core/logging"
 	"0chain.net/core/transaction"
-	"0chain.net/core/util"
 
 	"go.uber.org/zap"
 )
@@ -48,16 +49,8 @@ func (cr *ChallengeEntity) SubmitChallengeToBC(ctx context.Context) (*transactio
 		return nil, err
 	}
 
-	Logger.Info("Verifying challenge response to blockchain.", zap.String("txn", txn.Hash), zap.String("challenge_id", cr.ChallengeID))
-	time.Sleep(transaction.SLEEP_FOR_TXN_CONFIRMATION * time.Second)
-
-	t, err := transaction.VerifyTransaction(txn.Hash, chain.GetServerChain())
-	if err != nil {
-		Logger.Error("Error verifying the challenge response transaction", zap.String("err:", err.Error()), zap.String("txn", txn.Hash), zap.String("challenge_id", cr.ChallengeID))
-		return txn, err
-	}
-	Logger.Info("Challenge committed and accepted", zap.Any("txn.hash", t.Hash), zap.Any("txn.output", t.TransactionOutput), zap.String("challenge_id", cr.ChallengeID))
-	return t, nil
+	Logger.Info("Submitted challenge response to blockchain.", zap.String("txn", txn.Hash), zap.String("challenge_id", cr.ChallengeID))
+	return txn, nil
 }
 
 func (cr *ChallengeEntity) ErrorChallenge(ctx context.Context, err error) {
@@ -67,32 +60,140 @@ func (cr *ChallengeEntity) ErrorChallenge(ctx context.Context, err error) {
 	}
 }
 
+// GetValidationTickets asks the validators of the challenge for their tickets
+// and saves the result. The validators are asked out of any DB transaction,
+// not to keep one open through their timeouts and retries: the request is
+// built in a transaction and the result saved in another.
 func (cr *ChallengeEntity) GetValidationTickets(ctx context.Context) error {
+	var postData []byte
+	err := inTransaction(ctx, func(ctx context.Context) (err error) {
+		postData, err = cr.validationRequest(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	cr.collectValidationTickets(ctx, postData)
+	return inTransaction(ctx, cr.saveValidationResult)
+}
+
+// inTransaction runs f in a transaction of its own, committed even if f
+// fails as the challenge is saved with the error.
+func inTransaction(ctx context.Context, f func(ctx context.Context) error) error {
+	ctx = datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(ctx)
+	err := f(ctx)
+	if commitErr := db.Commit().Error; commitErr != nil && err == nil {
+		return common.NewErrorf("commit_error",
+			"error committing the challenge: %v", commitErr)
+	}
+	return err
+}
+
+// validationRequest builds the request of the validators for the challenge,
+// saving the challenge with the error if it can't.
+func (cr *ChallengeEntity) validationRequest(ctx context.Context) ([]byte, error) {
 	if len(cr.Validators) == 0 {
 		cr.StatusMessage = "No validators assigned to the challange"
 		if err := cr.Save(ctx); err != nil {
 			Logger.Error("ChallengeEntity_Save", zap.String("challenge_id", cr.ChallengeID), zap.Error(err))
 		}
-		return common.NewError("no_validators", "No validators assigned to the challange")
+		return nil, common.NewError("no_validators", "No validators assigned to the challange")
 	}
 
 	allocationObj, err := allocation.GetAllocationByID(ctx, cr.AllocationID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	wms, err := writemarker.GetWriteMarkersInRange(ctx, cr.AllocationID, cr.AllocationRoot, allocationObj.AllocationRoot)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if len(wms) == 0 {
-		return common.NewError("write_marker_not_found", "Could find the writemarker for the given allocation root on challenge")
+		return nil, common.NewError("write_marker_not_found", "Could find the writemarker for the given allocation root on challenge")
+	}
+
+	req, err := newChallengeRequest(ctx, cr.AllocationID, cr.ChallengeID,
+		cr.RandomNumber, wms)
+	if err != nil {
+		cr.ErrorChallenge(ctx, err)
+		return nil, err
+	}
+	cr.BlockNum = req.BlockNum
+	cr.RefID = req.ObjectPath.RefID
+	cr.RespondedAllocationRoot = allocationObj.AllocationRoot
+	cr.ObjectPath = req.ObjectPath
+
+	postDataBytes, err := json.Marshal(req.Body)
+	if err != nil {
+		Logger.Error("Error in marshalling the post data for validation. " + err.Error())
+		cr.ErrorChallenge(ctx, err)
+		return nil, err
+	}
+	if len(cr.ValidationTickets) != len(cr.Validators) {
+		cr.ValidationTickets = make([]*ValidationTicket, len(cr.Validators))
+	}
+	return postDataBytes, nil
+}
+
+// saveValidationResult saves the challenge with the result of the tickets,
+// or the error if the validators didn't reach a consensus.
+func (cr *ChallengeEntity) saveValidationResult(ctx context.Context) error {
+	numSuccess := 0
+	numFailure := 0
+
+	numValidatorsResponded := 0
+	for _, vt := range cr.ValidationTickets {
+		if vt != nil {
+			if vt.Result {
+				numSuccess++
+			} else {
+				numFailure++
+			}
+			numValidatorsResponded++
+		}
 	}
 
-	rootRef, err := reference.GetReference(ctx, cr.AllocationID, "/")
+	Logger.Info("validator response stats", zap.Any("challenge_id", cr.ChallengeID), zap.Any("validation_tickets", cr.ValidationTickets))
+	if numSuccess > (len(cr.Validators)/2) || numFailure > (len(cr.Validators)/2) || numValidatorsResponded == len(cr.Validators) {
+		if numSuccess > (len(cr.Validators) / 2) {
+			cr.Result = ChallengeSuccess
+		} else {
+			cr.Result = ChallengeFailure
+			Logger.Error("Challenge failed by the validators", zap.Any("block_num", cr.BlockNum), zap.Any("object_path", cr.ObjectPath), zap.Any("challenge", cr))
+		}
+
+		cr.Status = Processed
+	} else {
+		cr.ErrorChallenge(ctx, common.NewError("no_consensus_challenge", "No Consensus on the challenge result. Erroring out the challenge"))
+		return common.NewError("no_consensus_challenge", "No Consensus on the challenge result. Erroring out the challenge")
+	}
+
+	return cr.Save(ctx)
+}
+
+// challengeRequest is the request of the validators for a challenge, with
+// the block challenged.
+type challengeRequest struct {
+	BlockNum   int64
+	ObjectPath *reference.ObjectPath
+	Body       map[string]interface{}
+}
+
+// newChallengeRequest picks the block of the allocation challenged with the
+// seed, the way the validators check it, and builds their request with the
+// write markers given, from the challenged one to the latest.
+func newChallengeRequest(ctx context.Context, allocationID, challengeID string,
+	seed int64, wms []*writemarker.WriteMarkerEntity) (*challengeRequest, error) {
+
+	rootRef, err := reference.GetReference(ctx, allocationID, "/")
+	if err != nil {
+		return nil, err
+	}
 	blockNum := int64(0)
 	if rootRef.NumBlocks > 0 {
-		r := rand.New(rand.NewSource(cr.RandomNumber))
+		r := rand.New(rand.NewSource(seed))
 		//rand.Seed(cr.RandomNumber)
 		blockNum = r.Int63n(rootRef.NumBlocks)
 		blockNum = blockNum + 1
@@ -100,23 +201,14 @@ func (cr *ChallengeEntity) GetValidationTickets(ctx context.Context) error {
 		Logger.Error("Got a challenge for a blank allocation")
 	}
 
-	cr.BlockNum = blockNum
+	Logger.Info("blockNum for challenge", zap.Any("rootRef.NumBlocks", rootRef.NumBlocks), zap.Any("blockNum", blockNum), zap.Any("challenge_id", challengeID), zap.Any("random_seed", seed))
+	objectPath, err := reference.GetObjectPath(ctx, allocationID, blockNum)
 	if err != nil {
-		cr.ErrorChallenge(ctx, err)
-		return err
-	}
-	Logger.Info("blockNum for challenge", zap.Any("rootRef.NumBlocks", rootRef.NumBlocks), zap.Any("blockNum", blockNum), zap.Any("challenge_id", cr.ChallengeID), zap.Any("random_seed", cr.RandomNumber))
-	objectPath, err := reference.GetObjectPath(ctx, cr.AllocationID, blockNum)
-	if err != nil {
-		cr.ErrorChallenge(ctx, err)
-		return err
+		return nil, err
 	}
-	cr.RefID = objectPath.RefID
-	cr.RespondedAllocationRoot = allocationObj.AllocationRoot
-	cr.ObjectPath = objectPath
 
 	postData := make(map[string]interface{})
-	postData["challenge_id"] = cr.ChallengeID
+	postData["challenge_id"] = challengeID
 	postData["object_path"] = objectPath
 	markersArray := make([]map[string]interface{}, 0)
 	for _, wm := range wms {
@@ -130,108 +222,42 @@ func (cr *ChallengeEntity) GetValidationTickets(ctx context.Context) error {
 	if blockNum > 0 {
 		if objectPath.Meta["type"] != reference.FILE {
 			Logger.Info("Block number to be challenged for file:", zap.Any("block", objectPath.FileBlockNum), zap.Any("meta", objectPath.Meta), zap.Any("obejct_path", objectPath))
-			err = common.NewError("invalid_object_path", "Object path was not for a file")
-			cr.ErrorChallenge(ctx, err)
-			return err
+			return nil, common.NewError("invalid_object_path", "Object path was not for a file")
 		}
 
 		inputData := &filestore.FileInputData{}
 		inputData.Name = objectPath.Meta["name"].(string)
 		inputData.Path = objectPath.Meta["path"].(string)
 		inputData.Hash = objectPath.Meta["content_hash"].(string)
-		r := rand.New(rand.NewSource(cr.RandomNumber))
+		r := rand.New(rand.NewSource(seed))
 		//rand.Seed(cr.RandomNumber)
 		blockoffset := r.Intn(1024)
-		blockData, mt, err := filestore.GetFileStore().GetFileBlockForChallenge(cr.AllocationID, inputData, blockoffset)
+		blockData, mt, err := filestore.GetFileStore().GetFileBlockForChallenge(allocationID, inputData, blockoffset)
 
 		if err != nil {
-			cr.ErrorChallenge(ctx, err)
-			return common.NewError("blockdata_not_found", err.Error())
+			return nil, common.NewError("blockdata_not_found", err.Error())
 		}
 		postData["data"] = []byte(blockData)
 		postData["merkle_path"] = mt.GetPathByIndex(blockoffset)
 	}
 
-	postDataBytes, err := json.Marshal(postData)
+	return &challengeRequest{
+		BlockNum:   blockNum,
+		ObjectPath: objectPath,
+		Body:       postData,
+	}, nil
+}
+
+func (cr *ChallengeEntity) CommitChallenge(ctx context.Context, verifyOnly bool) error {
+
+	pending, err := outbox.HasPending(ctx, ResponseTxnKind, cr.ChallengeID)
 	if err != nil {
-		Logger.Error("Error in marshalling the post data for validation. " + err.Error())
-		cr.ErrorChallenge(ctx, err)
 		return err
 	}
-	responses := make(map[string]ValidationTicket)
-	if cr.ValidationTickets == nil {
-		cr.ValidationTickets = make([]*ValidationTicket, len(cr.Validators))
-	}
-	for i, validator := range cr.Validators {
-		if cr.ValidationTickets[i] != nil {
-			exisitingVT := cr.ValidationTickets[i]
-			if len(exisitingVT.Signature) > 0 && exisitingVT.ChallengeID == cr.ChallengeID {
-				continue
-			}
-		}
-		url := validator.URL + VALIDATOR_URL
-		resp, err := util.SendPostRequest(url, postDataBytes, nil)
-		if err != nil {
-			Logger.Info("Got error from the validator.", zap.Any("error", err.Error()))
-			delete(responses, validator.ID)
-			cr.ValidationTickets[i] = nil
-			continue
-		}
-		var validationTicket ValidationTicket
-		err = json.Unmarshal(resp, &validationTicket)
-		if err != nil {
-			Logger.Info("Got error decoding from the validator response .", zap.Any("resp", string(resp)), zap.Any("error", err.Error()))
-			delete(responses, validator.ID)
-			cr.ValidationTickets[i] = nil
-			continue
-		}
-		Logger.Info("Got response from the validator.", zap.Any("validator_response", validationTicket))
-		verified, err := validationTicket.VerifySign()
-		if err != nil || !verified {
-			Logger.Info("Validation ticket from validator could not be verified.")
-			delete(responses, validator.ID)
-			cr.ValidationTickets[i] = nil
-			continue
-		}
-		responses[validator.ID] = validationTicket
-		cr.ValidationTickets[i] = &validationTicket
+	if pending {
+		return nil // the response submitted awaits confirmation
 	}
 
-	numSuccess := 0
-	numFailure := 0
-
-	numValidatorsResponded := 0
-	for _, vt := range cr.ValidationTickets {
-		if vt != nil {
-			if vt.Result {
-				numSuccess++
-			} else {
-				numFailure++
-			}
-			numValidatorsResponded++
-		}
-	}
-
-	Logger.Info("validator response stats", zap.Any("challenge_id", cr.ChallengeID), zap.Any("validator_responses", responses))
-	if numSuccess > (len(cr.Validators)/2) || numFailure > (len(cr.Validators)/2) || numValidatorsResponded == len(cr.Validators) {
-		if numSuccess > (len(cr.Validators) / 2) {
-			cr.Result = ChallengeSuccess
-		} else {
-			cr.Result = ChallengeFailure
-			Logger.Error("Challenge failed by the validators", zap.Any("block_num", cr.BlockNum), zap.Any("object_path", objectPath), zap.Any("challenge", cr))
-		}
-
-		cr.Status = Processed
-	} else {
-		cr.ErrorChallenge(ctx, common.NewError("no_consensus_challenge", "No Consensus on the challenge result. Erroring out the challenge"))
-		return common.NewError("no_consensus_challenge", "No Consensus on the challenge result. Erroring out the challenge")
-	}
-
-	return cr.Save(ctx)
-}
-
-func (cr *ChallengeEntity) CommitChallenge(ctx context.Context, verifyOnly bool) error {
-
 	if len(cr.LastCommitTxnIDs) > 0 {
 		for _, lastTxn := range cr.LastCommitTxnIDs {
 			Logger.Info("Verifying the transaction : " + lastTxn)
@@ -244,6 +270,7 @@ func (cr *ChallengeEntity) CommitChallenge(ctx context.Context, verifyOnly bool)
 					Logger.Error("ChallengeEntity_Save", zap.String("challenge_id", cr.ChallengeID), zap.Error(err))
 				}
 				FileChallenged(ctx, cr.RefID, cr.Result, cr.CommitTxnID)
+				challengeCommitted(cr)
 				return nil
 			}
 			Logger.Error("Error verifying the txn from BC."+lastTxn, zap.String("challenge_id", cr.ChallengeID), zap.Error(err))
@@ -263,12 +290,55 @@ func (cr *ChallengeEntity) CommitChallenge(ctx context.Context, verifyOnly bool)
 		cr.ErrorChallenge(ctx, err)
 		Logger.Error("Error while submitting challenge to BC.", zap.String("challenge_id", cr.ChallengeID), zap.Error(err))
 	} else {
-		cr.Status = Committed
-		cr.StatusMessage = t.TransactionOutput
+		cr.StatusMessage = "Awaiting confirmation of the challenge response"
 		cr.CommitTxnID = t.Hash
 		cr.LastCommitTxnIDs = append(cr.LastCommitTxnIDs, t.Hash)
+		if err = outbox.Enqueue(ctx, ResponseTxnKind, cr.ChallengeID, t.Hash, nil); err != nil {
+			return err
+		}
+		return cr.Save(ctx)
 	}
 	err = cr.Save(ctx)
 	FileChallenged(ctx, cr.RefID, cr.Result, cr.CommitTxnID)
 	return err
 }
+
+// ResponseTxnKind is the kind of the outbox transactions responding to challenges.
+const ResponseTxnKind = "challenge_response"
+
+func onResponseConfirmed(ctx context.Context, ptx *outbox.PendingTxn, t *transaction.Transaction) error {
+	mutex := lock.GetMutex(ChallengeEntity{}.TableName(), ptx.RefKey)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	cr, err := GetChallengeEntity(ctx, ptx.RefKey)
+	if err != nil {
+		return err
+	}
+	if cr.Status == Committed {
+		return nil
+	}
+	cr.Status = Committed
+	cr.StatusMessage = t.TransactionOutput
+	cr.CommitTxnID = t.Hash
+	if err = cr.Save(ctx); err != nil {
+		return err
+	}
+	FileChallenged(ctx, cr.RefID, cr.Result, cr.CommitTxnID)
+	challengeCommitted(cr)
+	return nil
+}
+
+// onResponseFailed leaves the challenge processed, to be submitted again.
+func onResponseFailed(ctx context.Context, ptx *outbox.PendingTxn) error {
+	mutex := lock.GetMutex(ChallengeEntity{}.TableName(), ptx.RefKey)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	cr, err := GetChallengeEntity(ctx, ptx.RefKey)
+	if err != nil {
+		return err
+	}
+	cr.StatusMessage = "challenge response failed: " + ptx.StatusMessage
+	return cr.Save(ctx)
+}
@@ -0,0 +1,204 @@
+package challenge
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/outbox"
+	"0chain.net/core/lock"
+	"0chain.net/core/metrics"
+
+	. "0chain.net/core/logging"
+	"github.com/remeh/sizedwaitgroup"
+	"go.uber.org/zap"
+)
+
+// submitNow wakes the submission of the processed challenges up, not to
+// wait for the next round with a response ready.
+var submitNow = make(chan struct{}, 1)
+
+func notifyProcessed() {
+	select {
+	case submitNow <- struct{}{}:
+	default: // already notified
+	}
+}
+
+// sortByDeadline orders the challenges by their deadline, the ones without
+// a deadline last. The order of the challenges of the same deadline is kept.
+func sortByDeadline(challenges []*ChallengeEntity) {
+	sort.SliceStable(challenges, func(i, j int) bool {
+		di, iok := challenges[i].Deadline()
+		dj, jok := challenges[j].Deadline()
+		if iok != jok {
+			return iok
+		}
+		return di.Before(dj)
+	})
+}
+
+// expire marks the challenge expired, it's not answered anymore.
+func (cr *ChallengeEntity) expire(ctx context.Context) error {
+	deadline, _ := cr.Deadline()
+	Logger.Info("Challenge expired before being answered",
+		zap.String("challenge_id", cr.ChallengeID),
+		zap.Time("deadline", deadline), zap.Any("status", cr.Status))
+	cr.Status = Expired
+	cr.StatusMessage = "expired at " + deadline.UTC().Format(time.RFC3339)
+	if err := cr.Save(ctx); err != nil {
+		return err
+	}
+	challengesTotal.WithLabelValues("expired").Inc()
+	return nil
+}
+
+// processAccepted collects the validation tickets of the accepted
+// challenges, the closest to their deadline first. The expired ones are
+// marked so and left out.
+func processAccepted(ctx context.Context) {
+	rctx := datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(rctx)
+	accepted := make([]*ChallengeEntity, 0)
+	err := db.Where(ChallengeEntity{Status: Accepted}).Order("sequence").
+		Find(&accepted).Error
+	db.Rollback()
+	if err != nil {
+		Logger.Error("Error getting the accepted challenges", zap.Error(err))
+		return
+	}
+	metrics.QueueDepth.WithLabelValues("challenge_accepted").Set(float64(len(accepted)))
+
+	var (
+		now  = time.Now()
+		open = make([]*ChallengeEntity, 0, len(accepted))
+	)
+	for _, cr := range accepted {
+		if err := cr.UnmarshalFields(); err != nil {
+			Logger.Error("Error unmarshaling challenge entity.", zap.Error(err))
+			continue
+		}
+		if cr.expired(now) {
+			expireCtx := datastore.GetStore().CreateTransaction(ctx)
+			db := datastore.GetStore().GetTransaction(expireCtx)
+			if err := cr.expire(expireCtx); err != nil {
+				Logger.Error("ChallengeEntity_Save",
+					zap.String("challenge_id", cr.ChallengeID), zap.Error(err))
+				db.Rollback()
+				continue
+			}
+			db.Commit()
+			continue
+		}
+		open = append(open, cr)
+	}
+	sortByDeadline(open)
+	setNextDeadline(open)
+
+	// the workers take the challenges in the order, the closest to their
+	// deadline are answered first
+	swg := sizedwaitgroup.New(config.Configuration.ChallengeResolveNumWorkers)
+	for _, cr := range open {
+		Logger.Info("Processing the challenge", zap.Any("challenge_id", cr.ChallengeID), zap.Any("openchallenge", cr))
+		swg.Add()
+		go func(redeemCtx context.Context, challengeEntity *ChallengeEntity) {
+			defer swg.Done()
+			// the tickets are got and saved in transactions of their own
+			err := GetValidationTickets(redeemCtx, challengeEntity)
+			if err != nil {
+				Logger.Error("Getting validation tickets failed", zap.Any("challenge_id", challengeEntity.ChallengeID), zap.Error(err))
+			}
+			challengeProcessed(challengeEntity)
+			if err == nil && challengeEntity.Status == Processed {
+				notifyProcessed()
+			}
+		}(ctx, cr)
+	}
+	swg.Wait()
+}
+
+// submitProcessed submits the responses of the processed challenges, the
+// closest to their deadline first. With challenge_response.strict_order,
+// for the chains taking the responses of a blobber in the order of its
+// challenges, they are submitted in the order of the challenges instead.
+// Either way a challenge failing is skipped, left for the next round, and
+// holds back none of the others; an expired one is marked so.
+func submitProcessed(ctx context.Context, lastSeq int64) {
+	rctx := datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(rctx)
+	processed := make([]*ChallengeEntity, 0)
+	err := db.Where(ChallengeEntity{Status: Processed}).
+		Where("sequence > ?", lastSeq).
+		Order("sequence").
+		Find(&processed).Error
+	db.Rollback()
+	if err != nil {
+		Logger.Error("Error getting the processed challenges", zap.Error(err))
+		return
+	}
+	metrics.QueueDepth.WithLabelValues("challenge_processed").Set(float64(len(processed)))
+
+	for _, cr := range processed {
+		if err := cr.UnmarshalFields(); err != nil {
+			Logger.Error("ChallengeEntity_UnmarshalFields", zap.String("challenge_id", cr.ChallengeID), zap.Error(err))
+		}
+	}
+	if !config.Configuration.ChallengeStrictOrder {
+		sortByDeadline(processed)
+	}
+
+	for _, cr := range processed {
+		Logger.Info("Attempting to commit challenge", zap.Any("challenge_id", cr.ChallengeID), zap.Any("openchallenge", cr))
+		commitProcessed(ctx, cr)
+	}
+}
+
+// commitProcessed commits the response of the challenge, or expires it.
+func commitProcessed(ctx context.Context, cr *ChallengeEntity) {
+	mutex := lock.GetMutex(cr.TableName(), cr.ChallengeID)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	redeemCtx := datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(redeemCtx)
+
+	pending, err := outbox.HasPending(redeemCtx, ResponseTxnKind, cr.ChallengeID)
+	if err == nil && !pending && cr.expired(time.Now()) {
+		// a response of an earlier round may have made it
+		if err = cr.CommitChallenge(redeemCtx, true); err == nil && cr.Status != Committed {
+			err = cr.expire(redeemCtx)
+		}
+	} else if err == nil && !pending {
+		err = cr.CommitChallenge(redeemCtx, false)
+		if err == nil && cr.Status != Committed {
+			pending, err = outbox.HasPending(redeemCtx, ResponseTxnKind, cr.ChallengeID)
+		}
+	}
+	if err != nil {
+		Logger.Error("Error committing to blockchain",
+			zap.Error(err),
+			zap.String("challenge_id", cr.ChallengeID))
+		db.Rollback()
+		return
+	}
+	if err = db.Commit().Error; err != nil {
+		Logger.Error("Error committing the challenge", zap.Error(err),
+			zap.String("challenge_id", cr.ChallengeID))
+		return
+	}
+
+	switch {
+	case cr.Status == Committed:
+		Logger.Info("Challenge has been submitted to blockchain",
+			zap.Any("id", cr.ChallengeID),
+			zap.String("txn", cr.CommitTxnID))
+	case cr.Status == Expired:
+	case pending:
+		Logger.Info("Challenge response awaits confirmation",
+			zap.Any("challenge_id", cr.ChallengeID))
+	default:
+		Logger.Info("Challenge was not committed", zap.Any("challenge_id", cr.ChallengeID))
+	}
+}
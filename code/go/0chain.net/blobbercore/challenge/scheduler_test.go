@@ -0,0 +1,37 @@
+package challenge
+
+import (
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/core/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortByDeadline(t *testing.T) {
+	config.Configuration.ChallengeCompletionTime = time.Minute
+	now := common.Now()
+	challenges := []*ChallengeEntity{
+		{ChallengeID: "unknown"},
+		{ChallengeID: "late", Created: now},
+		{ChallengeID: "early", Created: now - 30},
+		{ChallengeID: "expired", Created: now - 90},
+		{ChallengeID: "late-too", Created: now},
+	}
+	sortByDeadline(challenges)
+
+	var ids []string
+	for _, cr := range challenges {
+		ids = append(ids, cr.ChallengeID)
+	}
+	require.Equal(t, []string{"expired", "early", "late", "late-too", "unknown"}, ids)
+
+	require.True(t, challenges[0].expired(time.Now()))
+	require.False(t, challenges[1].expired(time.Now()))
+	require.False(t, challenges[4].expired(time.Now()), "no deadline known")
+
+	config.Configuration.ChallengeCompletionTime = -1
+	require.False(t, challenges[0].expired(time.Now()), "no completion time")
+}
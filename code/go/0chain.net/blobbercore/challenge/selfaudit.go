@@ -0,0 +1,212 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/writemarker"
+	"0chain.net/core/common"
+	"0chain.net/core/lock"
+	validatorstorage "0chain.net/validatorcore/storage"
+
+	. "0chain.net/core/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxSelfAuditFailures is the number of the latest failures kept
+	maxSelfAuditFailures = 50
+	// selfAuditChallengeID is the ID of the simulated challenges, not of
+	// the chain
+	selfAuditChallengeID = "self-audit"
+)
+
+// SelfAuditFailure is a challenge simulated by the blobber the validators
+// would have failed.
+type SelfAuditFailure struct {
+	AllocationID   string           `json:"allocation_id"`
+	AllocationRoot string           `json:"allocation_root"`
+	Seed           int64            `json:"seed"`
+	BlockNum       int64            `json:"block_num"`
+	Path           string           `json:"path,omitempty"`
+	Error          string           `json:"error"`
+	Time           common.Timestamp `json:"time"`
+}
+
+// SelfAuditStatus is the outcome of the self audit since the blobber
+// started. It's healthy if the last round found no failure.
+type SelfAuditStatus struct {
+	Enabled   bool                `json:"enabled"`
+	Healthy   bool                `json:"healthy"`
+	Rounds    int64               `json:"rounds"`
+	LastRound common.Timestamp    `json:"last_round,omitempty"`
+	Checked   int64               `json:"checked"`
+	Failed    int64               `json:"failed"`
+	Failures  []*SelfAuditFailure `json:"failures"`
+}
+
+var selfAudit = struct {
+	sync.Mutex
+	status SelfAuditStatus
+}{status: SelfAuditStatus{Healthy: true}}
+
+// GetSelfAuditStatus returns a copy of the self audit status, the latest
+// failure first.
+func GetSelfAuditStatus() *SelfAuditStatus {
+	selfAudit.Lock()
+	defer selfAudit.Unlock()
+
+	status := selfAudit.status
+	status.Enabled = config.Configuration.SelfAuditFreq > 0
+	status.Failures = make([]*SelfAuditFailure, 0, len(selfAudit.status.Failures))
+	for i := len(selfAudit.status.Failures) - 1; i >= 0; i-- {
+		status.Failures = append(status.Failures, selfAudit.status.Failures[i])
+	}
+	return &status
+}
+
+func recordSelfAuditRound(checked int, failures []*SelfAuditFailure) {
+	selfAudit.Lock()
+	defer selfAudit.Unlock()
+
+	s := &selfAudit.status
+	s.Rounds++
+	s.LastRound = common.Now()
+	s.Checked += int64(checked)
+	s.Failed += int64(len(failures))
+	s.Healthy = len(failures) == 0
+	s.Failures = append(s.Failures, failures...)
+	if over := len(s.Failures) - maxSelfAuditFailures; over > 0 {
+		s.Failures = s.Failures[over:]
+	}
+}
+
+// SelfAudit challenges random allocations of the blobber every
+// self_audit.frequency seconds, the way the chain does, and verifies the
+// responses with the checks of the validators.
+func SelfAudit(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(config.Configuration.SelfAuditFreq) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			selfAuditRound(ctx)
+		}
+	}
+}
+
+func selfAuditRound(ctx context.Context) {
+	rctx := datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(rctx)
+	var allocs []*allocation.Allocation
+	err := db.Where("allocation_root <> ''").
+		Order("random()").
+		Limit(config.Configuration.SelfAuditNumChallenges).
+		Find(&allocs).Error
+	db.Rollback()
+	if err != nil {
+		Logger.Error("Error getting the allocations to self audit", zap.Error(err))
+		return
+	}
+
+	failures := make([]*SelfAuditFailure, 0)
+	for _, alloc := range allocs {
+		if f := selfAuditAllocation(ctx, alloc, rand.Int63()); f != nil {
+			Logger.Error("Self audit challenge failed",
+				zap.String("allocation_id", f.AllocationID),
+				zap.String("allocation_root", f.AllocationRoot),
+				zap.Int64("seed", f.Seed), zap.Int64("block_num", f.BlockNum),
+				zap.String("path", f.Path), zap.String("error", f.Error))
+			failures = append(failures, f)
+		}
+	}
+	recordSelfAuditRound(len(allocs), failures)
+}
+
+// selfAuditAllocation challenges the allocation of the seed given from its
+// latest redeemed write marker, the oldest root the chain would challenge,
+// and returns the failure if the validators would fail the response.
+func selfAuditAllocation(ctx context.Context, alloc *allocation.Allocation,
+	seed int64) *SelfAuditFailure {
+
+	// no write is committed to the allocation while its files are read
+	mutex := lock.GetMutex(alloc.TableName(), alloc.ID)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	ctx = datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(ctx)
+	defer db.Rollback()
+
+	failure := &SelfAuditFailure{
+		AllocationID: alloc.ID,
+		Seed:         seed,
+		Time:         common.Now(),
+	}
+	fail := func(err error) *SelfAuditFailure {
+		failure.Error = err.Error()
+		return failure
+	}
+
+	// a write may have been committed since the allocation was picked
+	if err := db.Where("id = ?", alloc.ID).First(alloc).Error; err != nil {
+		return fail(err)
+	}
+	startRoot := alloc.LatestRedeemedWM
+	if startRoot == "" {
+		startRoot = alloc.AllocationRoot
+	}
+	failure.AllocationRoot = startRoot
+
+	wms, err := writemarker.GetWriteMarkersInRange(ctx, alloc.ID, startRoot,
+		alloc.AllocationRoot)
+	if err != nil {
+		return fail(err)
+	}
+	req, err := newChallengeRequest(ctx, alloc.ID, selfAuditChallengeID, seed, wms)
+	if err != nil {
+		return fail(err)
+	}
+	failure.BlockNum = req.BlockNum
+	failure.Path, _ = req.ObjectPath.Meta["path"].(string)
+	if err = verifySelfAudit(alloc.ID, startRoot, seed, req); err != nil {
+		return fail(err)
+	}
+	return nil
+}
+
+// verifySelfAudit checks the request the way a validator does, decoded from
+// what would be sent to it.
+func verifySelfAudit(allocationID, allocationRoot string, seed int64,
+	req *challengeRequest) error {
+
+	body, err := json.Marshal(req.Body)
+	if err != nil {
+		return err
+	}
+	vreq := new(validatorstorage.ChallengeRequest)
+	if err = json.Unmarshal(body, vreq); err != nil {
+		return common.NewError("invalid_challenge_request", err.Error())
+	}
+	return vreq.VerifyChallenge(&validatorstorage.Challenge{
+		ID:             selfAuditChallengeID,
+		RandomNumber:   seed,
+		AllocationID:   allocationID,
+		AllocationRoot: allocationRoot,
+	}, &validatorstorage.Allocation{ID: allocationID})
+}
+
+// SelfAuditHandler serves the self audit status, not healthy if the last
+// round found a challenge the validators would fail.
+func SelfAuditHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	return GetSelfAuditStatus(), nil
+}
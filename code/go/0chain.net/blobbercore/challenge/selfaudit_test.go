@@ -0,0 +1,31 @@
+package challenge
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfAuditStatus(t *testing.T) {
+	failures := make([]*SelfAuditFailure, 0, maxSelfAuditFailures+1)
+	for i := 0; i <= maxSelfAuditFailures; i++ {
+		failures = append(failures, &SelfAuditFailure{
+			AllocationID: fmt.Sprintf("alloc-%d", i)})
+	}
+	recordSelfAuditRound(len(failures)+2, failures)
+
+	status := GetSelfAuditStatus()
+	require.False(t, status.Healthy)
+	require.EqualValues(t, maxSelfAuditFailures+1, status.Failed)
+	require.Len(t, status.Failures, maxSelfAuditFailures, "the oldest dropped")
+	require.Equal(t, fmt.Sprintf("alloc-%d", maxSelfAuditFailures),
+		status.Failures[0].AllocationID, "the latest first")
+
+	recordSelfAuditRound(3, nil)
+	status = GetSelfAuditStatus()
+	require.True(t, status.Healthy)
+	require.EqualValues(t, 2, status.Rounds)
+	require.EqualValues(t, maxSelfAuditFailures+6, status.Checked)
+	require.Len(t, status.Failures, maxSelfAuditFailures, "the failures kept")
+}
@@ -0,0 +1,170 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/stats"
+	"0chain.net/core/common"
+	. "0chain.net/core/logging"
+	"0chain.net/core/util"
+
+	"go.uber.org/zap"
+)
+
+// postValidator is the request to a validator, replaced by the tests.
+var postValidator = util.PostRequest
+
+// unknownDeadlineWindow is the time given to the validators of a challenge
+// without a deadline, as much as the default challenge_completion_time.
+const unknownDeadlineWindow = 2 * time.Minute
+
+// Deadline returns when the challenge expires, challenge_completion_time
+// after its creation on chain. It's unknown, false, for the challenges saved
+// before their creation was kept or without a completion time configured.
+func (cr *ChallengeEntity) Deadline() (time.Time, bool) {
+	completion := config.Configuration.ChallengeCompletionTime
+	if cr.Created == 0 || completion <= 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(cr.Created), 0).Add(completion), true
+}
+
+// expired returns true if the challenge can't be answered anymore.
+func (cr *ChallengeEntity) expired(now time.Time) bool {
+	deadline, ok := cr.Deadline()
+	return ok && now.After(deadline)
+}
+
+// hasTicket returns true if the validator of the index already gave its
+// signed ticket for the challenge.
+func (cr *ChallengeEntity) hasTicket(i int) bool {
+	vt := cr.ValidationTickets[i]
+	return vt != nil && len(vt.Signature) > 0 && vt.ChallengeID == cr.ChallengeID
+}
+
+type validatorResponse struct {
+	index  int
+	ticket *ValidationTicket
+}
+
+// collectValidationTickets asks the validators without a ticket for the
+// challenge in parallel, each request bounded by the validator timeout, and
+// asks again the failing ones until the deadline. It returns as soon as a
+// majority of the validators agrees on the result.
+func (cr *ChallengeEntity) collectValidationTickets(ctx context.Context,
+	postData []byte) {
+
+	deadline, ok := cr.Deadline()
+	if !ok {
+		deadline = time.Now().Add(unknownDeadlineWindow)
+	}
+	var (
+		timeout    = time.Duration(config.Configuration.ChallengeValidatorTimeout) * time.Second
+		retryDelay = time.Duration(config.Configuration.ChallengeValidatorRetryDelay) * time.Second
+		quorum     = len(cr.Validators)/2 + 1
+	)
+	// give the validators a chance even for a challenge about to expire
+	if minDeadline := time.Now().Add(timeout); deadline.Before(minDeadline) {
+		deadline = minDeadline
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	var (
+		responses = make(chan validatorResponse, len(cr.Validators))
+		pending   int
+		success   int
+		failure   int
+	)
+	count := func(vt *ValidationTicket) {
+		if vt.Result {
+			success++
+		} else {
+			failure++
+		}
+	}
+	for i := range cr.Validators {
+		if cr.hasTicket(i) {
+			count(cr.ValidationTickets[i])
+			continue
+		}
+		cr.ValidationTickets[i] = nil
+		pending++
+		go func(i int) {
+			responses <- validatorResponse{index: i,
+				ticket: cr.requestTicket(ctx, cr.Validators[i], postData,
+					timeout, retryDelay)}
+		}(i)
+	}
+
+	for ; pending > 0 && success < quorum && failure < quorum; pending-- {
+		resp := <-responses
+		if resp.ticket == nil {
+			continue // the deadline passed
+		}
+		cr.ValidationTickets[resp.index] = resp.ticket
+		count(resp.ticket)
+	}
+}
+
+// requestTicket asks the validator for its ticket until it gives a valid
+// one or the context is done, in which case it returns nil.
+func (cr *ChallengeEntity) requestTicket(ctx context.Context,
+	validator ValidationNode, postData []byte,
+	timeout, retryDelay time.Duration) *ValidationTicket {
+
+	for {
+		start := time.Now()
+		vt, err := cr.postTicketRequest(ctx, validator.URL, postData, timeout)
+		if ctx.Err() != nil {
+			return nil // the deadline error is not of the validator
+		}
+		stats.RecordValidatorRequest(validator.ID, validator.URL,
+			time.Since(start), err)
+		if err == nil {
+			Logger.Info("Got response from the validator.",
+				zap.Any("validator_response", vt))
+			return vt
+		}
+		Logger.Info("Got error from the validator.",
+			zap.String("validator", validator.ID),
+			zap.String("challenge_id", cr.ChallengeID), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(retryDelay):
+		}
+	}
+}
+
+func (cr *ChallengeEntity) postTicketRequest(ctx context.Context,
+	validatorURL string, postData []byte, timeout time.Duration) (
+	*ValidationTicket, error) {
+
+	reqCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resp, err := validatorBatches.post(reqCtx, validatorURL, postData)
+	if err != nil {
+		return nil, err
+	}
+	vt := new(ValidationTicket)
+	if err = json.Unmarshal(resp, vt); err != nil {
+		return nil, common.NewErrorf("invalid_validation_ticket",
+			"decoding the validator response %q: %v", resp, err)
+	}
+	if vt.ChallengeID != cr.ChallengeID {
+		return nil, common.NewError("invalid_validation_ticket",
+			"validation ticket is for another challenge")
+	}
+	verified, err := vt.VerifySign()
+	if err != nil || !verified {
+		return nil, common.NewError("invalid_validation_ticket",
+			"validation ticket from validator could not be verified")
+	}
+	return vt, nil
+}
@@ -0,0 +1,144 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/stats"
+	"0chain.net/core/common"
+	coreconfig "0chain.net/core/config"
+	"0chain.net/core/encryption"
+	"0chain.net/core/logging"
+
+	"github.com/0chain/gosdk/core/zcncrypto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// signedTicket returns the ticket of a validator of a new key for the
+// challenge.
+func signedTicket(t *testing.T, challengeID, validatorID string,
+	result bool) []byte {
+
+	scheme := zcncrypto.NewSignatureScheme("bls0chain")
+	wallet, err := scheme.GenerateKeys()
+	require.NoError(t, err)
+	vt := &ValidationTicket{
+		ChallengeID:  challengeID,
+		BlobberID:    "blobber",
+		ValidatorID:  validatorID,
+		ValidatorKey: wallet.Keys[0].PublicKey,
+		Result:       result,
+		Timestamp:    common.Now(),
+	}
+	vt.Signature, err = scheme.Sign(encryption.Hash(fmt.Sprintf(
+		"%v:%v:%v:%v:%v:%v", vt.ChallengeID, vt.BlobberID, vt.ValidatorID,
+		vt.ValidatorKey, vt.Result, vt.Timestamp)))
+	require.NoError(t, err)
+	b, err := json.Marshal(vt)
+	require.NoError(t, err)
+	return b
+}
+
+func TestCollectValidationTickets(t *testing.T) {
+	logging.Logger = zap.NewNop()
+	coreconfig.Configuration.SignatureScheme = "bls0chain"
+	config.Configuration.ChallengeValidatorTimeout = 1
+	config.Configuration.ChallengeValidatorRetryDelay = 0
+	config.Configuration.ChallengeCompletionTime = time.Minute
+	defer func(post func(context.Context, string, []byte) ([]byte, error)) {
+		postValidator = post
+	}(postValidator)
+
+	newChallenge := func(id string, n int) *ChallengeEntity {
+		cr := &ChallengeEntity{ChallengeID: id, Created: common.Now(),
+			ValidationTickets: make([]*ValidationTicket, n)}
+		for i := 0; i < n; i++ {
+			cr.Validators = append(cr.Validators, ValidationNode{
+				ID: fmt.Sprintf("%s-v%d", id, i), URL: fmt.Sprintf("http://v%d", i)})
+		}
+		return cr
+	}
+	validatorOf := func(url string) string {
+		return strings.TrimSuffix(strings.TrimPrefix(url, "http://"), VALIDATOR_URL)
+	}
+
+	t.Run("quorum without the dead validator", func(t *testing.T) {
+		cr := newChallenge("c1", 3)
+		tickets := map[string][]byte{
+			"v1": signedTicket(t, "c1", "c1-v1", true),
+			"v2": signedTicket(t, "c1", "c1-v2", true),
+		}
+		postValidator = func(ctx context.Context, url string, _ []byte) ([]byte, error) {
+			if b, ok := tickets[validatorOf(url)]; ok {
+				return b, nil
+			}
+			<-ctx.Done() // v0 never answers
+			return nil, ctx.Err()
+		}
+
+		start := time.Now()
+		cr.collectValidationTickets(context.Background(), nil)
+		require.True(t, time.Since(start) < 500*time.Millisecond,
+			"done without waiting for the dead validator")
+		require.Nil(t, cr.ValidationTickets[0])
+		require.NotNil(t, cr.ValidationTickets[1])
+		require.NotNil(t, cr.ValidationTickets[2])
+	})
+
+	t.Run("retry within the deadline", func(t *testing.T) {
+		cr := newChallenge("c2", 1)
+		ticket := signedTicket(t, "c2", "c2-v0", false)
+		var (
+			mu    sync.Mutex
+			calls int
+		)
+		postValidator = func(context.Context, string, []byte) ([]byte, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if calls++; calls < 3 {
+				return nil, errors.New("unavailable")
+			}
+			return ticket, nil
+		}
+
+		cr.collectValidationTickets(context.Background(), nil)
+		require.Equal(t, 3, calls)
+		require.NotNil(t, cr.ValidationTickets[0])
+		require.False(t, cr.ValidationTickets[0].Result)
+
+		var vs *stats.ValidatorStats
+		for _, s := range stats.GetValidatorStats() {
+			if s.ID == "c2-v0" {
+				vs = s
+			}
+		}
+		require.NotNil(t, vs)
+		require.EqualValues(t, 3, vs.Requests)
+		require.EqualValues(t, 2, vs.Failures)
+		require.Equal(t, "unavailable", vs.LastError)
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		cr := newChallenge("c3", 2)
+		cr.Created -= 120 // expired, the validators get a single timeout
+		cr.ValidationTickets[1] = &ValidationTicket{ChallengeID: "c3",
+			Signature: "kept", Result: true}
+		postValidator = func(context.Context, string, []byte) ([]byte, error) {
+			return []byte("{}"), nil // not a valid ticket
+		}
+
+		start := time.Now()
+		cr.collectValidationTickets(context.Background(), nil)
+		require.True(t, time.Since(start) < 2*time.Second)
+		require.Nil(t, cr.ValidationTickets[0])
+		require.Equal(t, "kept", cr.ValidationTickets[1].Signature)
+	})
+}
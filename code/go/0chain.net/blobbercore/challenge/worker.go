@@ -9,12 +9,12 @@ import (
 
 	"0chain.net/blobbercore/config"
 	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/outbox"
 	"0chain.net/core/chain"
 	"0chain.net/core/lock"
 	"0chain.net/core/node"
 	"0chain.net/core/transaction"
 
-	"github.com/remeh/sizedwaitgroup"
 	"gorm.io/gorm"
 
 	. "0chain.net/core/logging"
@@ -27,8 +27,15 @@ type BCChallengeResponse struct {
 }
 
 func SetupWorkers(ctx context.Context) {
+	outbox.RegisterHandler(ResponseTxnKind, &outbox.Handler{
+		OnConfirmed: onResponseConfirmed,
+		OnFailed:    onResponseFailed,
+	})
 	go FindChallenges(ctx)
 	go SubmitProcessedChallenges(ctx) //nolint:errcheck // goroutines
+	if config.Configuration.SelfAuditFreq > 0 {
+		go SelfAudit(ctx)
+	}
 }
 
 func GetValidationTickets(ctx context.Context, challengeObj *ChallengeEntity) error {
@@ -57,52 +64,23 @@ func SubmitProcessedChallenges(ctx context.Context) error {
 				Order("sequence desc").Limit(1).Rows()
 
 			if rows != nil && err == nil {
-				lastSeq := 0
+				var lastSeq int64
 				lastCommitTxn := ""
 				for rows.Next() {
 					if err := rows.Scan(&lastCommitTxn, &lastSeq); err != nil {
 						Logger.Error("Rows_Scan", zap.Error(err))
 					}
 				}
-
-				openchallenges := make([]*ChallengeEntity, 0)
-
-				db.Where(ChallengeEntity{Status: Processed}).
-					Where("sequence > ?", lastSeq).
-					Order("sequence").
-					Find(&openchallenges)
-
-				if len(openchallenges) > 0 {
-					for _, openchallenge := range openchallenges {
-						Logger.Info("Attempting to commit challenge", zap.Any("challenge_id", openchallenge.ChallengeID), zap.Any("openchallenge", openchallenge))
-						if err := openchallenge.UnmarshalFields(); err != nil {
-							Logger.Error("ChallengeEntity_UnmarshalFields", zap.String("challenge_id", openchallenge.ChallengeID), zap.Error(err))
-						}
-						mutex := lock.GetMutex(openchallenge.TableName(), openchallenge.ChallengeID)
-						mutex.Lock()
-						redeemCtx := datastore.GetStore().CreateTransaction(ctx)
-						err := openchallenge.CommitChallenge(redeemCtx, false)
-						if err != nil {
-							Logger.Error("Error committing to blockchain",
-								zap.Error(err),
-								zap.String("challenge_id", openchallenge.ChallengeID))
-						}
-						mutex.Unlock()
-						db := datastore.GetStore().GetTransaction(redeemCtx)
-						db.Commit()
-						if err == nil && openchallenge.Status == Committed {
-							Logger.Info("Challenge has been submitted to blockchain",
-								zap.Any("id", openchallenge.ChallengeID),
-								zap.String("txn", openchallenge.CommitTxnID))
-						} else {
-							Logger.Info("Challenge was not committed", zap.Any("challenge_id", openchallenge.ChallengeID))
-							break
-						}
-					}
-				}
 				db.Rollback()
 				rctx.Done()
 
+				// in the order of the challenges, the ones skipped behind
+				// the last committed can only be verified on the chain
+				if !config.Configuration.ChallengeStrictOrder {
+					lastSeq = 0 // the processed challenges are all submitted
+				}
+				submitProcessed(ctx, lastSeq)
+
 				rctx = datastore.GetStore().CreateTransaction(ctx)
 				db = datastore.GetStore().GetTransaction(rctx)
 				toBeVerifiedChallenges := make([]*ChallengeEntity, 0)
@@ -145,10 +123,13 @@ func SubmitProcessedChallenges(ctx context.Context) error {
 					zap.Error(err))
 			}
 		}
-		time.Sleep(time.Duration(config.Configuration.ChallengeResolveFreq) * time.Second)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-submitNow:
+		case <-time.After(time.Duration(config.Configuration.ChallengeResolveFreq) * time.Second):
+		}
 	}
-
-	return nil //nolint:govet // need more time to verify
 }
 
 var iterInprogress = false
@@ -162,40 +143,6 @@ func FindChallenges(ctx context.Context) {
 		case <-ticker.C:
 			if !iterInprogress {
 				iterInprogress = true
-				rctx := datastore.GetStore().CreateTransaction(ctx)
-				db := datastore.GetStore().GetTransaction(rctx)
-				openchallenges := make([]*ChallengeEntity, 0)
-				db.Where(ChallengeEntity{Status: Accepted}).Find(&openchallenges)
-				if len(openchallenges) > 0 {
-					swg := sizedwaitgroup.New(config.Configuration.ChallengeResolveNumWorkers)
-					for _, openchallenge := range openchallenges {
-						Logger.Info("Processing the challenge", zap.Any("challenge_id", openchallenge.ChallengeID), zap.Any("openchallenge", openchallenge))
-						err := openchallenge.UnmarshalFields()
-						if err != nil {
-							Logger.Error("Error unmarshaling challenge entity.", zap.Error(err))
-							continue
-						}
-						swg.Add()
-						go func(redeemCtx context.Context, challengeEntity *ChallengeEntity) {
-							redeemCtx = datastore.GetStore().CreateTransaction(redeemCtx)
-							defer redeemCtx.Done()
-							err := GetValidationTickets(redeemCtx, challengeEntity)
-							if err != nil {
-								Logger.Error("Getting validation tickets failed", zap.Any("challenge_id", challengeEntity.ChallengeID), zap.Error(err))
-							}
-							db := datastore.GetStore().GetTransaction(redeemCtx)
-							err = db.Commit().Error
-							if err != nil {
-								Logger.Error("Error commiting the readmarker redeem", zap.Error(err))
-							}
-							swg.Done()
-						}(ctx, openchallenge)
-					}
-					swg.Wait()
-				}
-				db.Rollback()
-				rctx.Done()
-
 				params := make(map[string]string)
 				params["blobber"] = node.Self.ID
 
@@ -246,6 +193,8 @@ func FindChallenges(ctx context.Context) {
 					db.Commit()
 					tCtx.Done()
 				}
+
+				processAccepted(ctx)
 				iterInprogress = false
 			}
 		}
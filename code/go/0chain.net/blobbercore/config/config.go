@@ -19,11 +19,31 @@ func SetupDefaultConfig() {
 	viper.SetDefault("openconnection_cleaner.frequency", 30)
 	viper.SetDefault("writemarker_redeem.frequency", 10)
 	viper.SetDefault("writemarker_redeem.num_workers", 5)
+	viper.SetDefault("writemarker_redeem.max_retries", 10)
+	viper.SetDefault("writemarker_redeem.retry_delay", 10)
+	viper.SetDefault("writemarker_redeem.max_retry_delay", 3600)
 	viper.SetDefault("readmarker_redeem.frequency", 10)
 	viper.SetDefault("readmarker_redeem.num_workers", 5)
+	viper.SetDefault("readmarker_redeem.batch", false)
+	viper.SetDefault("readmarker_redeem.batch_size", 20)
+	viper.SetDefault("readmarker_redeem.min_value", 0.0)
+	viper.SetDefault("readmarker_redeem.max_age", 3600)
+	viper.SetDefault("readmarker_redeem.max_pending_blocks", 0)
 	viper.SetDefault("challenge_response.frequency", 10)
 	viper.SetDefault("challenge_response.num_workers", 5)
 	viper.SetDefault("challenge_response.max_retries", 10)
+	viper.SetDefault("challenge_response.validator_timeout", 10)
+	viper.SetDefault("challenge_response.validator_retry_delay", 2)
+	viper.SetDefault("challenge_response.strict_order", false)
+	viper.SetDefault("challenge_response.validator_batch_size", 10)
+	viper.SetDefault("challenge_response.validator_grpc", true)
+	viper.SetDefault("self_audit.frequency", 0)
+	viper.SetDefault("self_audit.num_challenges", 5)
+	viper.SetDefault("txn_confirmation.frequency", 1)
+	viper.SetDefault("txn_confirmation.num_workers", 5)
+	viper.SetDefault("txn_confirmation.initial_delay", 5)
+	viper.SetDefault("txn_confirmation.max_backoff", 60)
+	viper.SetDefault("txn_confirmation.timeout", 300)
 
 	viper.SetDefault("capacity", -1)
 	viper.SetDefault("read_price", 0.0)
@@ -81,11 +101,31 @@ type Config struct {
 	OpenConnectionWorkerTolerance int64
 	WMRedeemFreq                  int64
 	WMRedeemNumWorkers            int
+	WMRedeemMaxRetries            int
+	WMRedeemRetryDelay            int64
+	WMRedeemMaxRetryDelay         int64
 	RMRedeemFreq                  int64
 	RMRedeemNumWorkers            int
+	RMRedeemBatch                 bool
+	RMRedeemBatchSize             int
+	RMRedeemMinValue              int64
+	RMRedeemMaxAge                int64
+	RMRedeemMaxPendingBlocks      int64
 	ChallengeResolveFreq          int64
 	ChallengeResolveNumWorkers    int
 	ChallengeMaxRetires           int
+	ChallengeValidatorTimeout     int64
+	ChallengeValidatorRetryDelay  int64
+	ChallengeStrictOrder          bool
+	ChallengeValidatorBatchSize   int
+	ChallengeValidatorGRPC        bool
+	SelfAuditFreq                 int64
+	SelfAuditNumChallenges        int
+	TxnConfirmFreq                int64
+	TxnConfirmNumWorkers          int
+	TxnConfirmInitialDelay        int64
+	TxnConfirmMaxBackoff          int64
+	TxnConfirmTimeout             int64
 	TempFilesCleanupFreq          int64
 	TempFilesCleanupNumWorkers    int
 	MaxFileSize                   int64
@@ -101,6 +141,16 @@ type Config struct {
 	MinioWorkerFreq int64
 	MinioUseSSL     bool
 
+	// AdminOperatorKeys are the public keys of the clients of all the admin
+	// endpoints and of the gRPC admin service
+	AdminOperatorKeys []string
+	// AdminReaderKeys are the public keys of the clients of the read-only
+	// admin endpoints
+	AdminReaderKeys []string
+	// AdminTokenFile has the bearer tokens of the admin endpoints, a
+	// "name role token" line each
+	AdminTokenFile string
+
 	ReadPrice               float64
 	WritePrice              float64
 	PriceInUSD              bool
@@ -130,6 +180,14 @@ type Config struct {
 /*Configuration of the system */
 var Configuration Config
 
+// Redacted returns a copy of the configuration without the DB credentials,
+// to show it to the admin readers.
+func (c Config) Redacted() Config {
+	c.DBUserName = ""
+	c.DBPassword = ""
+	return c
+}
+
 /*TestNet is the program running in TestNet mode? */
 func TestNet() bool {
 	return Configuration.DeploymentMode == DeploymentTestNet
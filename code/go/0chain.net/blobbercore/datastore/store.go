@@ -2,11 +2,13 @@ package datastore
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
 	"0chain.net/blobbercore/config"
 	"0chain.net/blobbercore/errors"
+	"0chain.net/core/metrics"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -32,6 +34,16 @@ func GetStore() *Store {
 	return &store
 }
 
+func init() {
+	metrics.RegisterDBStats("meta", func() *sql.DB {
+		if store.db == nil {
+			return nil
+		}
+		sqldb, _ := store.db.DB()
+		return sqldb
+	})
+}
+
 func (store *Store) Open() error {
 	db, err := gorm.Open(postgres.Open(fmt.Sprintf(
 		"host=%v port=%v user=%v dbname=%v password=%v sslmode=disable",
@@ -10,10 +10,10 @@ import (
 	"hash"
 	"io"
 	"io/ioutil"
-	"mime/multipart"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	. "0chain.net/core/logging"
 	"go.uber.org/zap"
@@ -131,6 +131,22 @@ func (fs *FileFSStore) GetTempPathSize(allocationID string) (int64, error) {
 	return size, err
 }
 
+// CheckWritable checks a file can be written in the root directory.
+func (fs *FileFSStore) CheckWritable() error {
+	f, err := ioutil.TempFile(fs.RootDirectory, ".writable")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write([]byte("ok"))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if rerr := os.Remove(f.Name()); err == nil {
+		err = rerr
+	}
+	return err
+}
+
 func (fs *FileFSStore) GetTotalDiskSizeUsed() (int64, error) {
 	var size int64
 	err := filepath.Walk(fs.RootDirectory, func(_ string, info os.FileInfo, err error) error {
@@ -476,7 +492,7 @@ func (fs *FileFSStore) GetMerkleTreeForFile(allocationID string, fileData *FileI
 }
 
 func (fs *FileFSStore) WriteFile(allocationID string, fileData *FileInputData,
-	infile multipart.File, connectionID string) (*FileOutputData, error) {
+	infile io.Reader, connectionID string) (*FileOutputData, error) {
 
 	allocation, err := fs.SetupAllocation(allocationID, false)
 	if err != nil {
@@ -601,7 +617,9 @@ func (fs *FileFSStore) IterateObjects(allocationID string, handler FileObjectHan
 }
 
 func (fs *FileFSStore) UploadToCloud(fileHash, filePath string) error {
-	_, err := fs.Minio.FPutObject(MinioConfig.BucketName, fileHash, filePath, minio.PutObjectOptions{})
+	start := time.Now()
+	size, err := fs.Minio.FPutObject(MinioConfig.BucketName, fileHash, filePath, minio.PutObjectOptions{})
+	cloudTransferred(toCloud, size, start, err)
 	if err != nil {
 		return err
 	}
@@ -610,7 +628,16 @@ func (fs *FileFSStore) UploadToCloud(fileHash, filePath string) error {
 }
 
 func (fs *FileFSStore) DownloadFromCloud(fileHash, filePath string) error {
-	return fs.Minio.FGetObject(MinioConfig.BucketName, fileHash, filePath, minio.GetObjectOptions{})
+	start := time.Now()
+	err := fs.Minio.FGetObject(MinioConfig.BucketName, fileHash, filePath, minio.GetObjectOptions{})
+	var size int64
+	if err == nil {
+		if info, serr := os.Stat(filePath); serr == nil {
+			size = info.Size()
+		}
+	}
+	cloudTransferred(fromCloud, size, start, err)
+	return err
 }
 
 func (fs *FileFSStore) RemoveFromCloud(fileHash string) error {
@@ -0,0 +1,43 @@
+package filestore
+
+import (
+	"time"
+
+	"0chain.net/core/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// the directions of the transfers with the cold storage
+const (
+	toCloud   = "upload"
+	fromCloud = "download"
+)
+
+var (
+	cloudTransfers = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blobber_cold_storage_transfers_total",
+		Help: "Files moved to or fetched from the cold storage, by direction and outcome.",
+	}, []string{"direction", "outcome"})
+	cloudTransferBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blobber_cold_storage_transfer_bytes_total",
+		Help: "Bytes of the files moved to or fetched from the cold storage, by direction.",
+	}, []string{"direction"})
+	cloudTransferDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "blobber_cold_storage_transfer_duration_seconds",
+		Help:    "Time transferring a file with the cold storage, by direction.",
+		Buckets: []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"direction"})
+)
+
+// cloudTransferred accounts a transfer with the cold storage of the
+// direction and size given, started at the time given.
+func cloudTransferred(direction string, size int64, start time.Time, err error) {
+	cloudTransfers.WithLabelValues(direction, metrics.Outcome(err)).Inc()
+	if err != nil {
+		return
+	}
+	cloudTransferBytes.WithLabelValues(direction).Add(float64(size))
+	cloudTransferDuration.WithLabelValues(direction).Observe(time.Since(start).Seconds())
+}
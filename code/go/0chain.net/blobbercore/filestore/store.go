@@ -2,7 +2,7 @@ package filestore
 
 import (
 	"encoding/json"
-	"mime/multipart"
+	"io"
 
 	"0chain.net/core/util"
 )
@@ -41,7 +41,7 @@ type FileOutputData struct {
 type FileObjectHandler func(contentHash string, contentSize int64)
 
 type FileStore interface {
-	WriteFile(allocationID string, fileData *FileInputData, infile multipart.File, connectionID string) (*FileOutputData, error)
+	WriteFile(allocationID string, fileData *FileInputData, infile io.Reader, connectionID string) (*FileOutputData, error)
 	DeleteTempFile(allocationID string, fileData *FileInputData, connectionID string) error
 	GetFileBlock(allocationID string, fileData *FileInputData, blockNum int64, numBlocks int64) ([]byte, error)
 	CommitWrite(allocationID string, fileData *FileInputData, connectionID string) (bool, error)
@@ -55,6 +55,7 @@ type FileStore interface {
 	UploadToCloud(fileHash, filePath string) error
 	DownloadFromCloud(fileHash, filePath string) error
 	SetupAllocation(allocationID string, skipCreate bool) (*StoreAllocation, error)
+	CheckWritable() error
 }
 
 var fsStore FileStore
@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	. "0chain.net/core/logging"
+	"go.uber.org/zap"
+)
+
+// AdminRole is what a client of the admin endpoints is allowed to call.
+type AdminRole int
+
+const (
+	// AdminReader calls the read-only diagnostics
+	AdminReader AdminRole = iota + 1
+	// AdminOperator calls the mutating admin endpoints too
+	AdminOperator
+)
+
+func (role AdminRole) String() string {
+	switch role {
+	case AdminReader:
+		return "reader"
+	case AdminOperator:
+		return "operator"
+	}
+	return "none"
+}
+
+func parseAdminRole(s string) (AdminRole, bool) {
+	switch s {
+	case "reader":
+		return AdminReader, true
+	case "operator":
+		return AdminOperator, true
+	}
+	return 0, false
+}
+
+// adminSignatureMaxAge is how far from now, in seconds, the timestamp of a
+// signed admin request may be
+const adminSignatureMaxAge = 5 * 60
+
+// adminClient is the authenticated client of an admin call.
+type adminClient struct {
+	Name string // the client id, or the name of the bearer token
+	Role AdminRole
+}
+
+type adminClientContextKey struct{}
+
+// adminClientFromContext returns the client of the admin request
+// authenticated by WithAdminAuth, for the gateway calling the services in
+// process.
+func adminClientFromContext(ctx context.Context) (*adminClient, bool) {
+	c, ok := ctx.Value(adminClientContextKey{}).(*adminClient)
+	return c, ok
+}
+
+// adminKeyRole returns the role of the public key in the configuration, none
+// if it's of no admin.
+func adminKeyRole(key string) AdminRole {
+	for _, k := range config.Configuration.AdminOperatorKeys {
+		if k == key {
+			return AdminOperator
+		}
+	}
+	for _, k := range config.Configuration.AdminReaderKeys {
+		if k == key {
+			return AdminReader
+		}
+	}
+	return 0
+}
+
+// adminMaxBodySize is the size of the largest body of a signed admin request
+const adminMaxBodySize = 1 << 20
+
+// AdminRequestHash returns the hash the client of an admin request signs: of
+// its method, URI, body and timestamp.
+func AdminRequestHash(method, uri string, body []byte, timestamp string) string {
+	return encryption.Hash(method + ":" + uri + ":" + encryption.Hash(body) + ":" + timestamp)
+}
+
+// authenticateAdmin returns the client of the admin request, by its bearer
+// token or by its signature.
+func authenticateAdmin(r *http.Request) (*adminClient, error) {
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		token := strings.TrimPrefix(auth, "Bearer ")
+		if token == auth || token == "" {
+			return nil, common.NewError("invalid_authorization",
+				"expecting a bearer token")
+		}
+		return adminTokenClient(token)
+	}
+
+	clientID := r.Header.Get(common.ClientHeader)
+	if clientID == "" {
+		return nil, common.NewError("unauthenticated",
+			"the admin endpoints need a bearer token or a signed request")
+	}
+	key := r.Header.Get(common.ClientKeyHeader)
+	sign := r.Header.Get(common.ClientSignatureHeader)
+	timestamp := r.Header.Get(common.TimestampHeader)
+	if key == "" || sign == "" || timestamp == "" {
+		return nil, common.NewError("unauthenticated",
+			"missing client key, signature or timestamp")
+	}
+
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil || encryption.Hash(keyBytes) != clientID {
+		return nil, common.NewError("unauthenticated",
+			"the client key is not the one of the client")
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || !common.Within(ts, adminSignatureMaxAge) {
+		return nil, common.NewError("unauthenticated",
+			"the timestamp of the request is invalid or expired")
+	}
+	// the body is read for its hash and put back for the handler
+	var body []byte
+	if r.Body != nil {
+		body, err = ioutil.ReadAll(io.LimitReader(r.Body, adminMaxBodySize+1))
+		if err != nil || len(body) > adminMaxBodySize {
+			return nil, common.NewError("invalid_request",
+				"the body of the request is unreadable or too large")
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	ok, err := encryption.Verify(key, sign,
+		AdminRequestHash(r.Method, r.URL.RequestURI(), body, timestamp))
+	if err != nil || !ok {
+		return nil, common.NewError("unauthenticated", "invalid signature")
+	}
+	return &adminClient{Name: clientID, Role: adminKeyRole(key)}, nil
+}
+
+// adminTokenClient returns the client of the bearer token by the token file,
+// read each time for the tokens to be changed without a restart.
+func adminTokenClient(token string) (*adminClient, error) {
+	if config.Configuration.AdminTokenFile == "" {
+		return nil, common.NewError("unauthenticated", "no admin token accepted")
+	}
+	f, err := os.Open(config.Configuration.AdminTokenFile)
+	if err != nil {
+		Logger.Error("Opening the admin token file", zap.Error(err))
+		return nil, common.NewError("unauthenticated", "no admin token accepted")
+	}
+	defer f.Close()
+
+	var client *adminClient
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+		role, ok := parseAdminRole(fields[1])
+		if !ok {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(fields[2]), []byte(token)) == 1 && client == nil {
+			client = &adminClient{Name: fields[0], Role: role}
+		}
+	}
+	if client == nil {
+		return nil, common.NewError("unauthenticated", "unknown admin token")
+	}
+	return client, nil
+}
+
+// statusRecorder keeps the status of the response, for the audit log.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (rec *statusRecorder) WriteHeader(status int) {
+	rec.status = status
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func respondAdminError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	if status == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+	w.WriteHeader(status)
+	data := map[string]interface{}{"error": err.Error()}
+	if cerr, ok := err.(*common.Error); ok {
+		data["code"] = cerr.Code
+	}
+	json.NewEncoder(w).Encode(data) //nolint:errcheck // nothing to do of the error
+}
+
+// WithAdminAuth serves the admin endpoint to the clients of the role given,
+// operators calling all of them, writing each call in the audit log.
+func WithAdminAuth(role AdminRole, handler common.ReqRespHandlerf) common.ReqRespHandlerf {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		client, err := authenticateAdmin(r)
+		status := http.StatusUnauthorized
+		if err == nil && client.Role < role {
+			status = http.StatusForbidden
+			err = common.NewErrorf("permission_denied",
+				"the endpoint needs the %v role", role)
+		}
+		if err == nil {
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			ctx := context.WithValue(r.Context(), adminClientContextKey{}, client)
+			handler(rec, r.WithContext(ctx))
+			status = rec.status
+		} else {
+			respondAdminError(w, status, err)
+		}
+
+		fields := []zap.Field{
+			zap.String("method", r.Method),
+			zap.String("uri", r.URL.RequestURI()),
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Int("status", status),
+			zap.Duration("duration", time.Since(start)),
+		}
+		if client != nil {
+			fields = append(fields, zap.String("client", client.Name),
+				zap.Stringer("role", client.Role))
+		}
+		if err != nil {
+			fields = append(fields, zap.Error(err))
+		}
+		AuditLogger.Info("admin call", fields...)
+	}
+}
@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"0chain.net/core/logging"
+	"github.com/0chain/gosdk/core/zcncrypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestWithAdminAuth(t *testing.T) {
+	prevConfig := config.Configuration
+	prevAudit := logging.AuditLogger
+	defer func() {
+		config.Configuration = prevConfig
+		logging.AuditLogger = prevAudit
+	}()
+	core, audit := observer.New(zap.InfoLevel)
+	logging.AuditLogger = zap.New(core)
+
+	operator := zcncrypto.NewBLS0ChainScheme()
+	_, err := operator.GenerateKeys()
+	require.NoError(t, err)
+	reader := zcncrypto.NewBLS0ChainScheme()
+	_, err = reader.GenerateKeys()
+	require.NoError(t, err)
+	other := zcncrypto.NewBLS0ChainScheme()
+	_, err = other.GenerateKeys()
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "admin_auth")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	tokenFile := filepath.Join(dir, "admin_tokens")
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte(
+		"# name role token\nalice operator op-token\nbob reader read-token\n"), 0600))
+	config.Configuration.AdminOperatorKeys = []string{operator.GetPublicKey()}
+	config.Configuration.AdminReaderKeys = []string{reader.GetPublicKey()}
+	config.Configuration.AdminTokenFile = tokenFile
+
+	const body = "allocation=allocation+id"
+	var called bool
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		assert.Equal(t, "allocation id", r.FormValue("allocation"))
+		w.WriteHeader(http.StatusAccepted)
+	}
+
+	signedBody := func(sch zcncrypto.SignatureScheme, at time.Time, body string) func(r *http.Request) {
+		return func(r *http.Request) {
+			keyBytes, err := hex.DecodeString(sch.GetPublicKey())
+			require.NoError(t, err)
+			timestamp := strconv.FormatInt(at.Unix(), 10)
+			sign, err := sch.Sign(AdminRequestHash(r.Method, r.URL.RequestURI(), []byte(body), timestamp))
+			require.NoError(t, err)
+			r.Header.Set(common.ClientHeader, encryption.Hash(keyBytes))
+			r.Header.Set(common.ClientKeyHeader, sch.GetPublicKey())
+			r.Header.Set(common.ClientSignatureHeader, sign)
+			r.Header.Set(common.TimestampHeader, timestamp)
+		}
+	}
+	signed := func(sch zcncrypto.SignatureScheme, at time.Time) func(r *http.Request) {
+		return signedBody(sch, at, body)
+	}
+	bearer := func(token string) func(r *http.Request) {
+		return func(r *http.Request) {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		role       AdminRole
+		auth       func(r *http.Request)
+		wantStatus int
+		wantClient string
+	}{
+		{
+			name:       "anonymous",
+			role:       AdminReader,
+			auth:       func(r *http.Request) {},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "reader key",
+			role:       AdminReader,
+			auth:       signed(reader, time.Now()),
+			wantStatus: http.StatusAccepted,
+			wantClient: encryption.Hash(mustDecodeHex(t, reader.GetPublicKey())),
+		},
+		{
+			name:       "reader key mutating",
+			role:       AdminOperator,
+			auth:       signed(reader, time.Now()),
+			wantStatus: http.StatusForbidden,
+			wantClient: encryption.Hash(mustDecodeHex(t, reader.GetPublicKey())),
+		},
+		{
+			name:       "operator key mutating",
+			role:       AdminOperator,
+			auth:       signed(operator, time.Now()),
+			wantStatus: http.StatusAccepted,
+			wantClient: encryption.Hash(mustDecodeHex(t, operator.GetPublicKey())),
+		},
+		{
+			name:       "expired signature",
+			role:       AdminReader,
+			auth:       signed(operator, time.Now().Add(-time.Hour)),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "body changed",
+			role:       AdminOperator,
+			auth:       signedBody(operator, time.Now(), "allocation=other"),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "key of no admin",
+			role:       AdminReader,
+			auth:       signed(other, time.Now()),
+			wantStatus: http.StatusForbidden,
+			wantClient: encryption.Hash(mustDecodeHex(t, other.GetPublicKey())),
+		},
+		{
+			name:       "reader token",
+			role:       AdminReader,
+			auth:       bearer("read-token"),
+			wantStatus: http.StatusAccepted,
+			wantClient: "bob",
+		},
+		{
+			name:       "reader token mutating",
+			role:       AdminOperator,
+			auth:       bearer("read-token"),
+			wantStatus: http.StatusForbidden,
+			wantClient: "bob",
+		},
+		{
+			name:       "operator token mutating",
+			role:       AdminOperator,
+			auth:       bearer("op-token"),
+			wantStatus: http.StatusAccepted,
+			wantClient: "alice",
+		},
+		{
+			name:       "unknown token",
+			role:       AdminReader,
+			auth:       bearer("name"),
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			called = false
+			audit.TakeAll()
+
+			r := httptest.NewRequest(http.MethodPost, "/_cleanupdisk?dry=1", strings.NewReader(body))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			test.auth(r)
+			w := httptest.NewRecorder()
+			WithAdminAuth(test.role, handler)(w, r)
+
+			assert.Equal(t, test.wantStatus, w.Code)
+			assert.Equal(t, test.wantStatus == http.StatusAccepted, called)
+
+			entries := audit.TakeAll()
+			require.Len(t, entries, 1)
+			fields := entries[0].ContextMap()
+			assert.Equal(t, "/_cleanupdisk?dry=1", fields["uri"])
+			assert.EqualValues(t, test.wantStatus, fields["status"])
+			if test.wantClient != "" {
+				assert.Equal(t, test.wantClient, fields["client"])
+			} else {
+				assert.NotContains(t, fields, "client")
+			}
+		})
+	}
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"io"
+	"path/filepath"
+
+	"0chain.net/blobbercore/filestore"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/core/common"
+)
+
+const (
+	ArchiveFormatTar = "tar"
+	ArchiveFormatZip = "zip"
+
+	// archiveBatchBlocks is the number of blocks read from the file store at once
+	archiveBatchBlocks = 64
+)
+
+// DirectoryArchive is a directory whose read was accounted and which is
+// ready to be streamed. Like block downloads, the entries hold this
+// blobber's data of the files, not the files reassembled.
+type DirectoryArchive struct {
+	AllocationID string
+	Format       string
+	Root         *reference.Ref
+	Refs         []*reference.Ref // everything below Root, parents first
+}
+
+// ContentType of the archive.
+func (a *DirectoryArchive) ContentType() string {
+	if a.Format == ArchiveFormatZip {
+		return "application/zip"
+	}
+	return "application/x-tar"
+}
+
+// FileName suggested to the client for the archive.
+func (a *DirectoryArchive) FileName() string {
+	name := a.Root.Name
+	if a.Root.Path == "/" || len(name) == 0 {
+		name = a.AllocationID
+	}
+	return name + "." + a.Format
+}
+
+// entryName of the ref within the archive, relative to the root.
+func (a *DirectoryArchive) entryName(ref *reference.Ref) string {
+	rel, err := filepath.Rel(a.Root.Path, ref.Path)
+	if err != nil {
+		rel = ref.Name
+	}
+	return filepath.ToSlash(rel)
+}
+
+// Write the archive to w.
+func (a *DirectoryArchive) Write(w io.Writer) error {
+	if a.Format == ArchiveFormatZip {
+		return a.writeZip(w)
+	}
+	return a.writeTar(w)
+}
+
+func (a *DirectoryArchive) writeTar(w io.Writer) error {
+	tw := tar.NewWriter(w)
+	for _, ref := range a.Refs {
+		hdr := &tar.Header{
+			Name:    a.entryName(ref),
+			Mode:    0644,
+			ModTime: ref.UpdatedAt,
+		}
+		if ref.Type == reference.DIRECTORY {
+			hdr.Name += "/"
+			hdr.Mode = 0755
+			hdr.Typeflag = tar.TypeDir
+		} else {
+			hdr.Typeflag = tar.TypeReg
+			hdr.Size = ref.Size
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if ref.Type == reference.FILE {
+			if err := a.copyFile(tw, ref); err != nil {
+				return err
+			}
+		}
+	}
+	return tw.Close()
+}
+
+func (a *DirectoryArchive) writeZip(w io.Writer) error {
+	zw := zip.NewWriter(w)
+	for _, ref := range a.Refs {
+		hdr := &zip.FileHeader{
+			Name:     a.entryName(ref),
+			Method:   zip.Store, // the data is erasure coded, often encrypted
+			Modified: ref.UpdatedAt,
+		}
+		if ref.Type == reference.DIRECTORY {
+			hdr.Name += "/"
+		}
+		fw, err := zw.CreateHeader(hdr)
+		if err != nil {
+			return err
+		}
+		if ref.Type == reference.FILE {
+			if err = a.copyFile(fw, ref); err != nil {
+				return err
+			}
+		}
+	}
+	return zw.Close()
+}
+
+func (a *DirectoryArchive) copyFile(w io.Writer, ref *reference.Ref) error {
+	fileData := &filestore.FileInputData{
+		Name:    ref.Name,
+		Path:    ref.Path,
+		Hash:    ref.ContentHash,
+		OnCloud: ref.OnCloud,
+	}
+	for blockNum := int64(1); blockNum <= ref.NumBlocks; blockNum += archiveBatchBlocks {
+		data, err := filestore.GetFileStore().GetFileBlock(a.AllocationID,
+			fileData, blockNum, archiveBatchBlocks)
+		if err != nil {
+			return common.NewErrorf("archive_read_error",
+				"reading %s: %v", ref.Path, err)
+		}
+		if _, err = w.Write(data); err != nil {
+			return err
+		}
+		downloadBytes.Add(float64(len(data)))
+	}
+	return nil
+}
+
+// flattenRefTree returns the refs below the root, each directory before its
+// children.
+func flattenRefTree(root *reference.Ref) []*reference.Ref {
+	var refs []*reference.Ref
+	for _, child := range root.Children {
+		refs = append(refs, child)
+		refs = append(refs, flattenRefTree(child)...)
+	}
+	return refs
+}
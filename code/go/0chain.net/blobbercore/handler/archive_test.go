@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"0chain.net/blobbercore/filestore"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/core/encryption"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectoryArchiveWrite(t *testing.T) {
+	const allocationID = "archive_test_allocation"
+
+	alloc, err := filestore.GetFileStore().SetupAllocation(allocationID, false)
+	require.NoError(t, err)
+	defer os.RemoveAll(alloc.Path)
+
+	// spans more than one batch of blocks
+	content := bytes.Repeat([]byte("0123456789abcdef"), (archiveBatchBlocks*filestore.CHUNK_SIZE+100)/16)
+	contentHash := encryption.Hash(content)
+	dir, name := filestore.GetFilePathFromHash(contentHash)
+	require.NoError(t, os.MkdirAll(filepath.Join(alloc.ObjectsPath, dir), 0777))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(alloc.ObjectsPath, dir, name), content, 0644))
+
+	root := &reference.Ref{Type: reference.DIRECTORY, Name: "docs", Path: "/docs"}
+	sub := &reference.Ref{Type: reference.DIRECTORY, Name: "sub", Path: "/docs/sub"}
+	file := &reference.Ref{
+		Type:        reference.FILE,
+		Name:        "a.bin",
+		Path:        "/docs/sub/a.bin",
+		ContentHash: contentHash,
+		Size:        int64(len(content)),
+		NumBlocks:   int64((len(content) + filestore.CHUNK_SIZE - 1) / filestore.CHUNK_SIZE),
+	}
+	root.AddChild(sub)
+	sub.AddChild(file)
+
+	archive := &DirectoryArchive{AllocationID: allocationID, Root: root, Refs: flattenRefTree(root)}
+	require.Equal(t, []*reference.Ref{sub, file}, archive.Refs)
+
+	t.Run("tar", func(t *testing.T) {
+		archive.Format = ArchiveFormatTar
+		require.Equal(t, "docs.tar", archive.FileName())
+
+		var buf bytes.Buffer
+		require.NoError(t, archive.Write(&buf))
+
+		tr := tar.NewReader(&buf)
+		hdr, err := tr.Next()
+		require.NoError(t, err)
+		require.Equal(t, "sub/", hdr.Name)
+		require.EqualValues(t, tar.TypeDir, hdr.Typeflag)
+
+		hdr, err = tr.Next()
+		require.NoError(t, err)
+		require.Equal(t, "sub/a.bin", hdr.Name)
+		data, err := ioutil.ReadAll(tr)
+		require.NoError(t, err)
+		require.Equal(t, content, data)
+
+		_, err = tr.Next()
+		require.Equal(t, io.EOF, err)
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		archive.Format = ArchiveFormatZip
+
+		var buf bytes.Buffer
+		require.NoError(t, archive.Write(&buf))
+
+		zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+		require.Len(t, zr.File, 2)
+		require.Equal(t, "sub/", zr.File[0].Name)
+		require.Equal(t, "sub/a.bin", zr.File[1].Name)
+
+		rc, err := zr.File[1].Open()
+		require.NoError(t, err)
+		defer rc.Close()
+		data, err := ioutil.ReadAll(rc)
+		require.NoError(t, err)
+		require.Equal(t, content, data)
+	})
+}
@@ -1,10 +1,15 @@
 package handler
 
 import (
+	"encoding/json"
+
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/blobbergrpc"
+	"0chain.net/blobbercore/challenge"
+	"0chain.net/blobbercore/readmarker"
 	"0chain.net/blobbercore/stats"
 	"0chain.net/blobbercore/writemarker"
+	"0chain.net/core/common"
 )
 
 func AllocationToGRPCAllocation(alloc *allocation.Allocation) *blobbergrpc.Allocation {
@@ -71,3 +76,133 @@ func WriteMarkerToWriteMarkerGRPC(wm writemarker.WriteMarker) *blobbergrpc.Write
 		Signature:              wm.Signature,
 	}
 }
+
+func WriteMarkerGRPCToWriteMarker(wm *blobbergrpc.WriteMarker) writemarker.WriteMarker {
+	return writemarker.WriteMarker{
+		AllocationRoot:         wm.GetAllocationRoot(),
+		PreviousAllocationRoot: wm.GetPreviousAllocationRoot(),
+		AllocationID:           wm.GetAllocationID(),
+		Size:                   wm.GetSize(),
+		BlobberID:              wm.GetBlobberID(),
+		Timestamp:              common.Timestamp(wm.GetTimestamp()),
+		ClientID:               wm.GetClientID(),
+		Signature:              wm.GetSignature(),
+	}
+}
+
+func ReadMarkerToReadMarkerGRPC(rm *readmarker.ReadMarker) *blobbergrpc.ReadMarker {
+	return &blobbergrpc.ReadMarker{
+		ClientID:        rm.ClientID,
+		ClientPublicKey: rm.ClientPublicKey,
+		BlobberID:       rm.BlobberID,
+		AllocationID:    rm.AllocationID,
+		OwnerID:         rm.OwnerID,
+		Timestamp:       int64(rm.Timestamp),
+		ReadCounter:     rm.ReadCounter,
+		Signature:       rm.Signature,
+		Suspend:         rm.Suspend,
+		PayerID:         rm.PayerID,
+	}
+}
+
+func ReadMarkerGRPCToReadMarker(rm *blobbergrpc.ReadMarker) *readmarker.ReadMarker {
+	return &readmarker.ReadMarker{
+		ClientID:        rm.GetClientID(),
+		ClientPublicKey: rm.GetClientPublicKey(),
+		BlobberID:       rm.GetBlobberID(),
+		AllocationID:    rm.GetAllocationID(),
+		OwnerID:         rm.GetOwnerID(),
+		Timestamp:       common.Timestamp(rm.GetTimestamp()),
+		ReadCounter:     rm.GetReadCounter(),
+		Signature:       rm.GetSignature(),
+		Suspend:         rm.GetSuspend(),
+		PayerID:         rm.GetPayerID(),
+	}
+}
+
+func UploadResultToUploadResultGRPC(r *UploadResult) *blobbergrpc.UploadResult {
+	return &blobbergrpc.UploadResult{
+		Filename:    r.Filename,
+		Size:        r.Size,
+		ContentHash: r.Hash,
+		MerkleRoot:  r.MerkleRoot,
+	}
+}
+
+// FileChangeGRPCToUpdateFileChange returns the change of the file meta of an
+// upload, as decoded from the uploadMeta of the REST API.
+func FileChangeGRPCToUpdateFileChange(fc *blobbergrpc.FileChange) (*allocation.UpdateFileChange, error) {
+	change := &allocation.UpdateFileChange{NewFileChange: allocation.NewFileChange{
+		Filename:            fc.Filename,
+		Path:                fc.Path,
+		Hash:                fc.ContentHash,
+		MerkleRoot:          fc.MerkleRoot,
+		ActualHash:          fc.ActualHash,
+		ActualSize:          fc.ActualSize,
+		ThumbnailFilename:   fc.ThumbnailFilename,
+		ThumbnailHash:       fc.ThumbnailHash,
+		ActualThumbnailHash: fc.ActualThumbnailHash,
+		ActualThumbnailSize: fc.ActualThumbnailSize,
+		MimeType:            fc.MimeType,
+		EncryptedKey:        fc.EncryptedKey,
+		CustomMeta:          fc.CustomMeta,
+	}}
+	if len(fc.Attributes) > 0 {
+		if err := json.Unmarshal(fc.Attributes, &change.Attributes); err != nil {
+			return nil, err
+		}
+	}
+	return change, nil
+}
+
+func ChallengeToChallengeGRPC(cr *challenge.ChallengeEntity) *blobbergrpc.Challenge {
+	var tickets []*blobbergrpc.ValidationTicket
+	for _, vt := range cr.ValidationTickets {
+		if vt == nil {
+			continue
+		}
+		tickets = append(tickets, &blobbergrpc.ValidationTicket{
+			ChallengeID:  vt.ChallengeID,
+			BlobberID:    vt.BlobberID,
+			ValidatorID:  vt.ValidatorID,
+			ValidatorKey: vt.ValidatorKey,
+			Result:       vt.Result,
+			Message:      vt.Message,
+			MessageCode:  vt.MessageCode,
+			Timestamp:    int64(vt.Timestamp),
+			Signature:    vt.Signature,
+		})
+	}
+
+	return &blobbergrpc.Challenge{
+		ID:                      cr.ChallengeID,
+		PrevID:                  cr.PrevChallengeID,
+		Seed:                    cr.RandomNumber,
+		AllocationID:            cr.AllocationID,
+		AllocationRoot:          cr.AllocationRoot,
+		RespondedAllocationRoot: cr.RespondedAllocationRoot,
+		Status:                  cr.Status.String(),
+		Result:                  cr.Result.String(),
+		StatusMessage:           cr.StatusMessage,
+		CommitTxnID:             cr.CommitTxnID,
+		BlockNum:                cr.BlockNum,
+		ValidationTickets:       tickets,
+		Created:                 int64(cr.Created),
+	}
+}
+
+func PassRatesToPassRatesGRPC(rates []*challenge.PassRate) []*blobbergrpc.ChallengePassRate {
+	var result []*blobbergrpc.ChallengePassRate
+	for _, pr := range rates {
+		result = append(result, &blobbergrpc.ChallengePassRate{
+			AllocationID: pr.AllocationID,
+			Day:          pr.Day,
+			Total:        pr.Total,
+			Passed:       pr.Passed,
+			Failed:       pr.Failed,
+			Expired:      pr.Expired,
+			Rate:         pr.Rate,
+		})
+	}
+	return result
+}
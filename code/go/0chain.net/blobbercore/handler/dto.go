@@ -1,7 +1,10 @@
 package handler
 
 import (
+	"io"
+
 	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/inclusionproof"
 	"0chain.net/blobbercore/readmarker"
 	"0chain.net/blobbercore/reference"
 	"0chain.net/blobbercore/writemarker"
@@ -19,6 +22,11 @@ type UploadResult struct {
 	UploadOffset int64 `json:"upload_offset"`
 }
 
+// MessageResult is the result of an operation with no other output.
+type MessageResult struct {
+	Msg string `json:"msg"`
+}
+
 type CommitResult struct {
 	AllocationRoot string                         `json:"allocation_root"`
 	WriteMarker    *writemarker.WriteMarker       `json:"write_marker"`
@@ -44,6 +52,13 @@ type ObjectPathResult struct {
 	LatestWM *writemarker.WriteMarker `json:"latest_write_marker"`
 }
 
+type InclusionProofResult struct {
+	*inclusionproof.Proof
+	LatestWM *writemarker.WriteMarker `json:"latest_write_marker"`
+	// ClientKey is the key the latest write marker is signed with
+	ClientKey string `json:"client_key"`
+}
+
 type ListResult struct {
 	AllocationRoot string                   `json:"allocation_root"`
 	Meta           map[string]interface{}   `json:"meta_data"`
@@ -57,3 +72,87 @@ type DownloadResponse struct {
 	Path         string                 `json:"-"`
 	LatestRM     *readmarker.ReadMarker `json:"latest_rm"`
 }
+
+// DownloadRequest is a download of a file of the allocation of the context.
+type DownloadRequest struct {
+	PathHash  string
+	AuthToken string
+	// RxPay makes the client pay for the reads
+	RxPay     bool
+	Thumbnail bool
+}
+
+// FileDownload is a file being downloaded, with who pays for the reads and
+// the auth ticket of the client, nil if it's the owner, the repairer or a
+// reader of the file.
+type FileDownload struct {
+	Alloc      *allocation.Allocation
+	Ref        *reference.Ref
+	ClientID   string
+	PayerID    string
+	AuthTicket *readmarker.AuthTicket
+	// AuthTicketJSON is saved with the read markers of the download
+	AuthTicketJSON string
+	Thumbnail      bool
+}
+
+// WriteFileRequest is an upload, an update or a delete of a file of a
+// connection, from the multipart form of the REST API or the gRPC stream.
+type WriteFileRequest struct {
+	ConnectionID string
+	// Mode is allocation.INSERT_OPERATION, UPDATE_OPERATION or DELETE_OPERATION
+	Mode string
+	// Path is of the file deleted, or PathHash, its lookup hash
+	Path     string
+	PathHash string
+	// Meta is of the file uploaded or updated
+	Meta allocation.UpdateFileChange
+	File io.Reader
+	// Thumbnail is nil if the upload has none
+	Thumbnail         io.Reader
+	ThumbnailFilename string
+}
+
+type CommitRequest struct {
+	ConnectionID string
+	WriteMarker  writemarker.WriteMarker
+}
+
+type RenameObjectRequest struct {
+	ConnectionID string
+	Path         string
+	PathHash     string
+	NewName      string
+}
+
+type CopyObjectRequest struct {
+	ConnectionID string
+	Path         string
+	PathHash     string
+	Dest         string
+}
+
+type UpdateObjectAttributesRequest struct {
+	ConnectionID string
+	Path         string
+	PathHash     string
+	// Attributes is the JSON of the new attributes
+	Attributes string
+}
+
+type CommitMetaTxnRequest struct {
+	Path      string
+	PathHash  string
+	AuthToken string
+	TxnID     string
+}
+
+// CollaboratorRequest adds, lists or removes the collaborators of a path,
+// by the HTTP method.
+type CollaboratorRequest struct {
+	Method   string
+	Path     string
+	PathHash string
+	CollabID string
+	Role     string
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"0chain.net/blobbercore/blobbergrpc"
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/stats"
+	. "0chain.net/core/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// blobberAdminServiceName is the service of the admin calls, audited
+const blobberAdminServiceName = "blobber.service.v1.BlobberAdmin"
+
+// blobberAdminService mirrors the admin endpoints of the REST API for the
+// clients of the admin keys, with the same roles.
+type blobberAdminService struct {
+	blobbergrpc.UnimplementedBlobberAdminServer
+}
+
+func newGRPCBlobberAdminService() *blobberAdminService {
+	return &blobberAdminService{}
+}
+
+// verifyAdmin checks the client of the request, verified by the auth
+// interceptors or by WithAdminAuth for the gateway, is of an admin key of the
+// role given.
+func verifyAdmin(ctx context.Context, role AdminRole) error {
+	if a, ok := adminClientFromContext(ctx); ok {
+		if a.Role < role {
+			return status.Errorf(codes.PermissionDenied, "the call needs the %v role", role)
+		}
+		return nil
+	}
+	c, ok := grpcClientFromContext(ctx)
+	if !ok || c.Key == "" {
+		return status.Error(codes.Unauthenticated, "the admin service needs a signed request")
+	}
+	if adminKeyRole(c.Key) < role {
+		return status.Errorf(codes.PermissionDenied, "the call needs the %v role", role)
+	}
+	return nil
+}
+
+// unaryAdminAuditInterceptor writes the calls of the admin service in the
+// audit log, the ones failing the authentication too.
+func unaryAdminAuditInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isAdminMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		creds := credentialsFromMetadata(ctx)
+		resp, err := handler(ctx, req)
+
+		fields := []zap.Field{
+			zap.String("method", info.FullMethod),
+			zap.String("client", creds.ID),
+			zap.Stringer("role", adminKeyRole(creds.Key)),
+			zap.Stringer("code", status.Code(err)),
+			zap.Duration("duration", time.Since(start)),
+		}
+		if p, ok := peer.FromContext(ctx); ok {
+			fields = append(fields, zap.Stringer("remote_addr", p.Addr))
+		}
+		if err != nil {
+			fields = append(fields, zap.Error(err))
+		}
+		AuditLogger.Info("admin call", fields...)
+		return resp, err
+	}
+}
+
+func (a *blobberAdminService) GetStats(ctx context.Context, req *blobbergrpc.GetStatsRequest) (*blobbergrpc.GetStatsResponse, error) {
+	if err := verifyAdmin(ctx, AdminReader); err != nil {
+		return nil, err
+	}
+
+	statsJSON, err := json.Marshal(stats.LoadBlobberStats(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &blobbergrpc.GetStatsResponse{Stats: statsJSON}, nil
+}
+
+func (a *blobberAdminService) GetConfig(ctx context.Context, req *blobbergrpc.GetConfigRequest) (*blobbergrpc.GetConfigResponse, error) {
+	if err := verifyAdmin(ctx, AdminReader); err != nil {
+		return nil, err
+	}
+
+	configJSON, err := json.Marshal(config.Configuration.Redacted())
+	if err != nil {
+		return nil, err
+	}
+	return &blobbergrpc.GetConfigResponse{Config: configJSON}, nil
+}
+
+func (a *blobberAdminService) CleanupDisk(ctx context.Context, req *blobbergrpc.CleanupDiskRequest) (*blobbergrpc.CleanupDiskResponse, error) {
+	if err := verifyAdmin(ctx, AdminOperator); err != nil {
+		return nil, err
+	}
+
+	if err := CleanupDiskFiles(ctx); err != nil {
+		return nil, err
+	}
+	return &blobbergrpc.CleanupDiskResponse{Msg: "cleanup"}, nil
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"0chain.net/blobbercore/blobbergrpc"
+	"0chain.net/blobbercore/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestBlobberAdminService_Operator(t *testing.T) {
+	prev, prevReaders := config.Configuration.AdminOperatorKeys, config.Configuration.AdminReaderKeys
+	prevPassword := config.Configuration.DBPassword
+	defer func() {
+		config.Configuration.AdminOperatorKeys = prev
+		config.Configuration.AdminReaderKeys = prevReaders
+		config.Configuration.DBPassword = prevPassword
+	}()
+	config.Configuration.AdminOperatorKeys = []string{"operator key"}
+	config.Configuration.AdminReaderKeys = []string{"reader key"}
+	config.Configuration.DBPassword = "db password"
+
+	clientContext := func(key string) context.Context {
+		return context.WithValue(context.Background(), grpcClientContextKey{},
+			&grpcClient{ID: "client", Key: key})
+	}
+	svc := newGRPCBlobberAdminService()
+
+	_, err := svc.GetConfig(context.Background(), &blobbergrpc.GetConfigRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = svc.GetConfig(clientContext(""), &blobbergrpc.GetConfigRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = svc.CleanupDisk(clientContext("other key"), &blobbergrpc.CleanupDiskRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = svc.GetConfig(clientContext("other key"), &blobbergrpc.GetConfigRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = svc.CleanupDisk(clientContext("reader key"), &blobbergrpc.CleanupDiskRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	resp, err := svc.GetConfig(clientContext("reader key"), &blobbergrpc.GetConfigRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, string(resp.Config), `"AdminReaderKeys":["reader key"]`)
+	assert.NotContains(t, string(resp.Config), "db password")
+
+	resp, err = svc.GetConfig(clientContext("operator key"), &blobbergrpc.GetConfigRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, string(resp.Config), `"AdminOperatorKeys":["operator key"]`)
+}
+
+func TestBlobberGRPCService_GetChallengeHistoryAdmin(t *testing.T) {
+	prev := config.Configuration.AdminReaderKeys
+	defer func() { config.Configuration.AdminReaderKeys = prev }()
+	config.Configuration.AdminReaderKeys = []string{"reader key"}
+
+	svc := newGRPCBlobberService(&storageHandler, &packageHandler{})
+	req := &blobbergrpc.GetChallengeHistoryRequest{Allocation: "allocation id"}
+
+	anonymous := context.WithValue(context.Background(), grpcClientContextKey{}, &grpcClient{})
+	_, err := svc.GetChallengeHistory(anonymous, req)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	client := context.WithValue(context.Background(), grpcClientContextKey{},
+		&grpcClient{ID: "client", Key: "other key"})
+	_, err = svc.GetChallengeHistory(client, req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestRunHealthChecks(t *testing.T) {
+	prev := healthChecks
+	defer func() { healthChecks = prev }()
+
+	var chainErr error
+	healthChecks = []healthCheck{
+		{name: "database", check: func(context.Context) error { return nil }},
+		{name: "chain", check: func(context.Context) error { return chainErr }},
+	}
+	servingStatus := func() healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := healthServer.Check(context.Background(),
+			&healthpb.HealthCheckRequest{Service: blobberServiceName})
+		require.NoError(t, err)
+		return resp.Status
+	}
+
+	assert.Empty(t, runHealthChecks(context.Background()))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus())
+
+	chainErr = errors.New("no sharder")
+	failed := runHealthChecks(context.Background())
+	assert.Equal(t, map[string]error{"chain": chainErr}, failed)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus())
+}
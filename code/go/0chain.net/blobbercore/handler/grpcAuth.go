@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"0chain.net/blobbercore/blobbergrpc"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// the metadata keys of the client of a gRPC request, the headers of the REST
+// API lower cased
+var (
+	clientMetadataKey          = strings.ToLower(common.ClientHeader)
+	clientKeyMetadataKey       = strings.ToLower(common.ClientKeyHeader)
+	clientSignatureMetadataKey = strings.ToLower(common.ClientSignatureHeader)
+	timestampMetadataKey       = strings.ToLower(common.TimestampHeader)
+)
+
+// grpcClient is the client of a gRPC request verified by the auth
+// interceptors, empty if the request is anonymous.
+type grpcClient struct {
+	ID  string
+	Key string
+}
+
+type grpcClientContextKey struct{}
+
+// grpcClientFromContext returns the client of the request verified by the
+// auth interceptors, false if the service is called without them.
+func grpcClientFromContext(ctx context.Context) (*grpcClient, bool) {
+	c, ok := ctx.Value(grpcClientContextKey{}).(*grpcClient)
+	return c, ok
+}
+
+// grpcCredentials are the client, key, signature and timestamp of the
+// metadata of a request.
+type grpcCredentials struct {
+	grpcClient
+	Signature string
+	Timestamp string
+}
+
+func credentialsFromMetadata(ctx context.Context) *grpcCredentials {
+	md, _ := metadata.FromIncomingContext(ctx)
+	get := func(key string) string {
+		if v := md.Get(key); len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+	return &grpcCredentials{
+		grpcClient: grpcClient{
+			ID:  get(clientMetadataKey),
+			Key: get(clientKeyMetadataKey),
+		},
+		Signature: get(clientSignatureMetadataKey),
+		Timestamp: get(timestampMetadataKey),
+	}
+}
+
+// RequestHash returns the hash the client of a request signs: of the full
+// method and of the deterministic serialization of the request, the first
+// message of a stream.
+func RequestHash(method string, msg proto.Message) (string, error) {
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	return encryption.Hash(method + ":" + encryption.Hash(body)), nil
+}
+
+// AdminRPCHash returns the hash the client of an admin service call signs:
+// the RequestHash of the call with its timestamp, not to be replayed.
+func AdminRPCHash(method string, msg proto.Message, timestamp string) (string, error) {
+	hash, err := RequestHash(method, msg)
+	if err != nil {
+		return "", err
+	}
+	return encryption.Hash(hash + ":" + timestamp), nil
+}
+
+// blobberAdminMethods are the methods of the Blobber service for the admin
+// clients, signed and audited as the ones of the admin service.
+var blobberAdminMethods = map[string]bool{
+	"/blobber.service.v1.Blobber/GetChallengeHistory": true,
+}
+
+// isAdminMethod tells whether the full method is of the admin service or one
+// of the blobberAdminMethods.
+func isAdminMethod(method string) bool {
+	return strings.HasPrefix(method, "/"+blobberAdminServiceName+"/") || blobberAdminMethods[method]
+}
+
+// requestContext returns the request context of the message, nil if it has
+// none.
+func requestContext(msg interface{}) *blobbergrpc.RequestContext {
+	switch m := msg.(type) {
+	case interface {
+		GetContext() *blobbergrpc.RequestContext
+	}:
+		return m.GetContext()
+	case *blobbergrpc.UploadFileRequest:
+		return m.GetMeta().GetContext()
+	case *blobbergrpc.DownloadFileRequest:
+		return m.GetMeta().GetContext()
+	}
+	return nil
+}
+
+// verify checks the signature of the request is of the client, the one of
+// the key, and that the request context, if any, is of the same client.
+func (c *grpcCredentials) verify(method string, msg interface{}) error {
+	if c.ID == "" {
+		if c.Key != "" || c.Signature != "" {
+			return status.Error(codes.Unauthenticated, "the client key and signature need the client id")
+		}
+		if rc := requestContext(msg); rc.GetClient() != "" {
+			return status.Error(codes.Unauthenticated, "the client of the request context is not authenticated")
+		}
+		return nil // anonymous
+	}
+	if c.Key == "" || c.Signature == "" {
+		return status.Error(codes.Unauthenticated, "missing client key or signature")
+	}
+
+	keyBytes, err := hex.DecodeString(c.Key)
+	if err != nil || encryption.Hash(keyBytes) != c.ID {
+		return status.Error(codes.Unauthenticated, "the client key is not the one of the client")
+	}
+
+	pm, ok := msg.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected request type %T", msg)
+	}
+	var hash string
+	if isAdminMethod(method) {
+		ts, perr := strconv.ParseInt(c.Timestamp, 10, 64)
+		if perr != nil || !common.Within(ts, adminSignatureMaxAge) {
+			return status.Error(codes.Unauthenticated, "the timestamp of the request is invalid or expired")
+		}
+		hash, err = AdminRPCHash(method, pm, c.Timestamp)
+	} else {
+		hash, err = RequestHash(method, pm)
+	}
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "hashing the request: %v", err)
+	}
+	sigOK, err := encryption.Verify(c.Key, c.Signature, hash)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "verifying the signature: %v", err)
+	}
+	if !sigOK {
+		return status.Error(codes.Unauthenticated, "invalid signature")
+	}
+
+	if rc := requestContext(msg); rc != nil {
+		if rc.Client != "" && rc.Client != c.ID {
+			return status.Error(codes.PermissionDenied, "the client of the request context is not the one authenticated")
+		}
+		if rc.ClientKey != "" && rc.ClientKey != c.Key {
+			return status.Error(codes.PermissionDenied, "the client key of the request context is not the one authenticated")
+		}
+	}
+	return nil
+}
+
+// unaryAuthInterceptor verifies the client of the metadata signed the
+// request, and puts it in the context in place of the one of the request
+// context.
+func unaryAuthInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		creds := credentialsFromMetadata(ctx)
+		if err := creds.verify(info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		ctx = context.WithValue(ctx, grpcClientContextKey{}, &creds.grpcClient)
+		return handler(ctx, req)
+	}
+}
+
+// authServerStream verifies the signature of the first message of the
+// stream as it's received.
+type authServerStream struct {
+	*grpc_middleware.WrappedServerStream
+	method   string
+	creds    *grpcCredentials
+	verified bool
+}
+
+func (s *authServerStream) RecvMsg(m interface{}) error {
+	if err := s.WrappedServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if !s.verified {
+		if err := s.creds.verify(s.method, m); err != nil {
+			return err
+		}
+		s.verified = true
+	}
+	return nil
+}
+
+// streamAuthInterceptor is the unaryAuthInterceptor of the streams, the
+// signature being the one of their first message.
+func streamAuthInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		creds := credentialsFromMetadata(ss.Context())
+		stream := &authServerStream{
+			WrappedServerStream: grpc_middleware.WrapServerStream(ss),
+			method:              info.FullMethod,
+			creds:               creds,
+		}
+		stream.WrappedContext = context.WithValue(ss.Context(), grpcClientContextKey{}, &creds.grpcClient)
+		return handler(srv, stream)
+	}
+}
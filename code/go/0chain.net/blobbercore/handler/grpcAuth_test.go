@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/blobbergrpc"
+	"0chain.net/blobbercore/constants"
+	"0chain.net/core/encryption"
+	"github.com/0chain/gosdk/core/zcncrypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	const method = "/blobber.service.v1.Blobber/GetAllocation"
+
+	sch := zcncrypto.NewBLS0ChainScheme()
+	_, err := sch.GenerateKeys()
+	require.NoError(t, err)
+	other := zcncrypto.NewBLS0ChainScheme()
+	_, err = other.GenerateKeys()
+	require.NoError(t, err)
+
+	request := func(client string) *blobbergrpc.GetAllocationRequest {
+		return &blobbergrpc.GetAllocationRequest{
+			Context: &blobbergrpc.RequestContext{Client: client},
+			Id:      "allocation tx",
+		}
+	}
+	// incoming returns the context of the server of the outgoing one given.
+	incoming := func(ctx context.Context) context.Context {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+	signed := func(req *blobbergrpc.GetAllocationRequest) context.Context {
+		return incoming(signedContext(t, sch, method, req))
+	}
+	clientID := func(ctx context.Context) string {
+		md, _ := metadata.FromIncomingContext(ctx)
+		return md.Get(clientMetadataKey)[0]
+	}
+	ownerID := clientID(signed(request("")))
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		req        *blobbergrpc.GetAllocationRequest
+		wantCode   codes.Code
+		wantClient string
+	}{
+		{
+			name:       "signed",
+			ctx:        signed(request(ownerID)),
+			req:        request(ownerID),
+			wantClient: ownerID,
+		},
+		{
+			name:     "signed for another request",
+			ctx:      signed(request("")),
+			req:      request(ownerID),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "key of another client",
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				clientMetadataKey, ownerID,
+				clientKeyMetadataKey, other.GetPublicKey(),
+				clientSignatureMetadataKey, "sign")),
+			req:      request(""),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "no signature",
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				clientMetadataKey, ownerID,
+				clientKeyMetadataKey, sch.GetPublicKey())),
+			req:      request(""),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "request context of another client",
+			ctx:      signed(request("other")),
+			req:      request("other"),
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "anonymous",
+			ctx:  context.Background(),
+			req:  request(""),
+		},
+		{
+			name:     "anonymous claiming a client",
+			ctx:      context.Background(),
+			req:      request(ownerID),
+			wantCode: codes.Unauthenticated,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var handled string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				ctx = setupGRPCHandlerContext(ctx, req.(*blobbergrpc.GetAllocationRequest).Context)
+				handled = ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+				return nil, nil
+			}
+			_, err := unaryAuthInterceptor()(test.ctx, test.req,
+				&grpc.UnaryServerInfo{FullMethod: method}, handler)
+			assert.Equal(t, test.wantCode, status.Code(err))
+			assert.Equal(t, test.wantClient, handled)
+		})
+	}
+}
+
+func TestUnaryAuthInterceptor_Admin(t *testing.T) {
+	sch := zcncrypto.NewBLS0ChainScheme()
+	_, err := sch.GenerateKeys()
+	require.NoError(t, err)
+	keyBytes, err := hex.DecodeString(sch.GetPublicKey())
+	require.NoError(t, err)
+
+	methods := []struct {
+		method string
+		req    proto.Message
+	}{
+		{
+			method: "/" + blobberAdminServiceName + "/CleanupDisk",
+			req:    &blobbergrpc.CleanupDiskRequest{},
+		},
+		{
+			method: "/blobber.service.v1.Blobber/GetChallengeHistory",
+			req:    &blobbergrpc.GetChallengeHistoryRequest{Allocation: "allocation id"},
+		},
+	}
+	for _, m := range methods {
+		method, req := m.method, m.req
+
+		// signed returns the context of a call signed at the time given,
+		// with the timestamp given in the metadata.
+		signed := func(signedAt, sent time.Time) context.Context {
+			hash, err := AdminRPCHash(method, req, strconv.FormatInt(signedAt.Unix(), 10))
+			require.NoError(t, err)
+			sign, err := sch.Sign(hash)
+			require.NoError(t, err)
+			return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				clientMetadataKey, encryption.Hash(keyBytes),
+				clientKeyMetadataKey, sch.GetPublicKey(),
+				clientSignatureMetadataKey, sign,
+				timestampMetadataKey, strconv.FormatInt(sent.Unix(), 10)))
+		}
+		now := time.Now()
+
+		tests := []struct {
+			name     string
+			ctx      context.Context
+			wantCode codes.Code
+		}{
+			{
+				name: "signed now",
+				ctx:  signed(now, now),
+			},
+			{
+				name:     "replayed",
+				ctx:      signed(now.Add(-time.Hour), now.Add(-time.Hour)),
+				wantCode: codes.Unauthenticated,
+			},
+			{
+				name:     "replayed with a new timestamp",
+				ctx:      signed(now.Add(-time.Hour), now),
+				wantCode: codes.Unauthenticated,
+			},
+			{
+				name:     "signed with no timestamp",
+				ctx:      signedContext(t, sch, method, req),
+				wantCode: codes.Unauthenticated,
+			},
+		}
+		for _, test := range tests {
+			t.Run(method+" "+test.name, func(t *testing.T) {
+				ctx := test.ctx
+				if md, ok := metadata.FromOutgoingContext(ctx); ok {
+					ctx = metadata.NewIncomingContext(context.Background(), md)
+				}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return nil, nil
+				}
+				_, err := unaryAuthInterceptor()(ctx, req,
+					&grpc.UnaryServerInfo{FullMethod: method}, handler)
+				assert.Equal(t, test.wantCode, status.Code(err))
+			})
+		}
+	}
+}
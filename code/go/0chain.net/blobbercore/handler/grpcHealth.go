@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/filestore"
+	. "0chain.net/core/logging"
+	"github.com/0chain/gosdk/zcncore"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// blobberServiceName is the service the health service reports of, with
+	// the server as a whole
+	blobberServiceName = "blobber.service.v1.Blobber"
+	// healthCheckInterval is the time between the readiness checks
+	healthCheckInterval = 30 * time.Second
+	// healthCheckTimeout is the time a check has to succeed
+	healthCheckTimeout = 10 * time.Second
+)
+
+// healthServer is the gRPC health service, serving once the readiness checks
+// all succeed.
+var healthServer = newHealthServer()
+
+func newHealthServer() *health.Server {
+	s := health.NewServer()
+	s.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	s.SetServingStatus(blobberServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	return s
+}
+
+// healthCheck is a dependency of the blobber to be ready.
+type healthCheck struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+// healthChecks are the readiness checks of the blobber.
+var healthChecks = []healthCheck{
+	{name: "database", check: checkDatabase},
+	{name: "filestore", check: checkFilestore},
+	{name: "chain", check: checkChain},
+	{name: "registration", check: checkRegistration},
+}
+
+func checkDatabase(ctx context.Context) error {
+	db := datastore.GetStore().GetDB()
+	if db == nil {
+		return errors.New("not connected")
+	}
+	sqldb, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return sqldb.PingContext(ctx)
+}
+
+func checkFilestore(ctx context.Context) error {
+	fs := filestore.GetFileStore()
+	if fs == nil {
+		return errors.New("not set up")
+	}
+	return fs.CheckWritable()
+}
+
+// checkChain checks a sharder answers with its latest finalized block.
+func checkChain(ctx context.Context) error {
+	sharders := zcncore.GetNetwork().Sharders
+	if len(sharders) == 0 {
+		return errors.New("no sharder known")
+	}
+	var err error
+	for _, sharder := range sharders {
+		var req *http.Request
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet,
+			sharder+"/v1/block/get/latest_finalized", nil)
+		if err != nil {
+			continue
+		}
+		var resp *http.Response
+		if resp, err = http.DefaultClient.Do(req); err != nil {
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusOK {
+			return nil
+		}
+		err = errors.New(resp.Status)
+	}
+	return err
+}
+
+func checkRegistration(ctx context.Context) error {
+	if !IsRegistered() {
+		return errors.New("not added on the chain yet")
+	}
+	return nil
+}
+
+// runHealthChecks sets the status of the health service by the readiness
+// checks, returning the failed ones.
+func runHealthChecks(ctx context.Context) map[string]error {
+	failed := make(map[string]error)
+	for _, hc := range healthChecks {
+		cctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		if err := hc.check(cctx); err != nil {
+			failed[hc.name] = err
+		}
+		cancel()
+	}
+
+	status := healthpb.HealthCheckResponse_SERVING
+	if len(failed) > 0 {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	healthServer.SetServingStatus("", status)
+	healthServer.SetServingStatus(blobberServiceName, status)
+	return failed
+}
+
+// HealthCheckWorker runs the readiness checks of the gRPC health service
+// until the context is done.
+func HealthCheckWorker(ctx context.Context) {
+	var wasReady bool
+	for {
+		failed := runHealthChecks(ctx)
+		if len(failed) > 0 {
+			fields := make([]zap.Field, 0, len(failed))
+			for name, err := range failed {
+				fields = append(fields, zap.NamedError(name, err))
+			}
+			Logger.Warn("Blobber not ready", fields...)
+		} else if !wasReady {
+			Logger.Info("Blobber ready")
+		}
+		wasReady = len(failed) == 0
+
+		select {
+		case <-ctx.Done():
+			healthServer.Shutdown()
+			return
+		case <-time.After(healthCheckInterval):
+		}
+	}
+}
@@ -2,16 +2,20 @@ package handler
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	"0chain.net/core/common"
 	"0chain.net/core/logging"
+	"0chain.net/core/metrics"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	grpc_ratelimit "github.com/grpc-ecosystem/go-grpc-middleware/ratelimit"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -42,6 +46,88 @@ func unaryDatabaseTransactionInjector() grpc.UnaryServerInterceptor {
 	}
 }
 
+// streamsWithOwnTransactions are the streams running their steps in
+// transactions of their own, with runInTransaction, as they last as long as
+// the client keeps them open.
+var streamsWithOwnTransactions = map[string]bool{
+	"/blobber.service.v1.Blobber/DownloadFile": true,
+}
+
+// streamDatabaseTransactionInjector runs the stream in a transaction committed
+// once the handler returns, the client getting the commit error, if any, in
+// the status following the response.
+func streamDatabaseTransactionInjector() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if streamsWithOwnTransactions[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		logger := ctxzap.Extract(ss.Context())
+
+		stream := grpc_middleware.WrapServerStream(ss)
+		stream.WrappedContext = GetMetaDataStore().CreateTransaction(ss.Context())
+		ctx := stream.WrappedContext
+
+		err := handler(srv, stream)
+		if err != nil {
+			var rollErr = GetMetaDataStore().GetTransaction(ctx).Rollback().Error
+			if rollErr != nil {
+				logger.Error("couldn't rollback", zap.Error(err))
+			}
+			return err
+		}
+
+		err = GetMetaDataStore().GetTransaction(ctx).Commit().Error
+		if err != nil {
+			return common.NewErrorf("commit_error",
+				"error committing to meta store: %v", err)
+		}
+
+		return nil
+	}
+}
+
+// runInTransaction runs f in a transaction of its own, committed if it
+// succeeds, for a stream to keep the changes of a step whatever the outcome of
+// the following ones.
+func runInTransaction(ctx context.Context, f func(ctx context.Context) error) error {
+	ctx = GetMetaDataStore().CreateTransaction(ctx)
+	if err := f(ctx); err != nil {
+		GetMetaDataStore().GetTransaction(ctx).Rollback()
+		return err
+	}
+
+	err := GetMetaDataStore().GetTransaction(ctx).Commit().Error
+	if err != nil {
+		return common.NewErrorf("commit_error",
+			"error committing to meta store: %v", err)
+	}
+	return nil
+}
+
+// gatewayDatabaseTransactionInjector runs the requests of the gateway, which
+// calls the service in process without the interceptors, in a transaction
+// committed by gatewayCommit.
+func gatewayDatabaseTransactionInjector(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := GetMetaDataStore().CreateTransaction(r.Context())
+		// no-op once committed
+		defer GetMetaDataStore().GetTransaction(ctx).Rollback()
+
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// gatewayCommit commits the transaction of the gateway request before the
+// response is written.
+func gatewayCommit(ctx context.Context, _ http.ResponseWriter, _ proto.Message) error {
+	err := GetMetaDataStore().GetTransaction(ctx).Commit().Error
+	if err != nil {
+		return common.NewErrorf("commit_error",
+			"error committing to meta store: %v", err)
+	}
+	return nil
+}
+
 func unaryTimeoutInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		deadline := time.Now().Add(time.Duration(TIMEOUT_SECONDS * time.Second))
@@ -56,11 +142,18 @@ func NewServerWithMiddlewares(limiter grpc_ratelimit.Limiter) *grpc.Server {
 	return grpc.NewServer(
 		grpc.ChainStreamInterceptor(
 			grpc_zap.StreamServerInterceptor(logging.Logger),
+			metrics.StreamServerInterceptor(),
 			grpc_recovery.StreamServerInterceptor(),
+			streamAuthInterceptor(),
+			streamDatabaseTransactionInjector(),
+			grpc_ratelimit.StreamServerInterceptor(limiter),
 		),
 		grpc.ChainUnaryInterceptor(
 			grpc_zap.UnaryServerInterceptor(logging.Logger),
+			metrics.UnaryServerInterceptor(),
 			grpc_recovery.UnaryServerInterceptor(),
+			unaryAdminAuditInterceptor(),
+			unaryAuthInterceptor(),
 			unaryDatabaseTransactionInjector(),
 			grpc_ratelimit.UnaryServerInterceptor(limiter),
 			unaryTimeoutInterceptor(), // should always be the lastest, to be "innermost"
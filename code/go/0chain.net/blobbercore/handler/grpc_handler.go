@@ -3,8 +3,12 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"io"
+	"net/http"
 	"strconv"
 
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/challenge"
 	"0chain.net/blobbercore/writemarker"
 
 	"0chain.net/blobbercore/reference"
@@ -85,12 +89,12 @@ func (b *blobberGRPCService) GetFileMetaData(ctx context.Context, req *blobbergr
 
 	// authorize file access
 	var (
-		isOwner          = clientID == alloc.OwnerID
-		isRepairer       = clientID == alloc.RepairerID
-		isCollaborator   = b.packageHandler.IsACollaborator(ctx, fileref.ID, clientID)
+		isOwner    = clientID == alloc.OwnerID
+		isRepairer = clientID == alloc.RepairerID
 	)
 
-	if !isOwner && !isRepairer && !isCollaborator {
+	if !isOwner && !isRepairer &&
+		!b.packageHandler.HasCollaboratorRole(ctx, alloc.ID, fileref.Path, clientID, reference.CollaboratorReader) {
 		// check auth token
 		if isAuthorized, err := b.storageHandler.verifyAuthTicket(ctx,
 			req.AuthToken, alloc, fileref, clientID,
@@ -107,6 +111,7 @@ func (b *blobberGRPCService) GetFileMetaData(ctx context.Context, req *blobbergr
 		collaboratorsGRPC = append(collaboratorsGRPC, &blobbergrpc.Collaborator{
 			RefId:     c.RefID,
 			ClientId:  c.ClientID,
+			Role:      string(c.Role),
 			CreatedAt: c.CreatedAt.UnixNano(),
 		})
 	}
@@ -400,3 +405,427 @@ func (b *blobberGRPCService) GetObjectTree(ctx context.Context, req *blobbergrpc
 	}
 	return &refPathResult, nil
 }
+
+func (b *blobberGRPCService) GetChallengeHistory(ctx context.Context, req *blobbergrpc.GetChallengeHistoryRequest) (*blobbergrpc.GetChallengeHistoryResponse, error) {
+	// the history is of the admin endpoints, as /_challenges
+	if err := verifyAdmin(ctx, AdminReader); err != nil {
+		return nil, err
+	}
+
+	filter := &challenge.HistoryFilter{
+		AllocationID:    req.Allocation,
+		From:            common.Timestamp(req.From),
+		To:              common.Timestamp(req.To),
+		ValidatorID:     req.Validator,
+		ValidatorResult: req.ValidatorResult,
+		Offset:          int(req.Offset),
+		Limit:           int(req.Limit),
+	}
+	if req.Status != "" {
+		status, err := challenge.ParseChallengeStatus(req.Status)
+		if err != nil {
+			return nil, err
+		}
+		filter.Status = &status
+	}
+	if req.Result != "" {
+		result, err := challenge.ParseChallengeResult(req.Result)
+		if err != nil {
+			return nil, err
+		}
+		filter.Result = &result
+	}
+
+	history, err := challenge.GetHistory(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp blobbergrpc.GetChallengeHistoryResponse
+	resp.Total = history.Total
+	resp.Offset = int64(history.Offset)
+	resp.Limit = int64(history.Limit)
+	for _, cr := range history.Challenges {
+		resp.Challenges = append(resp.Challenges, ChallengeToChallengeGRPC(cr))
+	}
+	resp.Allocations = PassRatesToPassRatesGRPC(history.Allocations)
+	resp.Days = PassRatesToPassRatesGRPC(history.Days)
+	return &resp, nil
+}
+
+// uploadStream reads the messages of an upload, the next one kept unread for
+// the file chunks to end at the first thumbnail one.
+type uploadStream struct {
+	stream blobbergrpc.Blobber_UploadFileServer
+	next   *blobbergrpc.UploadFileRequest
+	err    error
+}
+
+func (us *uploadStream) peek() (*blobbergrpc.UploadFileRequest, error) {
+	if us.next == nil && us.err == nil {
+		us.next, us.err = us.stream.Recv()
+	}
+	return us.next, us.err
+}
+
+// end checks that no message of the upload is left unread.
+func (us *uploadStream) end() error {
+	_, err := us.peek()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return common.NewError("invalid_parameters",
+		"Unexpected upload message. Thumbnail chunks need a thumbnail filename")
+}
+
+// uploadChunkReader reads the file, or the thumbnail, chunks of the upload.
+type uploadChunkReader struct {
+	us        *uploadStream
+	thumbnail bool
+	chunk     []byte
+}
+
+func (r *uploadChunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		msg, err := r.us.peek()
+		if err != nil {
+			return 0, err
+		}
+		switch m := msg.Request.(type) {
+		case *blobbergrpc.UploadFileRequest_FileChunk:
+			if r.thumbnail {
+				return 0, common.NewError("invalid_parameters",
+					"File chunk after the thumbnail ones")
+			}
+			r.chunk = m.FileChunk
+		case *blobbergrpc.UploadFileRequest_ThumbnailChunk:
+			if !r.thumbnail {
+				return 0, io.EOF
+			}
+			r.chunk = m.ThumbnailChunk
+		default:
+			return 0, common.NewError("invalid_parameters",
+				"The upload meta should be sent once, first")
+		}
+		r.us.next = nil
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+func (b *blobberGRPCService) UploadFile(stream blobbergrpc.Blobber_UploadFileServer) error {
+	msg, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		return err
+	}
+	meta := msg.GetMeta()
+	if meta == nil || meta.File == nil {
+		return common.NewError("invalid_parameters",
+			"The upload should start with its meta and the one of the file")
+	}
+
+	ctx := setupGRPCWriteContext(stream.Context(), meta.Context, meta.Allocation)
+	alloc, err := b.storageHandler.writeAccess(ctx)
+	if err != nil {
+		return err
+	}
+
+	change, err := FileChangeGRPCToUpdateFileChange(meta.File)
+	if err != nil {
+		return common.NewError("invalid_parameters",
+			"Invalid parameters. Error parsing the meta data for upload."+err.Error())
+	}
+	req := &WriteFileRequest{
+		ConnectionID: meta.ConnectionId,
+		Mode:         allocation.INSERT_OPERATION,
+		Meta:         *change,
+	}
+	if meta.Update {
+		req.Mode = allocation.UPDATE_OPERATION
+	}
+	us := &uploadStream{stream: stream}
+	req.File = &uploadChunkReader{us: us}
+	if change.ThumbnailFilename != "" {
+		req.Thumbnail = &uploadChunkReader{us: us, thumbnail: true}
+		req.ThumbnailFilename = change.ThumbnailFilename
+	}
+
+	result, err := b.storageHandler.writeFile(ctx, alloc, req)
+	if err != nil {
+		return err
+	}
+	if err = us.end(); err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&blobbergrpc.UploadFileResponse{
+		Result: UploadResultToUploadResultGRPC(result),
+	})
+}
+
+func (b *blobberGRPCService) DeleteFile(ctx context.Context, req *blobbergrpc.DeleteFileRequest) (*blobbergrpc.DeleteFileResponse, error) {
+	ctx = setupGRPCWriteContext(ctx, req.Context, req.Allocation)
+	alloc, err := b.storageHandler.writeAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := b.storageHandler.writeFile(ctx, alloc, &WriteFileRequest{
+		ConnectionID: req.ConnectionId,
+		Mode:         allocation.DELETE_OPERATION,
+		Path:         req.Path,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &blobbergrpc.DeleteFileResponse{Result: UploadResultToUploadResultGRPC(result)}, nil
+}
+
+func (b *blobberGRPCService) RenameObject(ctx context.Context, req *blobbergrpc.RenameObjectRequest) (*blobbergrpc.RenameObjectResponse, error) {
+	ctx = setupGRPCWriteContext(ctx, req.Context, req.Allocation)
+	result, err := b.storageHandler.renameObject(ctx, &RenameObjectRequest{
+		ConnectionID: req.ConnectionId,
+		Path:         req.Path,
+		PathHash:     req.PathHash,
+		NewName:      req.NewName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &blobbergrpc.RenameObjectResponse{Result: UploadResultToUploadResultGRPC(result)}, nil
+}
+
+func (b *blobberGRPCService) CopyObject(ctx context.Context, req *blobbergrpc.CopyObjectRequest) (*blobbergrpc.CopyObjectResponse, error) {
+	ctx = setupGRPCWriteContext(ctx, req.Context, req.Allocation)
+	result, err := b.storageHandler.copyObject(ctx, &CopyObjectRequest{
+		ConnectionID: req.ConnectionId,
+		Path:         req.Path,
+		PathHash:     req.PathHash,
+		Dest:         req.Dest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &blobbergrpc.CopyObjectResponse{Result: UploadResultToUploadResultGRPC(result)}, nil
+}
+
+func (b *blobberGRPCService) UpdateObjectAttributes(ctx context.Context, req *blobbergrpc.UpdateObjectAttributesRequest) (*blobbergrpc.UpdateObjectAttributesResponse, error) {
+	ctx = setupGRPCWriteContext(ctx, req.Context, req.Allocation)
+	attrs, err := b.storageHandler.updateObjectAttributes(ctx, &UpdateObjectAttributesRequest{
+		ConnectionID: req.ConnectionId,
+		Path:         req.Path,
+		PathHash:     req.PathHash,
+		Attributes:   string(req.Attributes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	attrsBytes, err := json.Marshal(attrs)
+	if err != nil {
+		return nil, err
+	}
+	return &blobbergrpc.UpdateObjectAttributesResponse{Attributes: attrsBytes}, nil
+}
+
+func (b *blobberGRPCService) CommitConnection(ctx context.Context, req *blobbergrpc.CommitConnectionRequest) (*blobbergrpc.CommitConnectionResponse, error) {
+	ctx = setupGRPCWriteContext(ctx, req.Context, req.Allocation)
+	result, err := b.storageHandler.commitWrite(ctx, &CommitRequest{
+		ConnectionID: req.ConnectionId,
+		WriteMarker:  WriteMarkerGRPCToWriteMarker(req.WriteMarker),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &blobbergrpc.CommitConnectionResponse{
+		AllocationRoot: result.AllocationRoot,
+		Success:        result.Success,
+		ErrorMessage:   result.ErrorMessage,
+	}
+	if result.WriteMarker != nil {
+		resp.WriteMarker = WriteMarkerToWriteMarkerGRPC(*result.WriteMarker)
+	}
+	return resp, nil
+}
+
+func (b *blobberGRPCService) AddCommitMetaTxn(ctx context.Context, req *blobbergrpc.AddCommitMetaTxnRequest) (*blobbergrpc.AddCommitMetaTxnResponse, error) {
+	ctx = setupGRPCWriteContext(ctx, req.Context, req.Allocation)
+	result, err := b.storageHandler.addCommitMetaTxn(ctx, &CommitMetaTxnRequest{
+		Path:      req.Path,
+		PathHash:  req.PathHash,
+		AuthToken: req.AuthToken,
+		TxnID:     req.TxnId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &blobbergrpc.AddCommitMetaTxnResponse{Msg: result.Msg}, nil
+}
+
+func (b *blobberGRPCService) AddCollaborator(ctx context.Context, req *blobbergrpc.AddCollaboratorRequest) (*blobbergrpc.AddCollaboratorResponse, error) {
+	ctx = setupGRPCWriteContext(ctx, req.Context, req.Allocation)
+	result, err := b.storageHandler.collaborator(ctx, &CollaboratorRequest{
+		Method:   http.MethodPost,
+		Path:     req.Path,
+		PathHash: req.PathHash,
+		CollabID: req.CollabId,
+		Role:     req.Role,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &blobbergrpc.AddCollaboratorResponse{Msg: result.(*MessageResult).Msg}, nil
+}
+
+func (b *blobberGRPCService) RemoveCollaborator(ctx context.Context, req *blobbergrpc.RemoveCollaboratorRequest) (*blobbergrpc.RemoveCollaboratorResponse, error) {
+	ctx = setupGRPCWriteContext(ctx, req.Context, req.Allocation)
+	result, err := b.storageHandler.collaborator(ctx, &CollaboratorRequest{
+		Method:   http.MethodDelete,
+		Path:     req.Path,
+		PathHash: req.PathHash,
+		CollabID: req.CollabId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &blobbergrpc.RemoveCollaboratorResponse{Msg: result.(*MessageResult).Msg}, nil
+}
+
+// downloadChunkBlocks is the number of blocks of a download sent in a
+// message, the data of a read marker split to keep them small.
+const downloadChunkBlocks = 16
+
+func (b *blobberGRPCService) DownloadFile(stream blobbergrpc.Blobber_DownloadFileServer) error {
+	msg, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		return err
+	}
+	meta := msg.GetMeta()
+	if meta == nil {
+		return common.NewError("invalid_parameters",
+			"The download should start with its meta")
+	}
+	if meta.BlockNum < 1 || meta.NumBlocks < 0 {
+		return common.NewError("download_file", "invalid block range")
+	}
+
+	// the stream has no transaction, the steps have short ones of their own
+	// not to keep one open as long as the client takes to read the blocks
+	ctx := setupGRPCWriteContext(stream.Context(), meta.Context, meta.Allocation)
+	var alloc *allocation.Allocation
+	err = runInTransaction(ctx, func(ctx context.Context) (err error) {
+		alloc, err = b.storageHandler.downloadAccess(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	pathHash, err := lookupPathHash(alloc.ID, meta.Path, meta.PathHash)
+	if err != nil {
+		return common.NewError("download_file", "invalid path")
+	}
+
+	var download *FileDownload
+	next, end := meta.BlockNum, meta.BlockNum+meta.NumBlocks
+	for next < end {
+		if msg.ReadMarker == nil || msg.NumBlocks <= 0 || next+msg.NumBlocks > end {
+			return common.NewError("invalid_parameters",
+				"Each message of the download should have the read marker of blocks of its range")
+		}
+		readMarker := ReadMarkerGRPCToReadMarker(msg.ReadMarker)
+
+		// the read marker is saved before its blocks are sent, as the
+		// REST API does, whatever happens to the rest of the download
+		var resp *DownloadResponse
+		err = runInTransaction(ctx, func(ctx context.Context) (err error) {
+			if err = b.storageHandler.verifyReadMarker(ctx, alloc, readMarker); err != nil {
+				return err
+			}
+			if download == nil {
+				download, err = b.storageHandler.openDownload(ctx, alloc, &DownloadRequest{
+					PathHash:  pathHash,
+					AuthToken: meta.AuthToken,
+					RxPay:     meta.RxPay,
+					Thumbnail: meta.Content == DOWNLOAD_CONTENT_THUMB,
+				})
+				if err != nil {
+					return err
+				}
+			}
+			resp, err = b.storageHandler.readBlocks(ctx, download, readMarker, next, msg.NumBlocks)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		if !resp.Success {
+			// the client is to send the read marker of the blocks again
+			err = stream.Send(&blobbergrpc.DownloadFileResponse{
+				LatestRM: ReadMarkerToReadMarkerGRPC(resp.LatestRM),
+			})
+		} else {
+			err = sendDownloadData(stream, next, resp)
+			next += msg.NumBlocks
+		}
+		if err != nil {
+			return err
+		}
+		if next == end {
+			break
+		}
+
+		msg, err = stream.Recv()
+		if err == io.EOF {
+			return nil // the client stopped the download
+		}
+		if err != nil {
+			return err
+		}
+		if msg.Meta != nil {
+			return common.NewError("invalid_parameters",
+				"The download meta should be sent once, first")
+		}
+	}
+	return nil
+}
+
+// sendDownloadData sends the data of the blocks read from the one given, in
+// messages of downloadChunkBlocks blocks.
+func sendDownloadData(stream blobbergrpc.Blobber_DownloadFileServer,
+	blockNum int64, resp *DownloadResponse) error {
+
+	const chunkSize = downloadChunkBlocks * reference.CHUNK_SIZE
+	data := resp.Data
+	for {
+		n := len(data)
+		if n > chunkSize {
+			n = chunkSize
+		}
+		err := stream.Send(&blobbergrpc.DownloadFileResponse{
+			Success:  true,
+			BlockNum: blockNum,
+			Data:     data[:n],
+			LatestRM: ReadMarkerToReadMarkerGRPC(resp.LatestRM),
+		})
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		if len(data) == 0 {
+			return nil
+		}
+		blockNum += downloadChunkBlocks
+	}
+}
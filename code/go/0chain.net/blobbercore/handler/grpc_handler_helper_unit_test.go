@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/readmarker"
 	"0chain.net/blobbercore/reference"
 	"github.com/stretchr/testify/mock"
 )
@@ -56,3 +57,270 @@ func (_m *storageHandlerI) verifyAuthTicket(ctx context.Context, authTokenString
 
 	return r0, r1
 }
+
+// writeAccess provides a mock function with given fields: ctx
+func (_m *storageHandlerI) writeAccess(ctx context.Context) (*allocation.Allocation, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *allocation.Allocation
+	if rf, ok := ret.Get(0).(func(context.Context) *allocation.Allocation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*allocation.Allocation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// writeFile provides a mock function with given fields: ctx, allocationObj, req
+func (_m *storageHandlerI) writeFile(ctx context.Context, allocationObj *allocation.Allocation, req *WriteFileRequest) (*UploadResult, error) {
+	ret := _m.Called(ctx, allocationObj, req)
+
+	var r0 *UploadResult
+	if rf, ok := ret.Get(0).(func(context.Context, *allocation.Allocation, *WriteFileRequest) *UploadResult); ok {
+		r0 = rf(ctx, allocationObj, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UploadResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *allocation.Allocation, *WriteFileRequest) error); ok {
+		r1 = rf(ctx, allocationObj, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// commitWrite provides a mock function with given fields: ctx, req
+func (_m *storageHandlerI) commitWrite(ctx context.Context, req *CommitRequest) (*CommitResult, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *CommitResult
+	if rf, ok := ret.Get(0).(func(context.Context, *CommitRequest) *CommitResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CommitResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *CommitRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// renameObject provides a mock function with given fields: ctx, req
+func (_m *storageHandlerI) renameObject(ctx context.Context, req *RenameObjectRequest) (*UploadResult, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *UploadResult
+	if rf, ok := ret.Get(0).(func(context.Context, *RenameObjectRequest) *UploadResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UploadResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *RenameObjectRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// copyObject provides a mock function with given fields: ctx, req
+func (_m *storageHandlerI) copyObject(ctx context.Context, req *CopyObjectRequest) (*UploadResult, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *UploadResult
+	if rf, ok := ret.Get(0).(func(context.Context, *CopyObjectRequest) *UploadResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UploadResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *CopyObjectRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// updateObjectAttributes provides a mock function with given fields: ctx, req
+func (_m *storageHandlerI) updateObjectAttributes(ctx context.Context, req *UpdateObjectAttributesRequest) (*reference.Attributes, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *reference.Attributes
+	if rf, ok := ret.Get(0).(func(context.Context, *UpdateObjectAttributesRequest) *reference.Attributes); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reference.Attributes)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *UpdateObjectAttributesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// addCommitMetaTxn provides a mock function with given fields: ctx, req
+func (_m *storageHandlerI) addCommitMetaTxn(ctx context.Context, req *CommitMetaTxnRequest) (*MessageResult, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *MessageResult
+	if rf, ok := ret.Get(0).(func(context.Context, *CommitMetaTxnRequest) *MessageResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MessageResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *CommitMetaTxnRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// collaborator provides a mock function with given fields: ctx, req
+func (_m *storageHandlerI) collaborator(ctx context.Context, req *CollaboratorRequest) (interface{}, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func(context.Context, *CollaboratorRequest) interface{}); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *CollaboratorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// downloadAccess provides a mock function with given fields: ctx
+func (_m *storageHandlerI) downloadAccess(ctx context.Context) (*allocation.Allocation, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *allocation.Allocation
+	if rf, ok := ret.Get(0).(func(context.Context) *allocation.Allocation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*allocation.Allocation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// verifyReadMarker provides a mock function with given fields: ctx, alloc, readMarker
+func (_m *storageHandlerI) verifyReadMarker(ctx context.Context, alloc *allocation.Allocation, readMarker *readmarker.ReadMarker) error {
+	ret := _m.Called(ctx, alloc, readMarker)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *allocation.Allocation, *readmarker.ReadMarker) error); ok {
+		r0 = rf(ctx, alloc, readMarker)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// openDownload provides a mock function with given fields: ctx, alloc, req
+func (_m *storageHandlerI) openDownload(ctx context.Context, alloc *allocation.Allocation, req *DownloadRequest) (*FileDownload, error) {
+	ret := _m.Called(ctx, alloc, req)
+
+	var r0 *FileDownload
+	if rf, ok := ret.Get(0).(func(context.Context, *allocation.Allocation, *DownloadRequest) *FileDownload); ok {
+		r0 = rf(ctx, alloc, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*FileDownload)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *allocation.Allocation, *DownloadRequest) error); ok {
+		r1 = rf(ctx, alloc, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// readBlocks provides a mock function with given fields: ctx, download, readMarker, blockNum, numBlocks
+func (_m *storageHandlerI) readBlocks(ctx context.Context, download *FileDownload, readMarker *readmarker.ReadMarker, blockNum int64, numBlocks int64) (*DownloadResponse, error) {
+	ret := _m.Called(ctx, download, readMarker, blockNum, numBlocks)
+
+	var r0 *DownloadResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *FileDownload, *readmarker.ReadMarker, int64, int64) *DownloadResponse); ok {
+		r0 = rf(ctx, download, readMarker, blockNum, numBlocks)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*DownloadResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *FileDownload, *readmarker.ReadMarker, int64, int64) error); ok {
+		r1 = rf(ctx, download, readMarker, blockNum, numBlocks)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
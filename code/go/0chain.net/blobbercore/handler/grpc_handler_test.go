@@ -2,8 +2,11 @@ package handler
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
+	"io"
 	"net"
+	"os"
 	"regexp"
 	"testing"
 	"time"
@@ -14,13 +17,17 @@ import (
 	"0chain.net/blobbercore/blobbergrpc"
 	"0chain.net/blobbercore/datastore"
 	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"github.com/0chain/gosdk/core/zcncrypto"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
 )
 
@@ -54,6 +61,29 @@ func makeTestClient() (blobbergrpc.BlobberClient, *grpc.ClientConn, error) {
 	return blobbergrpc.NewBlobberClient(conn), conn, err
 }
 
+// signedContext returns the context of a request of the client of the
+// scheme, with the credentials of its first message in the metadata.
+func signedContext(t *testing.T, sch zcncrypto.SignatureScheme, method string,
+	msg proto.Message) context.Context {
+
+	keyBytes, err := hex.DecodeString(sch.GetPublicKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := RequestHash(method, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign, err := sch.Sign(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return metadata.AppendToOutgoingContext(context.Background(),
+		clientMetadataKey, encryption.Hash(keyBytes),
+		clientKeyMetadataKey, sch.GetPublicKey(),
+		clientSignatureMetadataKey, sign)
+}
+
 func makeTestAllocation(exp common.Timestamp) *allocation.Allocation {
 	allocID := "allocation id"
 	alloc := allocation.Allocation{
@@ -249,3 +279,162 @@ func Test_GetAllocation(t *testing.T) {
 		)
 	}
 }
+
+func Test_UploadFile(t *testing.T) {
+	setup(t)
+
+	startGRPCServer(t)
+
+	grpcCl, conn, err := makeTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	sch := zcncrypto.NewBLS0ChainScheme()
+	if _, err := sch.GenerateKeys(); err != nil {
+		t.Fatal(err)
+	}
+	ts := time.Now().Add(time.Hour)
+	alloc := makeTestAllocation(common.Timestamp(ts.Unix()))
+	alloc.OwnerPublicKey = sch.GetPublicKey()
+	keyBytes, err := hex.DecodeString(sch.GetPublicKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	alloc.OwnerID = encryption.Hash(keyBytes)
+
+	sign, err := sch.Sign(encryption.Hash(alloc.Tx))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const connectionID = "connection id"
+
+	allocationMockSetup := func(mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "allocations" WHERE`)).
+			WithArgs(alloc.Tx).
+			WillReturnRows(
+				sqlmock.NewRows([]string{"id", "tx", "expiration_date", "owner_public_key", "owner_id", "blobber_size"}).
+					AddRow(alloc.ID, alloc.Tx, alloc.Expiration, alloc.OwnerPublicKey, alloc.OwnerID, int64(1<<30)),
+			)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "terms" WHERE`)).
+			WithArgs(alloc.ID).
+			WillReturnRows(
+				sqlmock.NewRows([]string{"id", "allocation_id"}).
+					AddRow(alloc.Terms[0].ID, alloc.Terms[0].AllocationID),
+			)
+	}
+
+	tests := []struct {
+		name      string
+		signature string
+		// anonymous sends no credentials in the metadata
+		anonymous bool
+		mockSetup func(sqlmock.Sqlmock)
+		wantCode  string
+	}{
+		{
+			name:      "OK",
+			signature: sign,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				aa := sqlmock.AnyArg()
+
+				allocationMockSetup(mock)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "allocation_connections" WHERE`)).
+					WithArgs(connectionID, alloc.ID, alloc.OwnerID, allocation.DeletedConnection).
+					WillReturnRows(
+						sqlmock.NewRows([]string{}).
+							AddRow(),
+					)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "reference_objects"`)).
+					WithArgs(alloc.ID, "/file.txt").
+					WillReturnError(gorm.ErrRecordNotFound)
+
+				mock.ExpectExec(`INSERT INTO "allocation_connections"`).
+					WithArgs(aa, aa, aa, aa, aa, aa, aa).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "allocation_changes"`)).
+					WithArgs(aa, aa, aa, aa, aa, aa).
+					WillReturnRows(
+						sqlmock.NewRows([]string{}),
+					)
+
+				mock.ExpectCommit()
+			},
+			wantCode: codes.OK.String(),
+		},
+		{
+			name:      "Invalid_Signature_ERR",
+			signature: "",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				allocationMockSetup(mock)
+				mock.ExpectRollback()
+			},
+			wantCode: codes.Unknown.String(),
+		},
+		{
+			name:      "Unauthenticated_Client_ERR",
+			signature: sign,
+			anonymous: true,
+			mockSetup: func(mock sqlmock.Sqlmock) {},
+			wantCode:  codes.Unauthenticated.String(),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock := datastore.MockTheStore(t)
+			test.mockSetup(mock)
+
+			msgs := []*blobbergrpc.UploadFileRequest{
+				{Request: &blobbergrpc.UploadFileRequest_Meta{Meta: &blobbergrpc.UploadFileMeta{
+					Context: &blobbergrpc.RequestContext{
+						Client:    alloc.OwnerID,
+						Signature: test.signature,
+					},
+					Allocation:   alloc.Tx,
+					ConnectionId: connectionID,
+					File:         &blobbergrpc.FileChange{Filename: "file.txt", Path: "/file.txt"},
+				}}},
+				{Request: &blobbergrpc.UploadFileRequest_FileChunk{FileChunk: []byte("file ")}},
+				{Request: &blobbergrpc.UploadFileRequest_FileChunk{FileChunk: []byte("content")}},
+			}
+
+			ctx := context.Background()
+			if !test.anonymous {
+				ctx = signedContext(t, sch, "/blobber.service.v1.Blobber/UploadFile", msgs[0])
+			}
+			stream, err := grpcCl.UploadFile(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, msg := range msgs {
+				if err := stream.Send(msg); err != nil && err != io.EOF {
+					t.Fatal(err)
+				}
+			}
+			resp, err := stream.CloseAndRecv()
+
+			assert.Equal(t, test.wantCode, status.Code(err).String())
+			if err == nil {
+				assert.Equal(t, "file.txt", resp.Result.Filename)
+				assert.Equal(t, int64(len("file content")), resp.Result.Size)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	curDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.RemoveAll(curDir + "/tmp"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -3,8 +3,17 @@ package handler
 import (
 	"context"
 	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
 	"testing"
 
+	"0chain.net/blobbercore/constants"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/readmarker"
+	"0chain.net/core/common"
+	"google.golang.org/grpc"
+
 	"0chain.net/blobbercore/stats"
 
 	"0chain.net/blobbercore/reference"
@@ -12,6 +21,7 @@ import (
 	"0chain.net/blobbercore/mocks"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"0chain.net/blobbercore/allocation"
 
@@ -87,7 +97,7 @@ func TestBlobberGRPCService_GetFileMetaData_Success(t *testing.T) {
 			ClientID: "test",
 		},
 	}, nil)
-	mockReferencePackage.On("IsACollaborator", mock.Anything, mock.Anything, mock.Anything).Return(true)
+	mockReferencePackage.On("HasCollaboratorRole", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true)
 	mockStorageHandler.On("verifyAuthTicket", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
 
 	svc := newGRPCBlobberService(mockStorageHandler, mockReferencePackage)
@@ -126,7 +136,7 @@ func TestBlobberGRPCService_GetFileMetaData_FileNotExist(t *testing.T) {
 			ClientID: "test",
 		},
 	}, nil)
-	mockReferencePackage.On("IsACollaborator", mock.Anything, mock.Anything, mock.Anything).Return(true)
+	mockReferencePackage.On("HasCollaboratorRole", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true)
 	mockStorageHandler.On("verifyAuthTicket", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
 
 	svc := newGRPCBlobberService(mockStorageHandler, mockReferencePackage)
@@ -472,3 +482,475 @@ func TestBlobberGRPCService_GetObjectTree_NotOwner(t *testing.T) {
 	}
 
 }
+
+// uploadFileServer is the stream of an upload of the messages given.
+type uploadFileServer struct {
+	grpc.ServerStream
+	msgs []*blobbergrpc.UploadFileRequest
+	resp *blobbergrpc.UploadFileResponse
+}
+
+func (s *uploadFileServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *uploadFileServer) Recv() (*blobbergrpc.UploadFileRequest, error) {
+	if len(s.msgs) == 0 {
+		return nil, io.EOF
+	}
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+	return msg, nil
+}
+
+func (s *uploadFileServer) SendAndClose(resp *blobbergrpc.UploadFileResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func uploadMeta(thumbnailFilename string) *blobbergrpc.UploadFileRequest {
+	return &blobbergrpc.UploadFileRequest{Request: &blobbergrpc.UploadFileRequest_Meta{
+		Meta: &blobbergrpc.UploadFileMeta{
+			Context:      &blobbergrpc.RequestContext{Client: "owner", Signature: "sign"},
+			Allocation:   "allocation tx",
+			ConnectionId: "connection",
+			File: &blobbergrpc.FileChange{
+				Filename:          "file.txt",
+				Path:              "/file.txt",
+				ThumbnailFilename: thumbnailFilename,
+				Attributes:        []byte(`{"who_pays_for_reads":1}`),
+			},
+		},
+	}}
+}
+
+func fileChunk(data string) *blobbergrpc.UploadFileRequest {
+	return &blobbergrpc.UploadFileRequest{Request: &blobbergrpc.UploadFileRequest_FileChunk{
+		FileChunk: []byte(data),
+	}}
+}
+
+func thumbnailChunk(data string) *blobbergrpc.UploadFileRequest {
+	return &blobbergrpc.UploadFileRequest{Request: &blobbergrpc.UploadFileRequest_ThumbnailChunk{
+		ThumbnailChunk: []byte(data),
+	}}
+}
+
+func TestBlobberGRPCService_UploadFile_Success(t *testing.T) {
+	stream := &uploadFileServer{msgs: []*blobbergrpc.UploadFileRequest{
+		uploadMeta("thumb.png"),
+		fileChunk("file "), fileChunk("content"),
+		thumbnailChunk("thumbnail"),
+	}}
+	alloc := &allocation.Allocation{ID: "allocationId", Tx: "allocation tx"}
+
+	mockStorageHandler := &storageHandlerI{}
+	mockReferencePackage := &mocks.PackageHandler{}
+	mockStorageHandler.On("writeAccess", mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Value(constants.ALLOCATION_CONTEXT_KEY) == "allocation tx" &&
+			ctx.Value(constants.CLIENT_SIGNATURE_HEADER_KEY) == "sign"
+	})).Return(alloc, nil)
+	mockStorageHandler.On("writeFile", mock.Anything, alloc, mock.Anything).Return(
+		func(ctx context.Context, _ *allocation.Allocation, req *WriteFileRequest) *UploadResult {
+			assert.Equal(t, allocation.INSERT_OPERATION, req.Mode)
+			assert.Equal(t, "connection", req.ConnectionID)
+			assert.Equal(t, "/file.txt", req.Meta.Path)
+			assert.Equal(t, common.WhoPays(1), req.Meta.Attributes.WhoPaysForReads)
+
+			file, err := ioutil.ReadAll(req.File)
+			assert.NoError(t, err)
+			assert.Equal(t, "file content", string(file))
+			assert.Equal(t, "thumb.png", req.ThumbnailFilename)
+			thumbnail, err := ioutil.ReadAll(req.Thumbnail)
+			assert.NoError(t, err)
+			assert.Equal(t, "thumbnail", string(thumbnail))
+
+			return &UploadResult{Filename: req.Meta.Filename, Size: int64(len(file))}
+		}, nil)
+
+	svc := newGRPCBlobberService(mockStorageHandler, mockReferencePackage)
+	err := svc.UploadFile(stream)
+	if err != nil {
+		t.Fatal("unexpected error - " + err.Error())
+	}
+
+	assert.Equal(t, "file.txt", stream.resp.Result.Filename)
+	assert.Equal(t, int64(len("file content")), stream.resp.Result.Size)
+}
+
+func TestBlobberGRPCService_UploadFile_InvalidStream(t *testing.T) {
+	tests := []struct {
+		name string
+		msgs []*blobbergrpc.UploadFileRequest
+	}{
+		{name: "no meta", msgs: []*blobbergrpc.UploadFileRequest{fileChunk("file")}},
+		{name: "thumbnail without filename", msgs: []*blobbergrpc.UploadFileRequest{
+			uploadMeta(""), fileChunk("file"), thumbnailChunk("thumbnail")}},
+		{name: "file chunk after thumbnail", msgs: []*blobbergrpc.UploadFileRequest{
+			uploadMeta("thumb.png"), thumbnailChunk("thumbnail"), fileChunk("file")}},
+		{name: "meta twice", msgs: []*blobbergrpc.UploadFileRequest{
+			uploadMeta(""), fileChunk("file"), uploadMeta("")}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			alloc := &allocation.Allocation{ID: "allocationId"}
+			mockStorageHandler := &storageHandlerI{}
+			mockStorageHandler.On("writeAccess", mock.Anything).Return(alloc, nil)
+			mockStorageHandler.On("writeFile", mock.Anything, alloc, mock.Anything).Return(
+				func(ctx context.Context, _ *allocation.Allocation, req *WriteFileRequest) *UploadResult {
+					return &UploadResult{}
+				},
+				func(ctx context.Context, _ *allocation.Allocation, req *WriteFileRequest) error {
+					if _, err := ioutil.ReadAll(req.File); err != nil {
+						return err
+					}
+					if req.Thumbnail != nil {
+						_, err := ioutil.ReadAll(req.Thumbnail)
+						return err
+					}
+					return nil
+				})
+
+			svc := newGRPCBlobberService(mockStorageHandler, &mocks.PackageHandler{})
+			err := svc.UploadFile(&uploadFileServer{msgs: test.msgs})
+			if err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestBlobberGRPCService_DeleteFile_Success(t *testing.T) {
+	req := &blobbergrpc.DeleteFileRequest{
+		Context:      &blobbergrpc.RequestContext{Client: "owner"},
+		Allocation:   "allocation tx",
+		ConnectionId: "connection",
+		Path:         "/file.txt",
+	}
+	alloc := &allocation.Allocation{ID: "allocationId"}
+
+	mockStorageHandler := &storageHandlerI{}
+	mockStorageHandler.On("writeAccess", mock.Anything).Return(alloc, nil)
+	mockStorageHandler.On("writeFile", mock.Anything, alloc, &WriteFileRequest{
+		ConnectionID: req.ConnectionId,
+		Mode:         allocation.DELETE_OPERATION,
+		Path:         req.Path,
+	}).Return(&UploadResult{Filename: "file.txt"}, nil)
+
+	svc := newGRPCBlobberService(mockStorageHandler, &mocks.PackageHandler{})
+	resp, err := svc.DeleteFile(context.Background(), req)
+	if err != nil {
+		t.Fatal("unexpected error - " + err.Error())
+	}
+
+	assert.Equal(t, "file.txt", resp.Result.Filename)
+}
+
+func TestBlobberGRPCService_DeleteFile_InvalidSignature(t *testing.T) {
+	req := &blobbergrpc.DeleteFileRequest{
+		Context:    &blobbergrpc.RequestContext{Client: "owner"},
+		Allocation: "allocation tx",
+		Path:       "/file.txt",
+	}
+
+	mockStorageHandler := &storageHandlerI{}
+	mockStorageHandler.On("writeAccess", mock.Anything).Return(nil,
+		common.NewError("invalid_signature", "Invalid signature"))
+
+	svc := newGRPCBlobberService(mockStorageHandler, &mocks.PackageHandler{})
+	_, err := svc.DeleteFile(context.Background(), req)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	mockStorageHandler.AssertNotCalled(t, "writeFile", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBlobberGRPCService_RenameObject_Success(t *testing.T) {
+	req := &blobbergrpc.RenameObjectRequest{
+		Context:      &blobbergrpc.RequestContext{Client: "owner"},
+		Allocation:   "allocation tx",
+		ConnectionId: "connection",
+		Path:         "/file.txt",
+		NewName:      "new.txt",
+	}
+
+	mockStorageHandler := &storageHandlerI{}
+	mockStorageHandler.On("renameObject", mock.Anything, &RenameObjectRequest{
+		ConnectionID: req.ConnectionId,
+		Path:         req.Path,
+		NewName:      req.NewName,
+	}).Return(&UploadResult{Filename: req.NewName}, nil)
+
+	svc := newGRPCBlobberService(mockStorageHandler, &mocks.PackageHandler{})
+	resp, err := svc.RenameObject(context.Background(), req)
+	if err != nil {
+		t.Fatal("unexpected error - " + err.Error())
+	}
+
+	assert.Equal(t, req.NewName, resp.Result.Filename)
+}
+
+func TestBlobberGRPCService_UpdateObjectAttributes_Success(t *testing.T) {
+	req := &blobbergrpc.UpdateObjectAttributesRequest{
+		Context:      &blobbergrpc.RequestContext{Client: "owner"},
+		Allocation:   "allocation tx",
+		ConnectionId: "connection",
+		Path:         "/file.txt",
+		Attributes:   []byte(`{"who_pays_for_reads":1}`),
+	}
+
+	mockStorageHandler := &storageHandlerI{}
+	mockStorageHandler.On("updateObjectAttributes", mock.Anything, &UpdateObjectAttributesRequest{
+		ConnectionID: req.ConnectionId,
+		Path:         req.Path,
+		Attributes:   string(req.Attributes),
+	}).Return(&reference.Attributes{WhoPaysForReads: common.WhoPays3rdParty}, nil)
+
+	svc := newGRPCBlobberService(mockStorageHandler, &mocks.PackageHandler{})
+	resp, err := svc.UpdateObjectAttributes(context.Background(), req)
+	if err != nil {
+		t.Fatal("unexpected error - " + err.Error())
+	}
+
+	assert.JSONEq(t, `{"who_pays_for_reads":1}`, string(resp.Attributes))
+}
+
+func TestBlobberGRPCService_CommitConnection_Success(t *testing.T) {
+	req := &blobbergrpc.CommitConnectionRequest{
+		Context:      &blobbergrpc.RequestContext{Client: "owner", ClientKey: "key"},
+		Allocation:   "allocation tx",
+		ConnectionId: "connection",
+		WriteMarker: &blobbergrpc.WriteMarker{
+			AllocationRoot: "root",
+			AllocationID:   "allocationId",
+			Size:           10,
+			Timestamp:      1000,
+			ClientID:       "owner",
+			Signature:      "sign",
+		},
+	}
+	wm := WriteMarkerGRPCToWriteMarker(req.WriteMarker)
+
+	mockStorageHandler := &storageHandlerI{}
+	mockStorageHandler.On("commitWrite", mock.Anything, &CommitRequest{
+		ConnectionID: req.ConnectionId,
+		WriteMarker:  wm,
+	}).Return(&CommitResult{AllocationRoot: "prev root", WriteMarker: &wm, Success: true}, nil)
+
+	svc := newGRPCBlobberService(mockStorageHandler, &mocks.PackageHandler{})
+	resp, err := svc.CommitConnection(context.Background(), req)
+	if err != nil {
+		t.Fatal("unexpected error - " + err.Error())
+	}
+
+	assert.True(t, resp.Success)
+	assert.Equal(t, "prev root", resp.AllocationRoot)
+	assert.Equal(t, req.WriteMarker.AllocationRoot, resp.WriteMarker.AllocationRoot)
+	assert.Equal(t, req.WriteMarker.Timestamp, resp.WriteMarker.Timestamp)
+}
+
+func TestBlobberGRPCService_AddCommitMetaTxn_Success(t *testing.T) {
+	req := &blobbergrpc.AddCommitMetaTxnRequest{
+		Context:    &blobbergrpc.RequestContext{Client: "owner"},
+		Allocation: "allocation tx",
+		Path:       "/file.txt",
+		TxnId:      "txn",
+	}
+
+	mockStorageHandler := &storageHandlerI{}
+	mockStorageHandler.On("addCommitMetaTxn", mock.Anything, &CommitMetaTxnRequest{
+		Path:  req.Path,
+		TxnID: req.TxnId,
+	}).Return(&MessageResult{Msg: "Added commitMetaTxn successfully"}, nil)
+
+	svc := newGRPCBlobberService(mockStorageHandler, &mocks.PackageHandler{})
+	resp, err := svc.AddCommitMetaTxn(context.Background(), req)
+	if err != nil {
+		t.Fatal("unexpected error - " + err.Error())
+	}
+
+	assert.Equal(t, "Added commitMetaTxn successfully", resp.Msg)
+}
+
+func TestBlobberGRPCService_Collaborators(t *testing.T) {
+	mockStorageHandler := &storageHandlerI{}
+	mockStorageHandler.On("collaborator", mock.Anything, &CollaboratorRequest{
+		Method:   http.MethodPost,
+		Path:     "/file.txt",
+		CollabID: "collab",
+		Role:     string(reference.CollaboratorReader),
+	}).Return(&MessageResult{Msg: "Added collaborator successfully"}, nil)
+	mockStorageHandler.On("collaborator", mock.Anything, &CollaboratorRequest{
+		Method:   http.MethodDelete,
+		Path:     "/file.txt",
+		CollabID: "collab",
+	}).Return(&MessageResult{Msg: "Removed collaborator successfully"}, nil)
+
+	svc := newGRPCBlobberService(mockStorageHandler, &mocks.PackageHandler{})
+	added, err := svc.AddCollaborator(context.Background(), &blobbergrpc.AddCollaboratorRequest{
+		Context:  &blobbergrpc.RequestContext{Client: "owner"},
+		Path:     "/file.txt",
+		CollabId: "collab",
+		Role:     string(reference.CollaboratorReader),
+	})
+	if err != nil {
+		t.Fatal("unexpected error - " + err.Error())
+	}
+	assert.Equal(t, "Added collaborator successfully", added.Msg)
+
+	removed, err := svc.RemoveCollaborator(context.Background(), &blobbergrpc.RemoveCollaboratorRequest{
+		Context:  &blobbergrpc.RequestContext{Client: "owner"},
+		Path:     "/file.txt",
+		CollabId: "collab",
+	})
+	if err != nil {
+		t.Fatal("unexpected error - " + err.Error())
+	}
+	assert.Equal(t, "Removed collaborator successfully", removed.Msg)
+}
+
+// downloadFileServer is the stream of a download of the messages given.
+type downloadFileServer struct {
+	grpc.ServerStream
+	msgs  []*blobbergrpc.DownloadFileRequest
+	resps []*blobbergrpc.DownloadFileResponse
+}
+
+func (s *downloadFileServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *downloadFileServer) Recv() (*blobbergrpc.DownloadFileRequest, error) {
+	if len(s.msgs) == 0 {
+		return nil, io.EOF
+	}
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+	return msg, nil
+}
+
+func (s *downloadFileServer) Send(resp *blobbergrpc.DownloadFileResponse) error {
+	s.resps = append(s.resps, resp)
+	return nil
+}
+
+func downloadReadMarker(counter, numBlocks int64) *blobbergrpc.DownloadFileRequest {
+	return &blobbergrpc.DownloadFileRequest{
+		ReadMarker: &blobbergrpc.ReadMarker{ClientID: "reader", ReadCounter: counter},
+		NumBlocks:  numBlocks,
+	}
+}
+
+func TestBlobberGRPCService_DownloadFile_Success(t *testing.T) {
+	first := downloadReadMarker(2, 2)
+	first.Meta = &blobbergrpc.DownloadFileMeta{
+		Context:    &blobbergrpc.RequestContext{Client: "reader", ClientKey: "key"},
+		Allocation: "allocation tx",
+		Path:       "/file.txt",
+		BlockNum:   1,
+		NumBlocks:  3,
+		AuthToken:  "ticket",
+	}
+	stream := &downloadFileServer{msgs: []*blobbergrpc.DownloadFileRequest{
+		first, downloadReadMarker(4, 1), downloadReadMarker(3, 1),
+	}}
+	alloc := &allocation.Allocation{ID: "allocationId", Tx: "allocation tx"}
+	download := &FileDownload{Alloc: alloc, ClientID: "reader"}
+
+	// the access and the read markers are each in a transaction of its own
+	sqlMock := datastore.MockTheStore(t)
+	for i := 0; i < 4; i++ {
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+	}
+
+	mockStorageHandler := &storageHandlerI{}
+	mockStorageHandler.On("downloadAccess", mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Value(constants.ALLOCATION_CONTEXT_KEY) == "allocation tx"
+	})).Return(alloc, nil)
+	mockStorageHandler.On("verifyReadMarker", mock.Anything, alloc, mock.Anything).Return(nil)
+	mockStorageHandler.On("openDownload", mock.Anything, alloc, &DownloadRequest{
+		PathHash:  reference.GetReferenceLookup(alloc.ID, "/file.txt"),
+		AuthToken: "ticket",
+	}).Return(download, nil).Once()
+	mockStorageHandler.On("readBlocks", mock.Anything, download, mock.Anything, int64(1), int64(2)).Return(
+		func(_ context.Context, _ *FileDownload, rm *readmarker.ReadMarker, _, _ int64) *DownloadResponse {
+			return &DownloadResponse{Success: true, Data: []byte("blocks 1-2"), LatestRM: rm}
+		}, nil).Once()
+	mockStorageHandler.On("readBlocks", mock.Anything, download, mock.Anything, int64(3), int64(1)).Return(
+		func(_ context.Context, _ *FileDownload, rm *readmarker.ReadMarker, _, _ int64) *DownloadResponse {
+			if rm.ReadCounter != 3 {
+				return &DownloadResponse{LatestRM: &readmarker.ReadMarker{ReadCounter: 2}}
+			}
+			return &DownloadResponse{Success: true, Data: []byte("block 3"), LatestRM: rm}
+		}, nil).Twice()
+
+	svc := newGRPCBlobberService(mockStorageHandler, &mocks.PackageHandler{})
+	if err := svc.DownloadFile(stream); err != nil {
+		t.Fatal("unexpected error - " + err.Error())
+	}
+
+	require.Len(t, stream.resps, 3)
+	assert.True(t, stream.resps[0].Success)
+	assert.Equal(t, int64(1), stream.resps[0].BlockNum)
+	assert.Equal(t, "blocks 1-2", string(stream.resps[0].Data))
+	assert.False(t, stream.resps[1].Success)
+	assert.Equal(t, int64(2), stream.resps[1].LatestRM.ReadCounter)
+	assert.True(t, stream.resps[2].Success)
+	assert.Equal(t, int64(3), stream.resps[2].BlockNum)
+	assert.Equal(t, int64(3), stream.resps[2].LatestRM.ReadCounter)
+	mockStorageHandler.AssertExpectations(t)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestBlobberGRPCService_DownloadFile_InvalidStream(t *testing.T) {
+	meta := func(req *blobbergrpc.DownloadFileRequest) *blobbergrpc.DownloadFileRequest {
+		req.Meta = &blobbergrpc.DownloadFileMeta{
+			Context:   &blobbergrpc.RequestContext{Client: "reader"},
+			Path:      "/file.txt",
+			BlockNum:  1,
+			NumBlocks: 2,
+		}
+		return req
+	}
+	tests := []struct {
+		name string
+		msgs []*blobbergrpc.DownloadFileRequest
+		txns int
+	}{
+		{name: "no meta", msgs: []*blobbergrpc.DownloadFileRequest{downloadReadMarker(1, 1)}},
+		{name: "no read marker", msgs: []*blobbergrpc.DownloadFileRequest{
+			meta(&blobbergrpc.DownloadFileRequest{NumBlocks: 1})}, txns: 1},
+		{name: "blocks out of range", msgs: []*blobbergrpc.DownloadFileRequest{
+			meta(downloadReadMarker(3, 3))}, txns: 1},
+		{name: "meta twice", msgs: []*blobbergrpc.DownloadFileRequest{
+			meta(downloadReadMarker(1, 1)), meta(downloadReadMarker(2, 1))}, txns: 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			alloc := &allocation.Allocation{ID: "allocationId"}
+			sqlMock := datastore.MockTheStore(t)
+			for i := 0; i < test.txns; i++ {
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectCommit()
+			}
+
+			mockStorageHandler := &storageHandlerI{}
+			mockStorageHandler.On("downloadAccess", mock.Anything).Return(alloc, nil)
+			mockStorageHandler.On("verifyReadMarker", mock.Anything, alloc, mock.Anything).Return(nil)
+			mockStorageHandler.On("openDownload", mock.Anything, alloc, mock.Anything).Return(&FileDownload{}, nil)
+			mockStorageHandler.On("readBlocks", mock.Anything, mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything).Return(
+				func(_ context.Context, _ *FileDownload, rm *readmarker.ReadMarker, _, _ int64) *DownloadResponse {
+					return &DownloadResponse{Success: true, LatestRM: rm}
+				}, nil)
+
+			svc := newGRPCBlobberService(mockStorageHandler, &mocks.PackageHandler{})
+			err := svc.DownloadFile(&downloadFileServer{msgs: test.msgs})
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			require.NoError(t, sqlMock.ExpectationsWereMet())
+		})
+	}
+}
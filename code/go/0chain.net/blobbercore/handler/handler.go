@@ -9,10 +9,14 @@ import (
 	"runtime/pprof"
 	"time"
 
+	"0chain.net/blobbercore/challenge"
 	"0chain.net/blobbercore/config"
 	"0chain.net/blobbercore/constants"
 	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/outbox"
+	"0chain.net/blobbercore/readmarker"
 	"0chain.net/blobbercore/stats"
+	"0chain.net/blobbercore/writemarker"
 	"0chain.net/core/common"
 
 	. "0chain.net/core/logging"
@@ -32,6 +36,7 @@ func SetupHandlers(r *mux.Router) {
 	//object operations
 	r.HandleFunc("/v1/file/upload/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(UploadHandler))))
 	r.HandleFunc("/v1/file/download/{allocation}", common.UserRateLimit(common.ToByteStream(WithConnection(DownloadHandler))))
+	r.HandleFunc("/v1/dir/download/{allocation}", common.UserRateLimit(DownloadDirectoryHandler))
 	r.HandleFunc("/v1/file/rename/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(RenameHandler))))
 	r.HandleFunc("/v1/file/copy/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(CopyHandler))))
 	r.HandleFunc("/v1/file/attributes/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(UpdateAttributesHandler))))
@@ -39,6 +44,11 @@ func SetupHandlers(r *mux.Router) {
 	r.HandleFunc("/v1/connection/commit/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(CommitHandler))))
 	r.HandleFunc("/v1/file/commitmetatxn/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(CommitMetaTxnHandler))))
 	r.HandleFunc("/v1/file/collaborator/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(CollaboratorHandler))))
+	r.HandleFunc("/v1/file/collaborators/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(ListCollaboratorsHandler))))
+	r.HandleFunc("/v1/file/collaborators/revoke/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(RevokeCollaboratorHandler))))
+	r.HandleFunc("/v1/authticket/revoke/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(RevokeAuthTicketHandler))))
+	r.HandleFunc("/v1/authticket/revocations/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(AuthTicketRevocationsHandler))))
+	r.HandleFunc("/v1/authticket/usage/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(AuthTicketUsageHandler))))
 	r.HandleFunc("/v1/file/calculatehash/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithConnection(CalculateHashHandler))))
 
 	//object info related apis
@@ -49,14 +59,21 @@ func SetupHandlers(r *mux.Router) {
 	r.HandleFunc("/v1/file/objectpath/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(ObjectPathHandler))))
 	r.HandleFunc("/v1/file/referencepath/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(ReferencePathHandler))))
 	r.HandleFunc("/v1/file/objecttree/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(ObjectTreeHandler))))
-
-	//admin related
-	r.HandleFunc("/_debug", common.UserRateLimit(common.ToJSONResponse(DumpGoRoutines)))
-	r.HandleFunc("/_config", common.UserRateLimit(common.ToJSONResponse(GetConfig)))
-	r.HandleFunc("/_stats", common.UserRateLimit(stats.StatsHandler))
-	r.HandleFunc("/_statsJSON", common.UserRateLimit(common.ToJSONResponse(stats.StatsJSONHandler)))
-	r.HandleFunc("/_cleanupdisk", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(CleanupDiskHandler))))
-	r.HandleFunc("/getstats", common.UserRateLimit(common.ToJSONResponse(stats.GetStatsHandler)))
+	r.HandleFunc("/v1/file/inclusionproof/{allocation}", common.UserRateLimit(common.ToJSONResponse(WithReadOnlyConnection(InclusionProofHandler))))
+
+	//admin related, the mutating endpoints for the operators only
+	r.HandleFunc("/_debug", common.UserRateLimit(WithAdminAuth(AdminReader, common.ToJSONResponse(DumpGoRoutines))))
+	r.HandleFunc("/_config", common.UserRateLimit(WithAdminAuth(AdminReader, common.ToJSONResponse(GetConfig))))
+	r.HandleFunc("/_stats", common.UserRateLimit(WithAdminAuth(AdminReader, stats.StatsHandler)))
+	r.HandleFunc("/_statsJSON", common.UserRateLimit(WithAdminAuth(AdminReader, common.ToJSONResponse(stats.StatsJSONHandler))))
+	r.HandleFunc("/_txnstats", common.UserRateLimit(WithAdminAuth(AdminReader, common.ToJSONResponse(outbox.StatsHandler))))
+	r.HandleFunc("/_readredeem", common.UserRateLimit(WithAdminAuth(AdminReader, common.ToJSONResponse(readmarker.RedeemStatusHandler))))
+	r.HandleFunc("/_stuckwritemarkers", common.UserRateLimit(WithAdminAuth(AdminReader, common.ToJSONResponse(writemarker.StuckMarkersHandler))))
+	r.HandleFunc("/_redrivewritemarkers", common.UserRateLimit(WithAdminAuth(AdminOperator, common.ToJSONResponse(writemarker.RedriveHandler))))
+	r.HandleFunc("/_selfaudit", common.UserRateLimit(WithAdminAuth(AdminReader, common.ToJSONResponse(challenge.SelfAuditHandler))))
+	r.HandleFunc("/_challenges", common.UserRateLimit(WithAdminAuth(AdminReader, common.ToJSONResponse(challenge.HistoryHandler))))
+	r.HandleFunc("/_cleanupdisk", common.UserRateLimit(WithAdminAuth(AdminOperator, common.ToJSONResponse(WithReadOnlyConnection(CleanupDiskHandler)))))
+	r.HandleFunc("/getstats", common.UserRateLimit(WithAdminAuth(AdminReader, common.ToJSONResponse(stats.GetStatsHandler))))
 }
 
 func WithReadOnlyConnection(handler common.JSONResponderF) common.JSONResponderF {
@@ -157,6 +174,61 @@ func CollaboratorHandler(ctx context.Context, r *http.Request) (interface{}, err
 	return response, nil
 }
 
+func ListCollaboratorsHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.ListCollaborators(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func RevokeCollaboratorHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.RevokeCollaborator(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func RevokeAuthTicketHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.RevokeAuthTicket(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func AuthTicketRevocationsHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.ListAuthTicketRevocations(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func AuthTicketUsageHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.GetAuthTicketUsage(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 func FileStatsHandler(ctx context.Context, r *http.Request) (interface{}, error) {
 	ctx = setupHandlerContext(ctx, r)
 
@@ -240,6 +312,42 @@ func ObjectTreeHandler(ctx context.Context, r *http.Request) (interface{}, error
 	return response, nil
 }
 
+func InclusionProofHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = setupHandlerContext(ctx, r)
+
+	response, err := storageHandler.GetInclusionProof(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+/*DownloadDirectoryHandler streams a directory as an archive once the read of all its blocks is committed*/
+func DownloadDirectoryHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := WithConnection(func(ctx context.Context, r *http.Request) (interface{}, error) {
+		ctx = setupHandlerContext(ctx, r)
+		return storageHandler.DownloadDirectory(ctx, r)
+	})(r.Context(), r)
+	if err != nil {
+		common.Respond(w, nil, err)
+		return
+	}
+
+	archive, ok := resp.(*DirectoryArchive)
+	if !ok {
+		common.Respond(w, resp, nil)
+		return
+	}
+	w.Header().Set("Content-Type", archive.ContentType())
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+archive.FileName()+"\"")
+	if err = archive.Write(w); err != nil {
+		Logger.Error("directory archive streaming failed",
+			zap.String("allocation", archive.AllocationID),
+			zap.String("path", archive.Root.Path), zap.Error(err))
+	}
+}
+
 func RenameHandler(ctx context.Context, r *http.Request) (interface{}, error) {
 	ctx = setupHandlerContext(ctx, r)
 	response, err := storageHandler.RenameObject(ctx, r)
@@ -309,7 +417,7 @@ func DumpGoRoutines(ctx context.Context, r *http.Request) (interface{}, error) {
 }
 
 func GetConfig(ctx context.Context, r *http.Request) (interface{}, error) {
-	return config.Configuration, nil
+	return config.Configuration.Redacted(), nil
 }
 
 func CleanupDiskHandler(ctx context.Context, r *http.Request) (interface{}, error) {
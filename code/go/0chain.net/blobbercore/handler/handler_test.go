@@ -2,6 +2,7 @@ package handler
 
 import (
 	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/constants"
 	bconfig "0chain.net/blobbercore/config"
 	"0chain.net/blobbercore/datastore"
 	"0chain.net/blobbercore/filestore"
@@ -12,6 +13,8 @@ import (
 	"0chain.net/core/encryption"
 	"0chain.net/core/logging"
 	"bytes"
+	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"github.com/0chain/gosdk/core/zcncrypto"
@@ -1031,3 +1034,75 @@ func TestHandlers_Requiring_Signature(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestVerifyClientSignatureFromContext(t *testing.T) {
+	keys := func() (zcncrypto.SignatureScheme, string) {
+		sch := zcncrypto.NewBLS0ChainScheme()
+		if _, err := sch.GenerateKeys(); err != nil {
+			t.Fatal(err)
+		}
+		keyBytes, err := hex.DecodeString(sch.GetPublicKey())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sch, encryption.Hash(keyBytes)
+	}
+	owner, ownerID := keys()
+	collab, collabID := keys()
+
+	alloc := makeTestAllocation(common.Timestamp(time.Now().Add(time.Hour).Unix()))
+	alloc.OwnerID = ownerID
+	alloc.OwnerPublicKey = owner.GetPublicKey()
+
+	signedContext := func(sch zcncrypto.SignatureScheme, clientID, clientKey string) context.Context {
+		sign, err := sch.Sign(encryption.Hash(alloc.Tx))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx := context.WithValue(context.Background(), constants.CLIENT_CONTEXT_KEY, clientID)
+		ctx = context.WithValue(ctx, constants.CLIENT_KEY_CONTEXT_KEY, clientKey)
+		ctx = context.WithValue(ctx, constants.ALLOCATION_CONTEXT_KEY, alloc.Tx)
+		return context.WithValue(ctx, constants.CLIENT_SIGNATURE_HEADER_KEY, sign)
+	}
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		valid bool
+	}{
+		{
+			name:  "owner with its key",
+			ctx:   signedContext(owner, ownerID, owner.GetPublicKey()),
+			valid: true,
+		},
+		{
+			name:  "owner without its key",
+			ctx:   signedContext(owner, ownerID, ""),
+			valid: true,
+		},
+		{
+			name:  "collaborator with its key",
+			ctx:   signedContext(collab, collabID, collab.GetPublicKey()),
+			valid: true,
+		},
+		{
+			name: "collaborator without its key",
+			ctx:  signedContext(collab, collabID, ""),
+		},
+		{
+			name: "key of another client",
+			ctx:  signedContext(collab, ownerID, collab.GetPublicKey()),
+		},
+		{
+			name: "signed with another key",
+			ctx:  signedContext(owner, collabID, collab.GetPublicKey()),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			valid, err := verifyClientSignatureFromContext(test.ctx, alloc)
+			assert.NoError(t, err)
+			assert.Equal(t, test.valid, valid)
+		})
+	}
+}
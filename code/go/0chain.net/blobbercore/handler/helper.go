@@ -4,36 +4,73 @@ import (
 	"context"
 
 	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/readmarker"
 	"0chain.net/blobbercore/reference"
 	"0chain.net/blobbercore/stats"
 	"0chain.net/blobbercore/writemarker"
+	"0chain.net/core/common"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
 	"0chain.net/blobbercore/blobbergrpc"
 	"0chain.net/blobbercore/constants"
 )
 
+// setupGRPCHandlerContext sets up the context of a request of the client of
+// the request context, or of the one verified by the auth interceptors if
+// they ran.
 func setupGRPCHandlerContext(ctx context.Context, r *blobbergrpc.RequestContext) context.Context {
-	ctx = context.WithValue(ctx, constants.CLIENT_CONTEXT_KEY, r.Client)
-	ctx = context.WithValue(ctx, constants.CLIENT_KEY_CONTEXT_KEY, r.ClientKey)
-	ctx = context.WithValue(ctx, constants.ALLOCATION_CONTEXT_KEY, r.Allocation)
+	clientID, clientKey := r.GetClient(), r.GetClientKey()
+	if c, ok := grpcClientFromContext(ctx); ok {
+		clientID, clientKey = c.ID, c.Key
+	}
+	ctx = context.WithValue(ctx, constants.CLIENT_CONTEXT_KEY, clientID)
+	ctx = context.WithValue(ctx, constants.CLIENT_KEY_CONTEXT_KEY, clientKey)
+	ctx = context.WithValue(ctx, constants.ALLOCATION_CONTEXT_KEY, r.GetAllocation())
+	ctx = context.WithValue(ctx, constants.CLIENT_SIGNATURE_HEADER_KEY, r.GetSignature())
 	return ctx
 }
 
+// setupGRPCWriteContext sets up the context of a write to the allocation of
+// the request, the one the signature of the request context is verified for,
+// or of a download from it.
+func setupGRPCWriteContext(ctx context.Context, r *blobbergrpc.RequestContext, allocation string) context.Context {
+	ctx = setupGRPCHandlerContext(ctx, r)
+	return context.WithValue(ctx, constants.ALLOCATION_CONTEXT_KEY, allocation)
+}
+
 func RegisterGRPCServices(r *mux.Router, server *grpc.Server) {
 	packHandler := &packageHandler{}
 	blobberService := newGRPCBlobberService(&storageHandler, packHandler)
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithForwardResponseOption(gatewayCommit))
 	blobbergrpc.RegisterBlobberServer(server, blobberService)
+	blobbergrpc.RegisterBlobberAdminServer(server, newGRPCBlobberAdminService())
+	healthpb.RegisterHealthServer(server, healthServer)
+	reflection.Register(server)
 	_ = blobbergrpc.RegisterBlobberHandlerServer(context.Background(), mux, blobberService)
-	r.PathPrefix("/").Handler(mux)
+	gateway := gatewayDatabaseTransactionInjector(mux)
+	r.HandleFunc("/v2/challenges", common.UserRateLimit(WithAdminAuth(AdminReader, gateway.ServeHTTP)))
+	r.PathPrefix("/").Handler(gateway)
 }
 
 type StorageHandlerI interface {
 	verifyAllocation(ctx context.Context, tx string, readonly bool) (alloc *allocation.Allocation, err error)
 	verifyAuthTicket(ctx context.Context, authTokenString string, allocationObj *allocation.Allocation, refRequested *reference.Ref, clientID string) (bool, error)
+	writeAccess(ctx context.Context) (*allocation.Allocation, error)
+	writeFile(ctx context.Context, allocationObj *allocation.Allocation, req *WriteFileRequest) (*UploadResult, error)
+	commitWrite(ctx context.Context, req *CommitRequest) (*CommitResult, error)
+	renameObject(ctx context.Context, req *RenameObjectRequest) (*UploadResult, error)
+	copyObject(ctx context.Context, req *CopyObjectRequest) (*UploadResult, error)
+	updateObjectAttributes(ctx context.Context, req *UpdateObjectAttributesRequest) (*reference.Attributes, error)
+	addCommitMetaTxn(ctx context.Context, req *CommitMetaTxnRequest) (*MessageResult, error)
+	collaborator(ctx context.Context, req *CollaboratorRequest) (interface{}, error)
+	downloadAccess(ctx context.Context) (*allocation.Allocation, error)
+	verifyReadMarker(ctx context.Context, alloc *allocation.Allocation, readMarker *readmarker.ReadMarker) error
+	openDownload(ctx context.Context, alloc *allocation.Allocation, req *DownloadRequest) (*FileDownload, error)
+	readBlocks(ctx context.Context, download *FileDownload, readMarker *readmarker.ReadMarker, blockNum, numBlocks int64) (*DownloadResponse, error)
 }
 
 // PackageHandler is an interface for all static functions that may need to be mocked
@@ -41,7 +78,7 @@ type PackageHandler interface {
 	GetReferenceFromLookupHash(ctx context.Context, allocationID string, path_hash string) (*reference.Ref, error)
 	GetCommitMetaTxns(ctx context.Context, refID int64) ([]reference.CommitMetaTxn, error)
 	GetCollaborators(ctx context.Context, refID int64) ([]reference.Collaborator, error)
-	IsACollaborator(ctx context.Context, refID int64, clientID string) bool
+	HasCollaboratorRole(ctx context.Context, allocationID string, path string, clientID string, required reference.CollaboratorRole) bool
 	GetFileStats(ctx context.Context, refID int64) (*stats.FileStats, error)
 	GetWriteMarkerEntity(ctx context.Context, allocation_root string) (*writemarker.WriteMarkerEntity, error)
 	GetRefWithChildren(ctx context.Context, allocationID string, path string) (*reference.Ref, error)
@@ -88,6 +125,6 @@ func (r *packageHandler) GetCollaborators(ctx context.Context, refID int64) ([]r
 	return reference.GetCollaborators(ctx, refID)
 }
 
-func (r *packageHandler) IsACollaborator(ctx context.Context, refID int64, clientID string) bool {
-	return reference.IsACollaborator(ctx, refID, clientID)
+func (r *packageHandler) HasCollaboratorRole(ctx context.Context, allocationID string, path string, clientID string, required reference.CollaboratorRole) bool {
+	return reference.HasCollaboratorRole(ctx, allocationID, path, clientID, required)
 }
@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	uploadBytes = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "blobber_upload_bytes_total",
+		Help: "Bytes of the files and thumbnails uploaded, committed or not.",
+	})
+	downloadBytes = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "blobber_download_bytes_total",
+		Help: "Bytes of the blocks downloaded, of the files and of the directory archives.",
+	})
+	commitDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "blobber_commit_duration_seconds",
+		Help:    "Time committing the write markers, by outcome.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"outcome"})
+)
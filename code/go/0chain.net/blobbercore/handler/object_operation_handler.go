@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/config"
@@ -23,6 +24,7 @@ import (
 	"0chain.net/core/common"
 	"0chain.net/core/encryption"
 	"0chain.net/core/lock"
+	"0chain.net/core/metrics"
 	"0chain.net/core/node"
 
 	"gorm.io/datatypes"
@@ -179,24 +181,9 @@ func (fsh *StorageHandler) DownloadFile(ctx context.Context, r *http.Request) (
 			"invalid method used (GET), use POST instead")
 	}
 
-	// get client and allocation ids
-	var (
-		clientID     = ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
-		allocationTx = ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
-		_            = ctx.Value(constants.CLIENT_KEY_CONTEXT_KEY).(string) // runtime type check
-		alloc        *allocation.Allocation
-	)
-
-	// check client
-	if len(clientID) == 0 {
-		return nil, common.NewError("download_file", "invalid client")
-	}
-
-	// get and check allocation
-	alloc, err = fsh.verifyAllocation(ctx, allocationTx, false)
+	alloc, err := fsh.downloadAccess(ctx)
 	if err != nil {
-		return nil, common.NewErrorf("download_file",
-			"invalid allocation id passed: %v", err)
+		return nil, err
 	}
 
 	// get and parse file params
@@ -245,17 +232,78 @@ func (fsh *StorageHandler) DownloadFile(ctx context.Context, r *http.Request) (
 			"error parsing the readmarker for download: %v", err)
 	}
 
+	if err = fsh.verifyReadMarker(ctx, alloc, readMarker); err != nil {
+		return nil, err
+	}
+
+	download, err := fsh.openDownload(ctx, alloc, &DownloadRequest{
+		PathHash:  pathHash,
+		AuthToken: r.FormValue("auth_token"),
+		RxPay:     r.FormValue("rx_pay") == "true",
+		Thumbnail: r.FormValue("content") == DOWNLOAD_CONTENT_THUMB,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := fsh.readBlocks(ctx, download, readMarker, blockNum, numBlocks)
+	if err != nil {
+		return nil, err
+	}
+	if !response.Success {
+		return response, nil
+	}
+	return response.Data, nil
+}
+
+// downloadAccess returns the allocation of the context, checking the client
+// of the context can download from it.
+func (fsh *StorageHandler) downloadAccess(ctx context.Context) (*allocation.Allocation, error) {
+	// get client and allocation ids
+	var (
+		clientID     = ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+		allocationTx = ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+		_            = ctx.Value(constants.CLIENT_KEY_CONTEXT_KEY).(string) // runtime type check
+	)
+
+	// check client
+	if len(clientID) == 0 {
+		return nil, common.NewError("download_file", "invalid client")
+	}
+
+	// get and check allocation
+	alloc, err := fsh.verifyAllocation(ctx, allocationTx, false)
+	if err != nil {
+		return nil, common.NewErrorf("download_file",
+			"invalid allocation id passed: %v", err)
+	}
+	return alloc, nil
+}
+
+// verifyReadMarker checks the read marker is the one of the client of the
+// context for the allocation and this blobber.
+func (fsh *StorageHandler) verifyReadMarker(ctx context.Context,
+	alloc *allocation.Allocation, readMarker *readmarker.ReadMarker) error {
+
 	var rmObj = &readmarker.ReadMarkerEntity{}
 	rmObj.LatestRM = readMarker
 
-	if err = rmObj.VerifyMarker(ctx, alloc); err != nil {
-		return nil, common.NewErrorf("download_file", "invalid read marker, "+
+	if err := rmObj.VerifyMarker(ctx, alloc); err != nil {
+		return common.NewErrorf("download_file", "invalid read marker, "+
 			"failed to verify the read marker: %v", err)
 	}
+	return nil
+}
+
+// openDownload returns the download of the file of the request, checking the
+// client of the context can read it and setting who pays for the reads.
+func (fsh *StorageHandler) openDownload(ctx context.Context,
+	alloc *allocation.Allocation, req *DownloadRequest) (*FileDownload, error) {
+
+	var clientID = ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
 
 	// get file reference
-	var fileref *reference.Ref
-	fileref, err = reference.GetReferenceFromLookupHash(ctx, alloc.ID, pathHash)
+	fileref, err := reference.GetReferenceFromLookupHash(ctx, alloc.ID, req.PathHash)
 	if err != nil {
 		return nil, common.NewErrorf("download_file",
 			"invalid file path: %v", err)
@@ -266,28 +314,35 @@ func (fsh *StorageHandler) DownloadFile(ctx context.Context, r *http.Request) (
 			"path is not a file: %v", err)
 	}
 
+	var download = &FileDownload{
+		Alloc:     alloc,
+		Ref:       fileref,
+		ClientID:  clientID,
+		Thumbnail: req.Thumbnail,
+	}
+
 	// set payer: default
-	var payerID = alloc.OwnerID
+	download.PayerID = alloc.OwnerID
 
 	// set payer: check for explicit allocation payer value
 	if len(alloc.PayerID) > 0 {
-		payerID = alloc.PayerID
+		download.PayerID = alloc.PayerID
 	}
 
 	// set payer: check for command line payer flag (--rx_pay)
-	if r.FormValue("rx_pay") == "true" {
-		payerID = clientID
+	if req.RxPay {
+		download.PayerID = clientID
 	}
 
 	// authorize file access
 	var (
-		isOwner          = clientID == alloc.OwnerID
-		isRepairer       = clientID == alloc.RepairerID
-		isCollaborator   = reference.IsACollaborator(ctx, fileref.ID, clientID)
+		isOwner    = clientID == alloc.OwnerID
+		isRepairer = clientID == alloc.RepairerID
 	)
 
-	if !isOwner && !isRepairer && !isCollaborator {
-		var authTokenString = r.FormValue("auth_token")
+	if !isOwner && !isRepairer &&
+		!reference.HasCollaboratorRole(ctx, alloc.ID, fileref.Path, clientID, reference.CollaboratorReader) {
+		var authTokenString = req.AuthToken
 
 		// check auth token
 		if isAuthorized, err := fsh.verifyAuthTicket(ctx,
@@ -297,12 +352,12 @@ func (fsh *StorageHandler) DownloadFile(ctx context.Context, r *http.Request) (
 				"cannot verify auth ticket: %v", err)
 		}
 
-		if json.Unmarshal([]byte(authTokenString), &readmarker.AuthTicket{}) != nil {
+		download.AuthTicket = &readmarker.AuthTicket{}
+		if json.Unmarshal([]byte(authTokenString), download.AuthTicket) != nil {
 			return nil, common.NewErrorf("download_file",
 				"error parsing the auth ticket for download: %v", err)
 		}
-
-		readMarker.AuthTicket = datatypes.JSON(authTokenString)
+		download.AuthTicketJSON = authTokenString
 
 		// check for file payer flag
 		if fileAttrs, err := fileref.GetAttributes(); err != nil {
@@ -310,11 +365,31 @@ func (fsh *StorageHandler) DownloadFile(ctx context.Context, r *http.Request) (
 				"error getting file attributes: %v", err)
 		} else {
 			if fileAttrs.WhoPaysForReads == common.WhoPays3rdParty {
-				payerID = clientID
+				download.PayerID = clientID
 			}
 		}
 	}
 
+	return download, nil
+}
+
+// readBlocks reads the blocks of the download the read marker pays for, and
+// saves it as the latest read marker of the client. The response has no data
+// but the latest read marker if the one given doesn't follow it.
+func (fsh *StorageHandler) readBlocks(ctx context.Context, download *FileDownload,
+	readMarker *readmarker.ReadMarker, blockNum, numBlocks int64) (
+	*DownloadResponse, error) {
+
+	var (
+		alloc   = download.Alloc
+		fileref = download.Ref
+		err     error
+	)
+
+	if download.AuthTicket != nil {
+		readMarker.AuthTicket = datatypes.JSON(download.AuthTicketJSON)
+	}
+
 	// create read marker
 	var (
 		rme           *readmarker.ReadMarkerEntity
@@ -322,7 +397,7 @@ func (fsh *StorageHandler) DownloadFile(ctx context.Context, r *http.Request) (
 		pendNumBlocks int64
 	)
 
-	rme, err = readmarker.GetLatestReadMarkerEntity(ctx, clientID)
+	rme, err = readmarker.GetLatestReadMarkerEntity(ctx, download.ClientID)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, common.NewErrorf("download_file",
 			"couldn't get read marker from DB: %v", err)
@@ -348,19 +423,24 @@ func (fsh *StorageHandler) DownloadFile(ctx context.Context, r *http.Request) (
 		return response, nil
 	}
 
+	// account the read against the limits of the auth ticket
+	if download.AuthTicket != nil {
+		if err = readmarker.ConsumeAuthTicket(ctx, download.AuthTicket, blockNum, numBlocks); err != nil {
+			return nil, common.NewErrorf("download_file",
+				"auth ticket usage: %v", err)
+		}
+	}
+
 	// check out read pool tokens if read_price > 0
-	err = readPreRedeem(ctx, alloc, numBlocks, pendNumBlocks, payerID)
+	err = readPreRedeem(ctx, alloc, numBlocks, pendNumBlocks, download.PayerID)
 	if err != nil {
 		return nil, common.NewErrorf("download_file",
 			"pre-redeeming read marker: %v", err)
 	}
 
 	// reading is allowed
-	var (
-		downloadMode = r.FormValue("content")
-		respData     []byte
-	)
-	if len(downloadMode) > 0 && downloadMode == DOWNLOAD_CONTENT_THUMB {
+	var respData []byte
+	if download.Thumbnail {
 		var fileData = &filestore.FileInputData{}
 		fileData.Name = fileref.Name
 		fileData.Path = fileref.Path
@@ -386,7 +466,7 @@ func (fsh *StorageHandler) DownloadFile(ctx context.Context, r *http.Request) (
 		}
 	}
 
-	readMarker.PayerID = payerID
+	readMarker.PayerID = download.PayerID
 	err = readmarker.SaveLatestReadMarker(ctx, readMarker, latestRM == nil)
 	if err != nil {
 		return nil, common.NewErrorf("download_file",
@@ -401,7 +481,209 @@ func (fsh *StorageHandler) DownloadFile(ctx context.Context, r *http.Request) (
 	response.AllocationID = fileref.AllocationID
 
 	stats.FileBlockDownloaded(ctx, fileref.ID)
-	return respData, nil
+	downloadBytes.Add(float64(len(respData)))
+	return response, nil
+}
+
+// DownloadDirectory accounts the read of every block of the files below the
+// requested directory and returns the archive to stream, or the latest read
+// marker if the one sent does not follow it.
+func (fsh *StorageHandler) DownloadDirectory(ctx context.Context, r *http.Request) (
+	resp interface{}, err error) {
+
+	if r.Method == "GET" {
+		return nil, common.NewError("download_directory",
+			"invalid method used (GET), use POST instead")
+	}
+
+	var (
+		clientID     = ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+		allocationTx = ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+		alloc        *allocation.Allocation
+	)
+
+	if len(clientID) == 0 {
+		return nil, common.NewError("download_directory", "invalid client")
+	}
+
+	alloc, err = fsh.verifyAllocation(ctx, allocationTx, false)
+	if err != nil {
+		return nil, common.NewErrorf("download_directory",
+			"invalid allocation id passed: %v", err)
+	}
+
+	if err = r.ParseMultipartForm(FORM_FILE_PARSE_MAX_MEMORY); nil != err {
+		return nil, common.NewErrorf("download_directory",
+			"request_parse_error: %v", err)
+	}
+
+	pathHash, err := pathHashFromReq(r, alloc.ID)
+	if err != nil {
+		return nil, common.NewError("download_directory", "invalid path")
+	}
+
+	var format = r.FormValue("format")
+	if len(format) == 0 {
+		format = ArchiveFormatTar
+	}
+	if format != ArchiveFormatTar && format != ArchiveFormatZip {
+		return nil, common.NewErrorf("download_directory",
+			"unsupported archive format: %s", format)
+	}
+
+	var readMarker = &readmarker.ReadMarker{}
+	err = json.Unmarshal([]byte(r.FormValue("read_marker")), &readMarker)
+	if err != nil {
+		return nil, common.NewErrorf("download_directory", "invalid parameters, "+
+			"error parsing the readmarker for download: %v", err)
+	}
+
+	var rmObj = &readmarker.ReadMarkerEntity{}
+	rmObj.LatestRM = readMarker
+	if err = rmObj.VerifyMarker(ctx, alloc); err != nil {
+		return nil, common.NewErrorf("download_directory", "invalid read marker, "+
+			"failed to verify the read marker: %v", err)
+	}
+
+	dirref, err := reference.GetReferenceFromLookupHash(ctx, alloc.ID, pathHash)
+	if err != nil {
+		return nil, common.NewErrorf("download_directory",
+			"invalid path: %v", err)
+	}
+	if dirref.Type != reference.DIRECTORY {
+		return nil, common.NewError("download_directory",
+			"path is not a directory")
+	}
+
+	var payerID = alloc.OwnerID
+	if len(alloc.PayerID) > 0 {
+		payerID = alloc.PayerID
+	}
+	if r.FormValue("rx_pay") == "true" {
+		payerID = clientID
+	}
+
+	var (
+		isOwner    = clientID == alloc.OwnerID
+		isRepairer = clientID == alloc.RepairerID
+		authToken  *readmarker.AuthTicket
+	)
+
+	if !isOwner && !isRepairer &&
+		!reference.HasCollaboratorRole(ctx, alloc.ID, dirref.Path, clientID, reference.CollaboratorReader) {
+		var authTokenString = r.FormValue("auth_token")
+		if isAuthorized, err := fsh.verifyAuthTicket(ctx,
+			authTokenString, alloc, dirref, clientID,
+		); !isAuthorized {
+			return nil, common.NewErrorf("download_directory",
+				"cannot verify auth ticket: %v", err)
+		}
+		authToken = &readmarker.AuthTicket{}
+		if err = json.Unmarshal([]byte(authTokenString), authToken); err != nil {
+			return nil, common.NewErrorf("download_directory",
+				"error parsing the auth ticket for download: %v", err)
+		}
+		readMarker.AuthTicket = datatypes.JSON(authTokenString)
+	}
+
+	// the tree only holds refs below the directory, which keeps the
+	// download within the scope of the ticket
+	tree, err := reference.GetObjectTree(ctx, alloc.ID, dirref.Path)
+	if err != nil {
+		return nil, common.NewErrorf("download_directory",
+			"couldn't get directory tree: %v", err)
+	}
+
+	var (
+		refs           = flattenRefTree(tree)
+		numBlocks      int64
+		thirdPartyPays = authToken != nil
+	)
+	for _, ref := range refs {
+		if ref.Type != reference.FILE {
+			continue
+		}
+		numBlocks += ref.NumBlocks
+		if thirdPartyPays {
+			fileAttrs, err := ref.GetAttributes()
+			if err != nil {
+				return nil, common.NewErrorf("download_directory",
+					"error getting file attributes: %v", err)
+			}
+			thirdPartyPays = fileAttrs.WhoPaysForReads == common.WhoPays3rdParty
+		}
+	}
+	// the ticket holder pays only if every file says so
+	if thirdPartyPays {
+		payerID = clientID
+	}
+
+	var (
+		rme           *readmarker.ReadMarkerEntity
+		latestRM      *readmarker.ReadMarker
+		pendNumBlocks int64
+	)
+
+	rme, err = readmarker.GetLatestReadMarkerEntity(ctx, clientID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, common.NewErrorf("download_directory",
+			"couldn't get read marker from DB: %v", err)
+	}
+
+	if rme != nil {
+		latestRM = rme.LatestRM
+		if pendNumBlocks, err = rme.PendNumBlocks(); err != nil {
+			return nil, common.NewErrorf("download_directory",
+				"couldn't get number of blocks pending redeeming: %v", err)
+		}
+	}
+
+	if latestRM != nil &&
+		latestRM.ReadCounter+numBlocks != readMarker.ReadCounter {
+
+		var response = &DownloadResponse{
+			Success:      false,
+			LatestRM:     latestRM,
+			Path:         dirref.Path,
+			AllocationID: dirref.AllocationID,
+		}
+		return response, nil
+	}
+
+	if authToken != nil {
+		if err = readmarker.ConsumeAuthTicket(ctx, authToken, 1, numBlocks); err != nil {
+			return nil, common.NewErrorf("download_directory",
+				"auth ticket usage: %v", err)
+		}
+	}
+
+	err = readPreRedeem(ctx, alloc, numBlocks, pendNumBlocks, payerID)
+	if err != nil {
+		return nil, common.NewErrorf("download_directory",
+			"pre-redeeming read marker: %v", err)
+	}
+
+	// the read marker is saved before the archive is streamed, in the
+	// transaction of the pre-redeem, as the block downloads do
+	readMarker.PayerID = payerID
+	err = readmarker.SaveLatestReadMarker(ctx, readMarker, latestRM == nil)
+	if err != nil {
+		return nil, common.NewErrorf("download_directory",
+			"couldn't save latest read marker: %v", err)
+	}
+
+	for _, ref := range refs {
+		if ref.Type == reference.FILE {
+			stats.FileBlockDownloaded(ctx, ref.ID)
+		}
+	}
+
+	return &DirectoryArchive{
+		AllocationID: alloc.ID,
+		Format:       format,
+		Root:         tree,
+		Refs:         refs,
+	}, nil
 }
 
 func (fsh *StorageHandler) CommitWrite(ctx context.Context, r *http.Request) (*CommitResult, error) {
@@ -409,6 +691,29 @@ func (fsh *StorageHandler) CommitWrite(ctx context.Context, r *http.Request) (*C
 	if r.Method == "GET" {
 		return nil, common.NewError("invalid_method", "Invalid method used for the upload URL. Use POST instead")
 	}
+
+	if err := r.ParseMultipartForm(FORM_FILE_PARSE_MAX_MEMORY); nil != err {
+		Logger.Info("Error Parsing the request", zap.Any("error", err))
+		return nil, common.NewError("request_parse_error", err.Error())
+	}
+
+	req := &CommitRequest{ConnectionID: r.FormValue("connection_id")}
+	if err := json.Unmarshal([]byte(r.FormValue("write_marker")), &req.WriteMarker); err != nil {
+		return nil, common.NewErrorf("invalid_parameters",
+			"Invalid parameters. Error parsing the writemarker for commit: %v",
+			err)
+	}
+
+	return fsh.commitWrite(ctx, req)
+}
+
+func (fsh *StorageHandler) commitWrite(ctx context.Context, req *CommitRequest) (_ *CommitResult, err error) {
+	start := time.Now()
+	defer func() {
+		commitDuration.WithLabelValues(metrics.Outcome(err)).
+			Observe(time.Since(start).Seconds())
+	}()
+
 	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
 	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
 	clientKey := ctx.Value(constants.CLIENT_KEY_CONTEXT_KEY).(string)
@@ -425,7 +730,7 @@ func (fsh *StorageHandler) CommitWrite(ctx context.Context, r *http.Request) (*C
 
 	allocationID := allocationObj.ID
 
-	connectionID := r.FormValue("connection_id")
+	connectionID := req.ConnectionID
 	if len(connectionID) == 0 {
 		return nil, common.NewError("invalid_parameters", "Invalid connection id passed")
 	}
@@ -444,33 +749,23 @@ func (fsh *StorageHandler) CommitWrite(ctx context.Context, r *http.Request) (*C
 			"Invalid connection id. Connection does not have any changes.")
 	}
 
-	var isCollaborator bool
-	for _, change := range connectionObj.Changes {
-		if change.Operation == allocation.UPDATE_OPERATION {
-			updateFileChange := new(allocation.UpdateFileChange)
-			if err := updateFileChange.Unmarshal(change.Input); err != nil {
-				return nil, err
-			}
-			fileRef, err := reference.GetReference(ctx, allocationID, updateFileChange.Path)
-			if err != nil {
-				return nil, err
-			}
-			isCollaborator = reference.IsACollaborator(ctx, fileRef.ID, clientID)
-			break
-		}
-	}
-
 	if len(clientID) == 0 || len(clientKey) == 0 {
 		return nil, common.NewError("invalid_params", "Please provide clientID and clientKey")
 	}
 
-	if (allocationObj.OwnerID != clientID || encryption.Hash(clientKeyBytes) != clientID) && !isCollaborator {
-		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
-	}
-
-	if err = r.ParseMultipartForm(FORM_FILE_PARSE_MAX_MEMORY); nil != err {
-		Logger.Info("Error Parsing the request", zap.Any("error", err))
-		return nil, common.NewError("request_parse_error", err.Error())
+	// a collaborator may only commit changes touching paths it can still write to
+	var isCollaborator bool
+	if allocationObj.OwnerID != clientID || encryption.Hash(clientKeyBytes) != clientID {
+		isCollaborator = true
+		for _, path := range connectionObj.GetAffectedPaths() {
+			if !reference.HasCollaboratorRole(ctx, allocationID, path, clientID, reference.CollaboratorWriter) {
+				isCollaborator = false
+				break
+			}
+		}
+		if !isCollaborator {
+			return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+		}
 	}
 
 	if allocationObj.BlobberSizeUsed+connectionObj.Size > allocationObj.BlobberSize {
@@ -478,14 +773,7 @@ func (fsh *StorageHandler) CommitWrite(ctx context.Context, r *http.Request) (*C
 			"Max size reached for the allocation with this blobber")
 	}
 
-	writeMarkerString := r.FormValue("write_marker")
-	writeMarker := writemarker.WriteMarker{}
-	err = json.Unmarshal([]byte(writeMarkerString), &writeMarker)
-	if err != nil {
-		return nil, common.NewErrorf("invalid_parameters",
-			"Invalid parameters. Error parsing the writemarker for commit: %v",
-			err)
-	}
+	writeMarker := req.WriteMarker
 
 	var result CommitResult
 	var latestWM *writemarker.WriteMarkerEntity
@@ -570,6 +858,7 @@ func (fsh *StorageHandler) CommitWrite(ctx context.Context, r *http.Request) (*C
 	connectionObj.DeleteChanges(ctx) //nolint:errcheck // never returns an error anyway
 
 	db.Model(connectionObj).Updates(allocation.AllocationChangeCollector{Status: allocation.CommittedConnection})
+	allocation.RecordUsedBytes(allocationID, allocationObj.BlobberSizeUsed+connectionObj.Size)
 
 	result.AllocationRoot = allocationObj.AllocationRoot
 	result.WriteMarker = &writeMarker
@@ -584,6 +873,15 @@ func (fsh *StorageHandler) RenameObject(ctx context.Context, r *http.Request) (i
 	if r.Method == "GET" {
 		return nil, common.NewError("invalid_method", "Invalid method used. Use POST instead")
 	}
+	return fsh.renameObject(ctx, &RenameObjectRequest{
+		ConnectionID: r.FormValue("connection_id"),
+		Path:         r.FormValue("path"),
+		PathHash:     r.FormValue("path_hash"),
+		NewName:      r.FormValue("new_name"),
+	})
+}
+
+func (fsh *StorageHandler) renameObject(ctx context.Context, req *RenameObjectRequest) (*UploadResult, error) {
 	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
 	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, false)
 	if err != nil {
@@ -599,7 +897,7 @@ func (fsh *StorageHandler) RenameObject(ctx context.Context, r *http.Request) (i
 	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
 	_ = ctx.Value(constants.CLIENT_KEY_CONTEXT_KEY).(string)
 
-	valid, err := verifySignatureFromRequest(r, allocationObj.OwnerPublicKey)
+	valid, err := verifyClientSignatureFromContext(ctx, allocationObj)
 	if !valid || err != nil {
 		return nil, common.NewError("invalid_signature", "Invalid signature")
 	}
@@ -608,21 +906,17 @@ func (fsh *StorageHandler) RenameObject(ctx context.Context, r *http.Request) (i
 		return nil, common.NewError("invalid_operation", "Invalid client")
 	}
 
-	new_name := r.FormValue("new_name")
+	new_name := req.NewName
 	if len(new_name) == 0 {
 		return nil, common.NewError("invalid_parameters", "Invalid name")
 	}
 
-	pathHash, err := pathHashFromReq(r, allocationID)
+	pathHash, err := lookupPathHash(allocationID, req.Path, req.PathHash)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(clientID) == 0 || allocationObj.OwnerID != clientID {
-		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
-	}
-
-	connectionID := r.FormValue("connection_id")
+	connectionID := req.ConnectionID
 	if len(connectionID) == 0 {
 		return nil, common.NewError("invalid_parameters", "Invalid connection id passed")
 	}
@@ -642,6 +936,11 @@ func (fsh *StorageHandler) RenameObject(ctx context.Context, r *http.Request) (i
 		return nil, common.NewError("invalid_parameters", "Invalid file path. "+err.Error())
 	}
 
+	if allocationObj.OwnerID != clientID &&
+		!reference.HasCollaboratorRole(ctx, allocationID, objectRef.Path, clientID, reference.CollaboratorWriter) {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation or a writer of the path")
+	}
+
 	allocationChange := &allocation.AllocationChange{}
 	allocationChange.ConnectionID = connectionObj.ConnectionID
 	allocationChange.Size = 0
@@ -674,6 +973,16 @@ func (fsh *StorageHandler) UpdateObjectAttributes(ctx context.Context,
 		return nil, common.NewError("update_object_attributes",
 			"Invalid method used. Use POST instead")
 	}
+	return fsh.updateObjectAttributes(ctx, &UpdateObjectAttributesRequest{
+		ConnectionID: r.FormValue("connection_id"),
+		Path:         r.FormValue("path"),
+		PathHash:     r.FormValue("path_hash"),
+		Attributes:   r.FormValue("attributes"),
+	})
+}
+
+func (fsh *StorageHandler) updateObjectAttributes(ctx context.Context,
+	req *UpdateObjectAttributesRequest) (attrs *reference.Attributes, err error) {
 
 	var (
 		allocTx  = ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
@@ -687,7 +996,7 @@ func (fsh *StorageHandler) UpdateObjectAttributes(ctx context.Context,
 			"Invalid allocation ID passed: %v", err)
 	}
 
-	valid, err := verifySignatureFromRequest(r, alloc.OwnerPublicKey)
+	valid, err := verifyClientSignatureFromContext(ctx, alloc)
 	if !valid || err != nil {
 		return nil, common.NewError("invalid_signature", "Invalid signature")
 	}
@@ -704,30 +1013,25 @@ func (fsh *StorageHandler) UpdateObjectAttributes(ctx context.Context,
 			"missing client ID")
 	}
 
-	var attributes = r.FormValue("attributes") // new attributes as string
+	var attributes = req.Attributes // new attributes as string
 	if attributes == "" {
 		return nil, common.NewError("update_object_attributes",
 			"missing new attributes, pass at least {} for empty attributes")
 	}
 
-	var attrs = new(reference.Attributes)
+	attrs = new(reference.Attributes)
 	if err = json.Unmarshal([]byte(attributes), attrs); err != nil {
 		return nil, common.NewErrorf("update_object_attributes",
 			"decoding given attributes: %v", err)
 	}
 
-	pathHash, err := pathHashFromReq(r, alloc.ID)
+	pathHash, err := lookupPathHash(alloc.ID, req.Path, req.PathHash)
 	if err != nil {
 		return nil, common.NewError("update_object_attributes",
 			"missing path and path_hash")
 	}
 
-	if alloc.OwnerID != clientID {
-		return nil, common.NewError("update_object_attributes",
-			"operation needs to be performed by the owner of the allocation")
-	}
-
-	var connID = r.FormValue("connection_id")
+	var connID = req.ConnectionID
 	if connID == "" {
 		return nil, common.NewErrorf("update_object_attributes",
 			"invalid connection id passed: %s", connID)
@@ -752,6 +1056,12 @@ func (fsh *StorageHandler) UpdateObjectAttributes(ctx context.Context,
 			"invalid file path: %v", err)
 	}
 
+	if alloc.OwnerID != clientID &&
+		!reference.HasCollaboratorRole(ctx, alloc.ID, ref.Path, clientID, reference.CollaboratorWriter) {
+		return nil, common.NewError("update_object_attributes",
+			"operation needs to be performed by the owner of the allocation or a writer of the path")
+	}
+
 	var change = new(allocation.AllocationChange)
 	change.ConnectionID = conn.ConnectionID
 	change.Operation = allocation.UPDATE_ATTRS_OPERATION
@@ -781,13 +1091,22 @@ func (fsh *StorageHandler) CopyObject(ctx context.Context, r *http.Request) (int
 	if r.Method == "GET" {
 		return nil, common.NewError("invalid_method", "Invalid method used. Use POST instead")
 	}
+	return fsh.copyObject(ctx, &CopyObjectRequest{
+		ConnectionID: r.FormValue("connection_id"),
+		Path:         r.FormValue("path"),
+		PathHash:     r.FormValue("path_hash"),
+		Dest:         r.FormValue("dest"),
+	})
+}
+
+func (fsh *StorageHandler) copyObject(ctx context.Context, req *CopyObjectRequest) (*UploadResult, error) {
 	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
 	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, false)
 	if err != nil {
 		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
 	}
 
-	valid, err := verifySignatureFromRequest(r, allocationObj.OwnerPublicKey)
+	valid, err := verifyClientSignatureFromContext(ctx, allocationObj)
 	if !valid || err != nil {
 		return nil, common.NewError("invalid_signature", "Invalid signature")
 	}
@@ -805,21 +1124,17 @@ func (fsh *StorageHandler) CopyObject(ctx context.Context, r *http.Request) (int
 		return nil, common.NewError("invalid_operation", "Invalid client")
 	}
 
-	destPath := r.FormValue("dest")
+	destPath := req.Dest
 	if len(destPath) == 0 {
 		return nil, common.NewError("invalid_parameters", "Invalid destination for operation")
 	}
 
-	pathHash, err := pathHashFromReq(r, allocationID)
+	pathHash, err := lookupPathHash(allocationID, req.Path, req.PathHash)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(clientID) == 0 || allocationObj.OwnerID != clientID {
-		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
-	}
-
-	connectionID := r.FormValue("connection_id")
+	connectionID := req.ConnectionID
 	if len(connectionID) == 0 {
 		return nil, common.NewError("invalid_parameters", "Invalid connection id passed")
 	}
@@ -838,6 +1153,12 @@ func (fsh *StorageHandler) CopyObject(ctx context.Context, r *http.Request) (int
 	if err != nil {
 		return nil, common.NewError("invalid_parameters", "Invalid file path. "+err.Error())
 	}
+	if allocationObj.OwnerID != clientID &&
+		(!reference.HasCollaboratorRole(ctx, allocationID, objectRef.Path, clientID, reference.CollaboratorReader) ||
+			!reference.HasCollaboratorRole(ctx, allocationID, destPath, clientID, reference.CollaboratorWriter)) {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation or a writer of the destination")
+	}
+
 	newPath := filepath.Join(destPath, objectRef.Name)
 	destRef, _ := reference.GetReference(ctx, allocationID, newPath)
 	if destRef != nil {
@@ -874,8 +1195,7 @@ func (fsh *StorageHandler) CopyObject(ctx context.Context, r *http.Request) (int
 	return result, nil
 }
 
-func (fsh *StorageHandler) DeleteFile(ctx context.Context, r *http.Request, connectionObj *allocation.AllocationChangeCollector) (*UploadResult, error) {
-	path := r.FormValue("path")
+func (fsh *StorageHandler) DeleteFile(ctx context.Context, path string, connectionObj *allocation.AllocationChangeCollector) (*UploadResult, error) {
 	if len(path) == 0 {
 		return nil, common.NewError("invalid_parameters", "Invalid path")
 	}
@@ -915,6 +1235,63 @@ func (fsh *StorageHandler) WriteFile(ctx context.Context, r *http.Request) (*Upl
 		return nil, common.NewError("invalid_method", "Invalid method used for the upload URL. Use multi-part form POST / PUT / DELETE instead")
 	}
 
+	allocationObj, err := fsh.writeAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.ParseMultipartForm(FORM_FILE_PARSE_MAX_MEMORY); err != nil {
+		Logger.Info("Error Parsing the request", zap.Any("error", err))
+		return nil, common.NewError("request_parse_error", err.Error())
+	}
+
+	req := &WriteFileRequest{
+		ConnectionID: r.FormValue("connection_id"),
+		Mode:         allocation.INSERT_OPERATION,
+	}
+	if r.Method == "PUT" {
+		req.Mode = allocation.UPDATE_OPERATION
+	} else if r.Method == "DELETE" {
+		req.Mode = allocation.DELETE_OPERATION
+	}
+
+	if req.Mode == allocation.DELETE_OPERATION {
+		req.Path = r.FormValue("path")
+		req.PathHash = r.FormValue("path_hash")
+		return fsh.writeFile(ctx, allocationObj, req)
+	}
+
+	formField := "uploadMeta"
+	if req.Mode == allocation.UPDATE_OPERATION {
+		formField = "updateMeta"
+	}
+	uploadMetaString := r.FormValue(formField)
+	err = json.Unmarshal([]byte(uploadMetaString), &req.Meta)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters",
+			"Invalid parameters. Error parsing the meta data for upload."+err.Error())
+	}
+
+	origfile, _, err := r.FormFile("uploadFile")
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Error Reading multi parts for file."+err.Error())
+	}
+	defer origfile.Close()
+	req.File = origfile
+
+	thumbfile, thumbHeader, _ := r.FormFile("uploadThumbnailFile")
+	if thumbHeader != nil {
+		defer thumbfile.Close()
+		req.Thumbnail = thumbfile
+		req.ThumbnailFilename = thumbHeader.Filename
+	}
+
+	return fsh.writeFile(ctx, allocationObj, req)
+}
+
+// writeAccess returns the allocation of the context, once verified that the
+// client can write to it.
+func (fsh *StorageHandler) writeAccess(ctx context.Context) (*allocation.Allocation, error) {
 	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
 	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
 
@@ -923,7 +1300,7 @@ func (fsh *StorageHandler) WriteFile(ctx context.Context, r *http.Request) (*Upl
 		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
 	}
 
-	valid, err := verifySignatureFromRequest(r, allocationObj.OwnerPublicKey)
+	valid, err := verifyClientSignatureFromContext(ctx, allocationObj)
 	if !valid || err != nil {
 		return nil, common.NewError("invalid_signature", "Invalid signature")
 	}
@@ -932,18 +1309,22 @@ func (fsh *StorageHandler) WriteFile(ctx context.Context, r *http.Request) (*Upl
 		return nil, common.NewError("immutable_allocation", "Cannot write to an immutable allocation")
 	}
 
-	allocationID := allocationObj.ID
-
 	if len(clientID) == 0 {
 		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner or the payer of the allocation")
 	}
 
-	if err := r.ParseMultipartForm(FORM_FILE_PARSE_MAX_MEMORY); err != nil {
-		Logger.Info("Error Parsing the request", zap.Any("error", err))
-		return nil, common.NewError("request_parse_error", err.Error())
-	}
+	return allocationObj, nil
+}
 
-	connectionID := r.FormValue("connection_id")
+// writeFile adds the upload, update or delete to the connection, the allocation
+// given by writeAccess.
+func (fsh *StorageHandler) writeFile(ctx context.Context, allocationObj *allocation.Allocation,
+	req *WriteFileRequest) (*UploadResult, error) {
+
+	allocationID := allocationObj.ID
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+
+	connectionID := req.ConnectionID
 	if len(connectionID) == 0 {
 		return nil, common.NewError("invalid_parameters", "Invalid connection id passed")
 	}
@@ -958,39 +1339,35 @@ func (fsh *StorageHandler) WriteFile(ctx context.Context, r *http.Request) (*Upl
 	defer mutex.Unlock()
 
 	result := &UploadResult{}
-	mode := allocation.INSERT_OPERATION
-	if r.Method == "PUT" {
-		mode = allocation.UPDATE_OPERATION
-	} else if r.Method == "DELETE" {
-		mode = allocation.DELETE_OPERATION
-	}
+	mode := req.Mode
 
 	if mode == allocation.DELETE_OPERATION {
-		if allocationObj.OwnerID != clientID && allocationObj.RepairerID != clientID {
-			return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner or the payer of the allocation")
-		}
-		result, err = fsh.DeleteFile(ctx, r, connectionObj)
-		if err != nil {
+		var pathHash string
+		if pathHash, err = lookupPathHash(allocationID, req.Path, req.PathHash); err != nil {
 			return nil, err
 		}
-	} else if mode == allocation.INSERT_OPERATION || mode == allocation.UPDATE_OPERATION {
-		var formData allocation.UpdateFileChange
-		formField := "uploadMeta"
-		if mode == allocation.UPDATE_OPERATION {
-			formField = "updateMeta"
+		// the role is checked on the path of the file deleted
+		var fileRef *reference.Ref
+		if fileRef, err = reference.GetReferenceFromLookupHash(ctx, allocationID, pathHash); err != nil {
+			return nil, common.NewError("invalid_file", "File does not exist at path")
 		}
-		uploadMetaString := r.FormValue(formField)
-		err = json.Unmarshal([]byte(uploadMetaString), &formData)
+		if allocationObj.OwnerID != clientID && allocationObj.RepairerID != clientID &&
+			!reference.HasCollaboratorRole(ctx, allocationID, fileRef.Path, clientID, reference.CollaboratorWriter) {
+			return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner, collaborator or the payer of the allocation")
+		}
+		result, err = fsh.DeleteFile(ctx, fileRef.Path, connectionObj)
 		if err != nil {
-			return nil, common.NewError("invalid_parameters",
-				"Invalid parameters. Error parsing the meta data for upload."+err.Error())
+			return nil, err
 		}
+	} else if mode == allocation.INSERT_OPERATION || mode == allocation.UPDATE_OPERATION {
+		formData := req.Meta
 		exisitingFileRef := fsh.checkIfFileAlreadyExists(ctx, allocationID, formData.Path)
 		existingFileRefSize := int64(0)
 		exisitingFileOnCloud := false
 		if mode == allocation.INSERT_OPERATION {
-			if allocationObj.OwnerID != clientID && allocationObj.RepairerID != clientID {
-				return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner or the payer of the allocation")
+			if allocationObj.OwnerID != clientID && allocationObj.RepairerID != clientID &&
+				!reference.HasCollaboratorRole(ctx, allocationID, formData.Path, clientID, reference.CollaboratorWriter) {
+				return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner, collaborator or the payer of the allocation")
 			}
 
 			if exisitingFileRef != nil {
@@ -1003,7 +1380,7 @@ func (fsh *StorageHandler) WriteFile(ctx context.Context, r *http.Request) (*Upl
 
 			if allocationObj.OwnerID != clientID &&
 				allocationObj.RepairerID != clientID &&
-				!reference.IsACollaborator(ctx, exisitingFileRef.ID, clientID) {
+				!reference.HasCollaboratorRole(ctx, allocationID, exisitingFileRef.Path, clientID, reference.CollaboratorWriter) {
 				return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner, collaborator or the payer of the allocation")
 			}
 		}
@@ -1013,24 +1390,12 @@ func (fsh *StorageHandler) WriteFile(ctx context.Context, r *http.Request) (*Upl
 			exisitingFileOnCloud = exisitingFileRef.OnCloud
 		}
 
-		origfile, _, err := r.FormFile("uploadFile")
-		if err != nil {
-			return nil, common.NewError("invalid_parameters", "Error Reading multi parts for file."+err.Error())
-		}
-		defer origfile.Close()
-
-		thumbfile, thumbHeader, _ := r.FormFile("uploadThumbnailFile")
-		thumbnailPresent := false
-		if thumbHeader != nil {
-			thumbnailPresent = true
-			defer thumbfile.Close()
-		}
-
 		fileInputData := &filestore.FileInputData{Name: formData.Filename, Path: formData.Path, OnCloud: exisitingFileOnCloud}
-		fileOutputData, err := filestore.GetFileStore().WriteFile(allocationID, fileInputData, origfile, connectionObj.ConnectionID)
+		fileOutputData, err := filestore.GetFileStore().WriteFile(allocationID, fileInputData, req.File, connectionObj.ConnectionID)
 		if err != nil {
 			return nil, common.NewError("upload_error", "Failed to upload the file. "+err.Error())
 		}
+		uploadBytes.Add(float64(fileOutputData.Size))
 
 		result.Filename = formData.Filename
 		result.Hash = fileOutputData.ContentHash
@@ -1053,12 +1418,13 @@ func (fsh *StorageHandler) WriteFile(ctx context.Context, r *http.Request) (*Upl
 		formData.Size = fileOutputData.Size
 
 		allocationSize := fileOutputData.Size
-		if thumbnailPresent {
-			thumbInputData := &filestore.FileInputData{Name: thumbHeader.Filename, Path: formData.Path}
-			thumbOutputData, err := filestore.GetFileStore().WriteFile(allocationID, thumbInputData, thumbfile, connectionObj.ConnectionID)
+		if req.Thumbnail != nil {
+			thumbInputData := &filestore.FileInputData{Name: req.ThumbnailFilename, Path: formData.Path}
+			thumbOutputData, err := filestore.GetFileStore().WriteFile(allocationID, thumbInputData, req.Thumbnail, connectionObj.ConnectionID)
 			if err != nil {
 				return nil, common.NewError("upload_error", "Failed to upload the thumbnail. "+err.Error())
 			}
+			uploadBytes.Add(float64(thumbOutputData.Size))
 			if len(formData.ThumbnailHash) > 0 && formData.ThumbnailHash != thumbOutputData.ContentHash {
 				return nil, common.NewError("content_hash_mismatch", "Content hash provided in the meta data does not match the thumbnail content")
 			}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/constants"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/reference"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageHandler_writeFile_DeleteRole(t *testing.T) {
+	alloc := &allocation.Allocation{ID: "allocation id", OwnerID: "owner"}
+	const deleted, granted = "/private/file.txt", "/shared"
+
+	tests := []struct {
+		name    string
+		exists  bool
+		wantErr string
+	}{
+		{
+			name:    "checked on the path of the hash",
+			exists:  true,
+			wantErr: "invalid_operation",
+		},
+		{
+			name:    "no file of the hash",
+			wantErr: "invalid_file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := datastore.MockTheStore(t)
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "allocation_connections"`)).
+				WillReturnRows(sqlmock.NewRows([]string{"connection_id"}))
+			refs := sqlmock.NewRows([]string{"id", "type", "allocation_id", "path", "lookup_hash"})
+			if tt.exists {
+				refs.AddRow(1, reference.FILE, alloc.ID, deleted, reference.GetReferenceLookup(alloc.ID, deleted))
+			}
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "reference_objects"`)).
+				WithArgs(alloc.ID, reference.GetReferenceLookup(alloc.ID, deleted)).
+				WillReturnRows(refs)
+			if tt.exists {
+				// the client is a writer of the path sent, not of the file
+				mock.ExpectQuery(regexp.QuoteMeta(`INNER JOIN reference_objects ON reference_objects.id = collaborators.ref_id`)).
+					WithArgs(alloc.ID, deleted, "/private", "/", "collaborator").
+					WillReturnRows(sqlmock.NewRows([]string{"role"}))
+			}
+
+			ctx := datastore.GetStore().CreateTransaction(context.Background())
+			ctx = context.WithValue(ctx, constants.CLIENT_CONTEXT_KEY, "collaborator")
+			ctx = context.WithValue(ctx, constants.CLIENT_KEY_CONTEXT_KEY, "")
+
+			_, err := (&StorageHandler{}).writeFile(ctx, alloc, &WriteFileRequest{
+				ConnectionID: "connection",
+				Mode:         allocation.DELETE_OPERATION,
+				Path:         granted,
+				PathHash:     reference.GetReferenceLookup(alloc.ID, deleted),
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
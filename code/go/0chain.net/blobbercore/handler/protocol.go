@@ -2,6 +2,7 @@ package handler
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 	"errors"
 	"context"
@@ -124,6 +125,20 @@ func BlobberAdd(ctx context.Context) (string, error) {
 	return txn.Hash, nil
 }
 
+// registered is set once the blobber is added or updated on the chain.
+var registered int32
+
+// SetRegistered records the blobber is added or updated on the chain.
+func SetRegistered() {
+	atomic.StoreInt32(&registered, 1)
+}
+
+// IsRegistered tells whether the blobber was added or updated on the chain
+// since it started.
+func IsRegistered() bool {
+	return atomic.LoadInt32(&registered) == 1
+}
+
 // ErrBlobberHasRemoved represents service health check error, where the
 // blobber has removed (by owner, in case the blobber doesn't provide its
 // service anymore). Thus the blobber shouldn't send the health check
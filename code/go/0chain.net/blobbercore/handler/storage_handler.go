@@ -2,8 +2,10 @@ package handler
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -50,6 +52,7 @@ func (fsh *StorageHandler) verifyAllocation(ctx context.Context, tx string,
 		return nil, common.NewError("verify_allocation",
 			"use of expired allocation")
 	}
+	allocation.RecordUsedBytes(alloc.ID, alloc.BlobberSizeUsed)
 
 	return
 }
@@ -67,12 +70,19 @@ func (fsh *StorageHandler) verifyAuthTicket(ctx context.Context, authTokenString
 	if err != nil {
 		return false, err
 	}
+	revoked, err := readmarker.IsAuthTicketRevoked(ctx, authToken)
+	if err != nil {
+		return false, common.NewError("auth_ticket_revocation_check_failed", err.Error())
+	}
+	if revoked {
+		return false, common.NewError("invalid_parameters", "Invalid auth ticket. Ticket has been revoked")
+	}
 	if refRequested.LookupHash != authToken.FilePathHash {
 		authTokenRef, err := reference.GetReferenceFromLookupHash(ctx, authToken.AllocationID, authToken.FilePathHash)
 		if err != nil {
 			return false, err
 		}
-		if refRequested.ParentPath != authTokenRef.Path && !strings.HasPrefix(refRequested.ParentPath, authTokenRef.Path+"/") {
+		if authTokenRef.Path != "/" && refRequested.ParentPath != authTokenRef.Path && !strings.HasPrefix(refRequested.ParentPath, authTokenRef.Path+"/") {
 			return false, common.NewError("invalid_parameters", "Auth ticket is not valid for the resource being requested")
 		}
 	}
@@ -170,12 +180,12 @@ func (fsh *StorageHandler) GetFileMeta(ctx context.Context, r *http.Request) (in
 
 	// authorize file access
 	var (
-		isOwner          = clientID == alloc.OwnerID
-		isRepairer       = clientID == alloc.RepairerID
-		isCollaborator   = reference.IsACollaborator(ctx, fileref.ID, clientID)
+		isOwner    = clientID == alloc.OwnerID
+		isRepairer = clientID == alloc.RepairerID
 	)
 
-	if !isOwner && !isRepairer && !isCollaborator {
+	if !isOwner && !isRepairer &&
+		!reference.HasCollaboratorRole(ctx, allocationID, fileref.Path, clientID, reference.CollaboratorReader) {
 		var authTokenString = r.FormValue("auth_token")
 
 		// check auth token
@@ -196,6 +206,15 @@ func (fsh *StorageHandler) AddCommitMetaTxn(ctx context.Context, r *http.Request
 	if r.Method == "GET" {
 		return nil, common.NewError("invalid_method", "Invalid method used. Use POST instead")
 	}
+	return fsh.addCommitMetaTxn(ctx, &CommitMetaTxnRequest{
+		Path:      r.FormValue("path"),
+		PathHash:  r.FormValue("path_hash"),
+		AuthToken: r.FormValue("auth_token"),
+		TxnID:     r.FormValue("txn_id"),
+	})
+}
+
+func (fsh *StorageHandler) addCommitMetaTxn(ctx context.Context, req *CommitMetaTxnRequest) (*MessageResult, error) {
 	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
 	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, true)
 
@@ -211,7 +230,7 @@ func (fsh *StorageHandler) AddCommitMetaTxn(ctx context.Context, r *http.Request
 
 	_ = ctx.Value(constants.CLIENT_KEY_CONTEXT_KEY).(string)
 
-	pathHash, err := pathHashFromReq(r, allocationID)
+	pathHash, err := lookupPathHash(allocationID, req.Path, req.PathHash)
 	if err != nil {
 		return nil, err
 	}
@@ -225,10 +244,8 @@ func (fsh *StorageHandler) AddCommitMetaTxn(ctx context.Context, r *http.Request
 		return nil, common.NewError("invalid_parameters", "Path is not a file.")
 	}
 
-	authTokenString := r.FormValue("auth_token")
-
-	if clientID != allocationObj.OwnerID || len(authTokenString) > 0 {
-		authTicketVerified, err := fsh.verifyAuthTicket(ctx, r.FormValue("auth_token"), allocationObj, fileref, clientID)
+	if clientID != allocationObj.OwnerID || len(req.AuthToken) > 0 {
+		authTicketVerified, err := fsh.verifyAuthTicket(ctx, req.AuthToken, allocationObj, fileref, clientID)
 		if err != nil {
 			return nil, err
 		}
@@ -237,33 +254,36 @@ func (fsh *StorageHandler) AddCommitMetaTxn(ctx context.Context, r *http.Request
 		}
 	}
 
-	txnID := r.FormValue("txn_id")
-	if len(txnID) == 0 {
+	if len(req.TxnID) == 0 {
 		return nil, common.NewError("invalid_parameter", "TxnID not present in the params")
 	}
 
-	err = reference.AddCommitMetaTxn(ctx, fileref.ID, txnID)
+	err = reference.AddCommitMetaTxn(ctx, fileref.ID, req.TxnID)
 	if err != nil {
 		return nil, common.NewError("add_commit_meta_txn_failed", "Failed to add commitMetaTxn with err :"+err.Error())
 	}
 
-	result := struct {
-		Msg string `json:"msg"`
-	}{
-		Msg: "Added commitMetaTxn successfully",
-	}
-
-	return result, nil
+	return &MessageResult{Msg: "Added commitMetaTxn successfully"}, nil
 }
 
 func (fsh *StorageHandler) AddCollaborator(ctx context.Context, r *http.Request) (interface{}, error) {
+	return fsh.collaborator(ctx, &CollaboratorRequest{
+		Method:   r.Method,
+		Path:     r.FormValue("path"),
+		PathHash: r.FormValue("path_hash"),
+		CollabID: r.FormValue("collab_id"),
+		Role:     r.FormValue("role"),
+	})
+}
+
+func (fsh *StorageHandler) collaborator(ctx context.Context, req *CollaboratorRequest) (interface{}, error) {
 	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
 	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, true)
 	if err != nil {
 		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
 	}
 
-	valid, err := verifySignatureFromRequest(r, allocationObj.OwnerPublicKey)
+	valid, err := verifyClientSignatureFromContext(ctx, allocationObj)
 	if !valid || err != nil {
 		return nil, common.NewError("invalid_signature", "Invalid signature")
 	}
@@ -272,48 +292,50 @@ func (fsh *StorageHandler) AddCollaborator(ctx context.Context, r *http.Request)
 	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
 	_ = ctx.Value(constants.CLIENT_KEY_CONTEXT_KEY).(string)
 
-	pathHash, err := pathHashFromReq(r, allocationID)
+	pathHash, err := lookupPathHash(allocationID, req.Path, req.PathHash)
 	if err != nil {
 		return nil, err
 	}
 
-	fileref, err := reference.GetReferenceFromLookupHash(ctx, allocationID, pathHash)
+	ref, err := reference.GetReferenceFromLookupHash(ctx, allocationID, pathHash)
 	if err != nil {
 		return nil, common.NewError("invalid_parameters", "Invalid file path. "+err.Error())
 	}
 
-	if fileref.Type != reference.FILE {
-		return nil, common.NewError("invalid_parameters", "Path is not a file.")
-	}
-
-	collabClientID := r.FormValue("collab_id")
+	collabClientID := req.CollabID
 	if len(collabClientID) == 0 {
 		return nil, common.NewError("invalid_parameter", "collab_id not present in the params")
 	}
 
-	var result struct {
-		Msg string `json:"msg"`
+	var result MessageResult
+
+	canManage := func() bool {
+		return len(clientID) > 0 && (clientID == allocationObj.OwnerID ||
+			reference.HasCollaboratorRole(ctx, allocationID, ref.Path, clientID, reference.CollaboratorManager))
 	}
 
-	switch r.Method {
+	switch req.Method {
 	case http.MethodPost:
-		if len(clientID) == 0 || clientID != allocationObj.OwnerID {
-			return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+		if !canManage() {
+			return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation or a manager of the path")
 		}
 
-		if reference.IsACollaborator(ctx, fileref.ID, collabClientID) {
-			result.Msg = "Given client ID is already a collaborator"
-			return result, nil
+		role := reference.CollaboratorRole(req.Role)
+		if len(role) == 0 {
+			role = reference.CollaboratorWriter
+		}
+		if err = role.Validate(); err != nil {
+			return nil, err
 		}
 
-		err = reference.AddCollaborator(ctx, fileref.ID, collabClientID)
+		err = reference.AddCollaborator(ctx, ref.ID, collabClientID, role)
 		if err != nil {
 			return nil, common.NewError("add_collaborator_failed", "Failed to add collaborator with err :"+err.Error())
 		}
 		result.Msg = "Added collaborator successfully"
 
 	case http.MethodGet:
-		collaborators, err := reference.GetCollaborators(ctx, fileref.ID)
+		collaborators, err := reference.GetCollaborators(ctx, ref.ID)
 		if err != nil {
 			return nil, common.NewError("get_collaborator_failed", "Failed to get collaborators from refID with err:"+err.Error())
 		}
@@ -321,11 +343,11 @@ func (fsh *StorageHandler) AddCollaborator(ctx context.Context, r *http.Request)
 		return collaborators, nil
 
 	case http.MethodDelete:
-		if len(clientID) == 0 || clientID != allocationObj.OwnerID {
-			return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+		if !canManage() {
+			return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation or a manager of the path")
 		}
 
-		err = reference.RemoveCollaborator(ctx, fileref.ID, collabClientID)
+		err = reference.RemoveCollaborator(ctx, ref.ID, collabClientID)
 		if err != nil {
 			return nil, common.NewError("delete_collaborator_failed", "Failed to delete collaborator from refID with err:"+err.Error())
 		}
@@ -335,7 +357,213 @@ func (fsh *StorageHandler) AddCollaborator(ctx context.Context, r *http.Request)
 		return nil, common.NewError("invalid_method", "Invalid method used. Use POST/GET/DELETE instead")
 	}
 
-	return result, nil
+	return &result, nil
+}
+
+// ListCollaborators lists the collaborator grants of the allocation on the
+// given path (the root by default) and below, optionally for a single client.
+func (fsh *StorageHandler) ListCollaborators(ctx context.Context, r *http.Request) (interface{}, error) {
+	if r.Method != http.MethodGet {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use GET instead")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, true)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+
+	valid, err := verifyClientSignatureFromContext(ctx, allocationObj)
+	if !valid || err != nil {
+		return nil, common.NewError("invalid_signature", "Invalid signature")
+	}
+
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+	path := r.FormValue("path")
+	if len(path) == 0 {
+		path = "/"
+	}
+
+	if len(clientID) == 0 || (clientID != allocationObj.OwnerID &&
+		!reference.HasCollaboratorRole(ctx, allocationObj.ID, path, clientID, reference.CollaboratorManager)) {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation or a manager of the path")
+	}
+
+	grants, err := reference.GetAllocationCollaborators(ctx, allocationObj.ID, path, r.FormValue("collab_id"))
+	if err != nil {
+		return nil, common.NewError("get_collaborator_failed", "Failed to list collaborators with err:"+err.Error())
+	}
+	return grants, nil
+}
+
+// RevokeCollaborator removes every grant the collaborator holds in the allocation.
+func (fsh *StorageHandler) RevokeCollaborator(ctx context.Context, r *http.Request) (interface{}, error) {
+	if r.Method != http.MethodPost && r.Method != http.MethodDelete {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use POST/DELETE instead")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, true)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+
+	valid, err := verifyClientSignatureFromContext(ctx, allocationObj)
+	if !valid || err != nil {
+		return nil, common.NewError("invalid_signature", "Invalid signature")
+	}
+
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+	if len(clientID) == 0 || clientID != allocationObj.OwnerID {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+	}
+
+	collabClientID := r.FormValue("collab_id")
+	if len(collabClientID) == 0 {
+		return nil, common.NewError("invalid_parameter", "collab_id not present in the params")
+	}
+
+	revoked, err := reference.RemoveAllocationCollaborator(ctx, allocationObj.ID, collabClientID)
+	if err != nil {
+		return nil, common.NewError("delete_collaborator_failed", "Failed to revoke collaborator with err:"+err.Error())
+	}
+
+	return struct {
+		Msg     string `json:"msg"`
+		Revoked int64  `json:"revoked"`
+	}{
+		Msg:     "Revoked collaborator successfully",
+		Revoked: revoked,
+	}, nil
+}
+
+// RevokeAuthTicket revokes auth tickets of the allocation by signature hash
+// (or the ticket itself), by shared path hash, or by issue time.
+func (fsh *StorageHandler) RevokeAuthTicket(ctx context.Context, r *http.Request) (*readmarker.AuthTicketRevocation, error) {
+	if r.Method != http.MethodPost {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use POST instead")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, true)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+
+	valid, err := verifySignatureFromRequest(r, allocationObj.OwnerPublicKey)
+	if !valid || err != nil {
+		return nil, common.NewError("invalid_signature", "Invalid signature")
+	}
+
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+	if len(clientID) == 0 || clientID != allocationObj.OwnerID {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+	}
+
+	rev := &readmarker.AuthTicketRevocation{
+		AllocationID:  allocationObj.ID,
+		SignatureHash: r.FormValue("signature_hash"),
+		FilePathHash:  r.FormValue("file_path_hash"),
+	}
+	if authTokenString := r.FormValue("auth_ticket"); len(authTokenString) > 0 {
+		authToken := &readmarker.AuthTicket{}
+		if err = json.Unmarshal([]byte(authTokenString), authToken); err != nil {
+			return nil, common.NewError("invalid_parameters", "Error parsing the auth ticket."+err.Error())
+		}
+		if authToken.AllocationID != allocationObj.ID {
+			return nil, common.NewError("invalid_parameters", "Auth ticket is not for this allocation")
+		}
+		rev.SignatureHash = authToken.GetSignatureHash()
+		rev.Expiration = authToken.Expiration
+	}
+	if path := r.FormValue("path"); len(path) > 0 && len(rev.FilePathHash) == 0 {
+		rev.FilePathHash = reference.GetReferenceLookup(allocationObj.ID, path)
+	}
+	if issuedBefore := r.FormValue("issued_before"); len(issuedBefore) > 0 {
+		ts, err := strconv.ParseInt(issuedBefore, 10, 64)
+		if err != nil || ts <= 0 {
+			return nil, common.NewError("invalid_parameters", "Invalid issued_before")
+		}
+		rev.IssuedBefore = common.Timestamp(ts)
+	}
+	if expiration := r.FormValue("expiration"); len(expiration) > 0 {
+		ts, err := strconv.ParseInt(expiration, 10, 64)
+		if err != nil {
+			return nil, common.NewError("invalid_parameters", "Invalid expiration")
+		}
+		rev.Expiration = common.Timestamp(ts)
+	}
+	if err = rev.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err = readmarker.AddAuthTicketRevocation(ctx, rev); err != nil {
+		return nil, common.NewError("revoke_auth_ticket_failed", "Failed to save the revocation."+err.Error())
+	}
+	return rev, nil
+}
+
+// ListAuthTicketRevocations lists the revocations of the allocation still in effect.
+func (fsh *StorageHandler) ListAuthTicketRevocations(ctx context.Context, r *http.Request) ([]*readmarker.AuthTicketRevocation, error) {
+	if r.Method != http.MethodGet {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use GET instead")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, true)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+
+	valid, err := verifySignatureFromRequest(r, allocationObj.OwnerPublicKey)
+	if !valid || err != nil {
+		return nil, common.NewError("invalid_signature", "Invalid signature")
+	}
+
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+	if len(clientID) == 0 || clientID != allocationObj.OwnerID {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+	}
+
+	revs, err := readmarker.GetActiveAuthTicketRevocations(ctx, allocationObj.ID)
+	if err != nil {
+		return nil, common.NewError("list_revocations_failed", "Failed to get the revocations."+err.Error())
+	}
+	return revs, nil
+}
+
+// GetAuthTicketUsage returns how much of their limits the auth tickets of
+// the allocation have consumed on this blobber.
+func (fsh *StorageHandler) GetAuthTicketUsage(ctx context.Context, r *http.Request) ([]*readmarker.AuthTicketUsage, error) {
+	if r.Method != http.MethodGet {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use GET instead")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, true)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+
+	valid, err := verifySignatureFromRequest(r, allocationObj.OwnerPublicKey)
+	if !valid || err != nil {
+		return nil, common.NewError("invalid_signature", "Invalid signature")
+	}
+
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+	if len(clientID) == 0 || clientID != allocationObj.OwnerID {
+		return nil, common.NewError("invalid_operation", "Operation needs to be performed by the owner of the allocation")
+	}
+
+	signatureHash := r.FormValue("signature_hash")
+	if authTokenString := r.FormValue("auth_ticket"); len(authTokenString) > 0 {
+		authToken := &readmarker.AuthTicket{}
+		if err = json.Unmarshal([]byte(authTokenString), authToken); err != nil {
+			return nil, common.NewError("invalid_parameters", "Error parsing the auth ticket."+err.Error())
+		}
+		signatureHash = authToken.GetSignatureHash()
+	}
+
+	usages, err := readmarker.GetAuthTicketUsages(ctx, allocationObj.ID, signatureHash)
+	if err != nil {
+		return nil, common.NewError("auth_ticket_usage_failed", "Failed to get the auth ticket usage."+err.Error())
+	}
+	return usages, nil
 }
 
 func (fsh *StorageHandler) GetFileStats(ctx context.Context, r *http.Request) (interface{}, error) {
@@ -423,16 +651,26 @@ func (fsh *StorageHandler) ListEntities(ctx context.Context, r *http.Request) (*
 		return nil, common.NewError("invalid_parameters", "Invalid path. "+err.Error())
 	}
 	authTokenString := r.FormValue("auth_token")
+	hidePath := clientID != allocationObj.OwnerID
 	if clientID != allocationObj.OwnerID || len(authTokenString) > 0 {
-		authTicketVerified, err := fsh.verifyAuthTicket(ctx, r.FormValue("auth_token"), allocationObj, fileref, clientID)
-		if err != nil {
-			return nil, err
-		}
-		if !authTicketVerified {
-			return nil, common.NewError("auth_ticket_verification_failed", "Could not verify the auth ticket.")
+		if len(authTokenString) == 0 &&
+			reference.HasCollaboratorRole(ctx, allocationID, fileref.Path, clientID, reference.CollaboratorReader) {
+			hidePath = false
+		} else {
+			authTicketVerified, err := fsh.verifyAuthTicket(ctx, authTokenString, allocationObj, fileref, clientID)
+			if err != nil {
+				return nil, err
+			}
+			if !authTicketVerified {
+				return nil, common.NewError("auth_ticket_verification_failed", "Could not verify the auth ticket.")
+			}
 		}
 	}
 
+	if r.FormValue("recursive") == "true" {
+		return fsh.listEntitiesRecursive(ctx, allocationObj, fileref, hidePath)
+	}
+
 	dirref, err := reference.GetRefWithChildren(ctx, allocationID, fileref.Path)
 	if err != nil {
 		return nil, common.NewError("invalid_parameters", "Invalid path. "+err.Error())
@@ -441,13 +679,13 @@ func (fsh *StorageHandler) ListEntities(ctx context.Context, r *http.Request) (*
 	var result ListResult
 	result.AllocationRoot = allocationObj.AllocationRoot
 	result.Meta = dirref.GetListingData(ctx)
-	if clientID != allocationObj.OwnerID {
+	if hidePath {
 		delete(result.Meta, "path")
 	}
 	result.Entities = make([]map[string]interface{}, len(dirref.Children))
 	for idx, child := range dirref.Children {
 		result.Entities[idx] = child.GetListingData(ctx)
-		if clientID != allocationObj.OwnerID {
+		if hidePath {
 			delete(result.Entities[idx], "path")
 		}
 	}
@@ -455,6 +693,35 @@ func (fsh *StorageHandler) ListEntities(ctx context.Context, r *http.Request) (*
 	return &result, nil
 }
 
+// listEntitiesRecursive lists everything below the ref, each entity with its
+// path relative to the ref so the tree can be rebuilt without revealing
+// where it lives in the allocation.
+func (fsh *StorageHandler) listEntitiesRecursive(ctx context.Context, allocationObj *allocation.Allocation, fileref *reference.Ref, hidePath bool) (*ListResult, error) {
+	tree, err := reference.GetObjectTree(ctx, allocationObj.ID, fileref.Path)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid path. "+err.Error())
+	}
+
+	var result ListResult
+	result.AllocationRoot = allocationObj.AllocationRoot
+	result.Meta = tree.GetListingData(ctx)
+	if hidePath {
+		delete(result.Meta, "path")
+	}
+	refs := flattenRefTree(tree)
+	result.Entities = make([]map[string]interface{}, len(refs))
+	for idx, ref := range refs {
+		result.Entities[idx] = ref.GetListingData(ctx)
+		if hidePath {
+			delete(result.Entities[idx], "path")
+		}
+		relPath, _ := filepath.Rel(tree.Path, ref.Path)
+		result.Entities[idx]["relative_path"] = filepath.ToSlash(relPath)
+	}
+
+	return &result, nil
+}
+
 func (fsh *StorageHandler) GetReferencePath(ctx context.Context, r *http.Request) (*ReferencePathResult, error) {
 	resCh := make(chan *ReferencePathResult)
 	errCh := make(chan error)
@@ -603,6 +870,67 @@ func (fsh *StorageHandler) GetObjectPath(ctx context.Context, r *http.Request) (
 	return &objPathResult, nil
 }
 
+func (fsh *StorageHandler) GetInclusionProof(ctx context.Context, r *http.Request) (*InclusionProofResult, error) {
+	if r.Method == "POST" {
+		return nil, common.NewError("invalid_method", "Invalid method used. Use GET instead")
+	}
+	allocationTx := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	allocationObj, err := fsh.verifyAllocation(ctx, allocationTx, false)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid allocation id passed."+err.Error())
+	}
+	allocationID := allocationObj.ID
+
+	clientID := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+	if len(clientID) == 0 {
+		return nil, common.NewError("invalid_operation", "Please pass clientID in the header")
+	}
+
+	pathHash, err := pathHashFromReq(r, allocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	fileref, err := reference.GetReferenceFromLookupHash(ctx, allocationID, pathHash)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid file path. "+err.Error())
+	}
+
+	var (
+		isOwner    = clientID == allocationObj.OwnerID
+		isRepairer = clientID == allocationObj.RepairerID
+	)
+	if !isOwner && !isRepairer &&
+		!reference.HasCollaboratorRole(ctx, allocationID, fileref.Path, clientID, reference.CollaboratorReader) {
+		authTicketVerified, err := fsh.verifyAuthTicket(ctx, r.FormValue("auth_token"), allocationObj, fileref, clientID)
+		if err != nil {
+			return nil, err
+		}
+		if !authTicketVerified {
+			return nil, common.NewError("auth_ticket_verification_failed", "Could not verify the auth ticket.")
+		}
+	}
+
+	if len(allocationObj.AllocationRoot) == 0 {
+		return nil, common.NewError("invalid_operation", "Allocation has no committed write marker")
+	}
+	latestWM, err := writemarker.GetWriteMarkerEntity(ctx, allocationObj.AllocationRoot)
+	if err != nil {
+		return nil, common.NewError("latest_write_marker_read_error", "Error reading the latest write marker for allocation."+err.Error())
+	}
+
+	proof, err := reference.GetInclusionProof(ctx, allocationID, pathHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InclusionProofResult{
+		Proof:     proof,
+		LatestWM:  &latestWM.WM,
+		ClientKey: latestWM.ClientPublicKey,
+	}, nil
+}
+
 func (fsh *StorageHandler) GetObjectTree(ctx context.Context, r *http.Request) (*ReferencePathResult, error) {
 	if r.Method == "POST" {
 		return nil, common.NewError("invalid_method", "Invalid method used. Use GET instead")
@@ -717,7 +1045,50 @@ func verifySignatureFromRequest(r *http.Request, pbK string) (bool, error) {
 		return false, common.NewError("invalid_params", "Missing allocation tx")
 	}
 
-	hash := encryption.Hash(data)
+	return verifySignature(sign, data, pbK)
+}
+
+// verifySignatureFromContext verifies the signature of the context, set from
+// the header or the gRPC request context, of the allocation of the context.
+func verifySignatureFromContext(ctx context.Context, pbK string) (bool, error) {
+	sign, _ := ctx.Value(constants.CLIENT_SIGNATURE_HEADER_KEY).(string)
+	sign = encryption.MiraclToHerumiSig(sign)
+	if len(sign) < 64 {
+		return false, nil
+	}
+
+	data, _ := ctx.Value(constants.ALLOCATION_CONTEXT_KEY).(string)
+	if len(data) == 0 {
+		return false, common.NewError("invalid_params", "Missing allocation tx")
+	}
+
+	return verifySignature(sign, data, pbK)
+}
+
+// verifyClientSignatureFromContext verifies the signature of the context is
+// of its client, signed with the client key once checked to be the one of the
+// client, for the collaborators to sign with their own key; an owner not
+// sending the key is verified with the one of the allocation. Whether the
+// client can do what it asks is up to the caller.
+func verifyClientSignatureFromContext(ctx context.Context, alloc *allocation.Allocation) (bool, error) {
+	clientID, _ := ctx.Value(constants.CLIENT_CONTEXT_KEY).(string)
+	clientKey, _ := ctx.Value(constants.CLIENT_KEY_CONTEXT_KEY).(string)
+	if len(clientKey) == 0 {
+		if len(clientID) == 0 || clientID != alloc.OwnerID {
+			return false, nil
+		}
+		return verifySignatureFromContext(ctx, alloc.OwnerPublicKey)
+	}
+
+	clientKeyBytes, err := hex.DecodeString(clientKey)
+	if err != nil || encryption.Hash(clientKeyBytes) != clientID {
+		return false, nil
+	}
+	return verifySignatureFromContext(ctx, clientKey)
+}
+
+func verifySignature(sign, allocationTx, pbK string) (bool, error) {
+	hash := encryption.Hash(allocationTx)
 	pbK = encryption.MiraclToHerumiPK(pbK)
 	return encryption.Verify(pbK, sign, hash)
 }
@@ -747,10 +1118,11 @@ func pathsFromReq(r *http.Request) ([]string, error) {
 }
 
 func pathHashFromReq(r *http.Request, allocationID string) (string, error) {
-	var (
-		pathHash = r.FormValue("path_hash")
-		path     = r.FormValue("path")
-	)
+	return lookupPathHash(allocationID, r.FormValue("path"), r.FormValue("path_hash"))
+}
+
+// lookupPathHash returns the path hash given, the one of the path if empty.
+func lookupPathHash(allocationID, path, pathHash string) (string, error) {
 	if len(pathHash) == 0 {
 		if len(path) == 0 {
 			return "", common.NewError("invalid_parameters", "Invalid path")
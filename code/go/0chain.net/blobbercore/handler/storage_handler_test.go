@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/constants"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/readmarker"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+
+	"github.com/0chain/gosdk/core/zcncrypto"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient returns the keys of a new client and its ID.
+func newTestClient(t *testing.T) (zcncrypto.SignatureScheme, string) {
+	sch := zcncrypto.NewBLS0ChainScheme()
+	_, err := sch.GenerateKeys()
+	require.NoError(t, err)
+	keyBytes, err := hex.DecodeString(sch.GetPublicKey())
+	require.NoError(t, err)
+	return sch, encryption.Hash(keyBytes)
+}
+
+// newTestAuthTicket returns the ticket of the path for the client, signed by
+// the owner.
+func newTestAuthTicket(t *testing.T, owner zcncrypto.SignatureScheme, alloc *allocation.Allocation, clientID, path string) *readmarker.AuthTicket {
+	now := common.Now()
+	at := &readmarker.AuthTicket{
+		AllocationID: alloc.ID,
+		ClientID:     clientID,
+		OwnerID:      alloc.OwnerID,
+		FilePathHash: reference.GetReferenceLookup(alloc.ID, path),
+		FileName:     "file.txt",
+		RefType:      reference.FILE,
+		Expiration:   now + 3600,
+		Timestamp:    now,
+	}
+	var err error
+	at.Signature, err = owner.Sign(encryption.Hash(at.GetHashData()))
+	require.NoError(t, err)
+	return at
+}
+
+func expectAllocation(mock sqlmock.Sqlmock, alloc *allocation.Allocation) {
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "allocations" WHERE`)).
+		WithArgs(alloc.Tx).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "tx", "expiration_date", "owner_public_key", "owner_id"}).
+				AddRow(alloc.ID, alloc.Tx, alloc.Expiration, alloc.OwnerPublicKey, alloc.OwnerID),
+		)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "terms" WHERE`)).
+		WithArgs(alloc.ID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "allocation_id"}).
+				AddRow(alloc.Terms[0].ID, alloc.Terms[0].AllocationID),
+		)
+}
+
+func TestStorageHandler_RevokeAuthTicket(t *testing.T) {
+	owner, ownerID := newTestClient(t)
+	other, otherID := newTestClient(t)
+
+	alloc := makeTestAllocation(common.Timestamp(time.Now().Add(time.Hour).Unix()))
+	alloc.OwnerID = ownerID
+	alloc.OwnerPublicKey = owner.GetPublicKey()
+
+	ticket := newTestAuthTicket(t, owner, alloc, otherID, "/file.txt")
+	ticketJSON, err := json.Marshal(ticket)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		signer   zcncrypto.SignatureScheme
+		clientID string
+		wantErr  string
+	}{
+		{
+			name:     "owner",
+			signer:   owner,
+			clientID: ownerID,
+		},
+		{
+			name:     "non-owner",
+			signer:   other,
+			clientID: otherID,
+			wantErr:  "invalid_signature",
+		},
+		{
+			name:     "non-owner with the signature of the owner",
+			signer:   owner,
+			clientID: otherID,
+			wantErr:  "invalid_operation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := datastore.MockTheStore(t)
+			mock.ExpectBegin()
+			expectAllocation(mock, alloc)
+			if tt.wantErr == "" {
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "auth_ticket_revocations"`)).
+					WithArgs(alloc.ID, ticket.GetSignatureHash(), "", 0, ticket.Expiration, sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			}
+
+			sign, err := tt.signer.Sign(encryption.Hash(alloc.Tx))
+			require.NoError(t, err)
+			form := url.Values{"auth_ticket": {string(ticketJSON)}}
+			r := httptest.NewRequest(http.MethodPost, "/v1/authticket/revoke/"+url.PathEscape(alloc.Tx),
+				strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			r.Header.Set(common.ClientSignatureHeader, sign)
+			r = mux.SetURLVars(r, map[string]string{"allocation": alloc.Tx})
+
+			ctx := datastore.GetStore().CreateTransaction(context.Background())
+			ctx = context.WithValue(ctx, constants.ALLOCATION_CONTEXT_KEY, alloc.Tx)
+			ctx = context.WithValue(ctx, constants.CLIENT_CONTEXT_KEY, tt.clientID)
+
+			rev, err := (&StorageHandler{}).RevokeAuthTicket(ctx, r)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, ticket.GetSignatureHash(), rev.SignatureHash)
+				assert.Equal(t, ticket.Expiration, rev.Expiration)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestStorageHandler_verifyAuthTicket_Revoked(t *testing.T) {
+	owner, ownerID := newTestClient(t)
+	_, clientID := newTestClient(t)
+
+	alloc := makeTestAllocation(common.Timestamp(time.Now().Add(time.Hour).Unix()))
+	alloc.OwnerID = ownerID
+	alloc.OwnerPublicKey = owner.GetPublicKey()
+
+	ticket := newTestAuthTicket(t, owner, alloc, clientID, "/file.txt")
+	ticketJSON, err := json.Marshal(ticket)
+	require.NoError(t, err)
+	ref := &reference.Ref{LookupHash: ticket.FilePathHash, Path: "/file.txt", ParentPath: "/"}
+
+	tests := []struct {
+		name    string
+		revoked int
+		wantErr bool
+	}{
+		{
+			name: "active",
+		},
+		{
+			name:    "revoked",
+			revoked: 1,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := datastore.MockTheStore(t)
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) FROM "auth_ticket_revocations"`)).
+				WithArgs(alloc.ID, sqlmock.AnyArg(), ticket.GetSignatureHash(), ticket.FilePathHash, ticket.Timestamp).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(tt.revoked))
+
+			ctx := datastore.GetStore().CreateTransaction(context.Background())
+			ok, err := (&StorageHandler{}).verifyAuthTicket(ctx, string(ticketJSON), alloc, ref, clientID)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "revoked")
+				assert.False(t, ok)
+			} else {
+				require.NoError(t, err)
+				assert.True(t, ok)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
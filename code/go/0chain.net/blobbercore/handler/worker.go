@@ -10,6 +10,7 @@ import (
 	"0chain.net/blobbercore/reference"
 	"0chain.net/blobbercore/stats"
 	"0chain.net/core/lock"
+	"0chain.net/core/metrics"
 
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/config"
@@ -118,6 +119,7 @@ func MoveColdDataToCloud(ctx context.Context) {
 					db.Table((&reference.Ref{}).TableName()).
 						Where("size > ? AND on_cloud = ?", coldStorageMinFileSize, false).
 						Count(&totalRecords)
+					metrics.QueueDepth.WithLabelValues("cold_storage").Set(float64(totalRecords))
 
 					offset := int64(0)
 					for offset < totalRecords {
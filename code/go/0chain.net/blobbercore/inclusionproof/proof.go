@@ -0,0 +1,132 @@
+// Package inclusionproof describes the proof a blobber hands out to show
+// that a single file is committed under the allocation root signed in the
+// latest write marker, and verifies such a proof without any access to the
+// blobber's database.
+package inclusionproof
+
+import (
+	"encoding/hex"
+	"path"
+	"strconv"
+	"strings"
+
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"0chain.net/validatorcore/storage/writemarker"
+)
+
+// FileMeta carries the fields of a file reference that go into its hash.
+type FileMeta struct {
+	AllocationID   string `json:"allocation_id"`
+	Type           string `json:"type"`
+	Name           string `json:"name"`
+	Path           string `json:"path"`
+	LookupHash     string `json:"lookup_hash"`
+	Hash           string `json:"hash"`
+	Size           int64  `json:"size"`
+	ContentHash    string `json:"content_hash"`
+	MerkleRoot     string `json:"merkle_root"`
+	ActualFileSize int64  `json:"actual_file_size"`
+	ActualFileHash string `json:"actual_file_hash"`
+	Attributes     string `json:"attributes"`
+}
+
+// GetHashData returns the same data the blobber hashes for a file reference.
+func (fm *FileMeta) GetHashData() string {
+	attributes := fm.Attributes
+	if len(attributes) == 0 {
+		attributes = "{}"
+	}
+	hashArray := []string{
+		fm.AllocationID,
+		fm.Type,
+		fm.Name,
+		fm.Path,
+		strconv.FormatInt(fm.Size, 10),
+		fm.ContentHash,
+		fm.MerkleRoot,
+		strconv.FormatInt(fm.ActualFileSize, 10),
+		fm.ActualFileHash,
+		attributes,
+	}
+	return strings.Join(hashArray, ":")
+}
+
+// Level is one directory on the way from the file up to the root. ChildHashes
+// are the hashes of all children of the directory ordered by lookup hash, and
+// Index is the position of the previous level (or the file itself) among them.
+type Level struct {
+	Path        string   `json:"path"`
+	Index       int      `json:"index"`
+	ChildHashes []string `json:"child_hashes"`
+}
+
+// Proof is the chain of directory levels linking a file to the root directory.
+type Proof struct {
+	File     *FileMeta `json:"file"`
+	Levels   []*Level  `json:"levels"`
+	RootHash string    `json:"root_hash"`
+}
+
+// Verify checks that the file is included in the tree of the allocation root
+// of the write marker, signed by the client of the key given as the validator
+// checks it. Whether the client of the write marker can write to the
+// allocation is up to the caller.
+func (p *Proof) Verify(wm *writemarker.WriteMarker, clientPublicKey string) error {
+	if wm == nil {
+		return common.NewError("invalid_proof", "Proof has no write marker")
+	}
+	if p.File == nil {
+		return common.NewError("invalid_proof", "Proof has no file")
+	}
+	if p.File.Type != "f" {
+		return common.NewError("invalid_proof", "Proof is not for a file")
+	}
+	if p.File.LookupHash != encryption.Hash(p.File.AllocationID+":"+p.File.Path) {
+		return common.NewError("invalid_proof", "File lookup hash does not match the path")
+	}
+	curHash := encryption.Hash(p.File.GetHashData())
+	if curHash != p.File.Hash {
+		return common.NewError("invalid_proof", "File hash does not match the file meta")
+	}
+
+	curPath := p.File.Path
+	for _, level := range p.Levels {
+		if level == nil {
+			return common.NewError("invalid_proof", "Proof has an empty level")
+		}
+		if level.Path != path.Dir(curPath) {
+			return common.NewErrorf("invalid_proof", "Level %v is not the parent of %v", level.Path, curPath)
+		}
+		if level.Index < 0 || level.Index >= len(level.ChildHashes) {
+			return common.NewErrorf("invalid_proof", "Invalid child index %v at level %v", level.Index, level.Path)
+		}
+		if level.ChildHashes[level.Index] != curHash {
+			return common.NewErrorf("invalid_proof", "Hash of %v not found at level %v", curPath, level.Path)
+		}
+		curHash = encryption.Hash(strings.Join(level.ChildHashes, ":"))
+		curPath = level.Path
+	}
+	if curPath != "/" {
+		return common.NewError("invalid_proof", "Proof does not reach the root directory")
+	}
+	if curHash != p.RootHash {
+		return common.NewError("invalid_proof", "Calculated root hash does not match the proof")
+	}
+
+	if wm.AllocationID != p.File.AllocationID {
+		return common.NewError("invalid_proof", "Write marker is not of the allocation of the file")
+	}
+	allocationRootCalculated := encryption.Hash(p.RootHash + ":" + strconv.FormatInt(int64(wm.Timestamp), 10))
+	if allocationRootCalculated != wm.AllocationRoot {
+		return common.NewError("invalid_proof", "Allocation root does not match the write marker")
+	}
+	clientKeyBytes, _ := hex.DecodeString(clientPublicKey)
+	if wm.ClientID != encryption.Hash(clientKeyBytes) {
+		return common.NewError("invalid_proof", "Write marker is not of the client of the key")
+	}
+	if !wm.VerifySignature(clientPublicKey) {
+		return common.NewError("invalid_proof", "Invalid write marker signature")
+	}
+	return nil
+}
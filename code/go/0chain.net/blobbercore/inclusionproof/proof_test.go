@@ -0,0 +1,227 @@
+package inclusionproof_test
+
+import (
+	"context"
+	"encoding/hex"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/inclusionproof"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/core/common"
+	"0chain.net/core/config"
+	"0chain.net/core/encryption"
+	"0chain.net/validatorcore/storage/writemarker"
+
+	"github.com/0chain/gosdk/core/zcncrypto"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+const allocationID = "alloc"
+
+func newFile(parentPath, name, content string) *reference.Ref {
+	ref := reference.NewFileRef()
+	ref.AllocationID = allocationID
+	ref.Path = parentPath + name
+	if parentPath != "/" {
+		ref.Path = parentPath + "/" + name
+	}
+	ref.ParentPath = parentPath
+	ref.Name = name
+	ref.LookupHash = reference.GetReferenceLookup(allocationID, ref.Path)
+	ref.Size = int64(len(content))
+	ref.ContentHash = encryption.Hash(content)
+	ref.MerkleRoot = encryption.Hash(content + ":merkle")
+	ref.ActualFileSize = ref.Size
+	ref.ActualFileHash = ref.ContentHash
+	return ref
+}
+
+func newDir(path, parentPath, name string, children ...*reference.Ref) *reference.Ref {
+	ref := reference.NewDirectoryRef()
+	ref.AllocationID = allocationID
+	ref.Path = path
+	ref.ParentPath = parentPath
+	ref.Name = name
+	ref.LookupHash = reference.GetReferenceLookup(allocationID, path)
+	for _, child := range children {
+		ref.AddChild(child)
+	}
+	return ref
+}
+
+// refRows returns the rows of the refs in the reference_objects table.
+func refRows(refs ...*reference.Ref) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"type", "allocation_id", "lookup_hash",
+		"name", "path", "hash", "parent_path", "size", "content_hash",
+		"merkle_root", "actual_file_size", "actual_file_hash"})
+	for _, ref := range refs {
+		rows.AddRow(ref.Type, ref.AllocationID, ref.LookupHash, ref.Name,
+			ref.Path, ref.Hash, ref.ParentPath, ref.Size, ref.ContentHash,
+			ref.MerkleRoot, ref.ActualFileSize, ref.ActualFileHash)
+	}
+	return rows
+}
+
+// getInclusionProof builds the proof of the file with the blobber's builder,
+// on the DB seeded with the directories on the way to the root.
+func getInclusionProof(t *testing.T, file *reference.Ref, dirs ...*reference.Ref) *inclusionproof.Proof {
+	mock := datastore.MockTheStore(t)
+	mock.ExpectBegin()
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "reference_objects"`)).
+		WillReturnRows(refRows(file))
+	for _, dir := range dirs {
+		// the directory first, then its children ordered by lookup hash
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "reference_objects"`)).
+			WillReturnRows(refRows(append([]*reference.Ref{dir}, dir.Children...)...))
+	}
+
+	proof, err := reference.GetInclusionProof(ctx, allocationID, file.LookupHash)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	return proof
+}
+
+// signedWriteMarker returns the write marker of the root hash, signed by the
+// client of the scheme.
+func signedWriteMarker(t *testing.T, sch zcncrypto.SignatureScheme, rootHash string) *writemarker.WriteMarker {
+	keyBytes, err := hex.DecodeString(sch.GetPublicKey())
+	require.NoError(t, err)
+	timestamp := common.Timestamp(1600000000)
+	wm := &writemarker.WriteMarker{
+		AllocationRoot: encryption.Hash(rootHash + ":" + strconv.FormatInt(int64(timestamp), 10)),
+		AllocationID:   allocationID,
+		BlobberID:      "blobber",
+		Timestamp:      timestamp,
+		ClientID:       encryption.Hash(keyBytes),
+		Size:           1,
+	}
+	wm.Signature, err = sch.Sign(encryption.Hash(wm.GetHashData()))
+	require.NoError(t, err)
+	return wm
+}
+
+func TestProofVerify(t *testing.T) {
+	config.Configuration.SignatureScheme = "bls0chain"
+
+	owner := zcncrypto.NewBLS0ChainScheme()
+	_, err := owner.GenerateKeys()
+	require.NoError(t, err)
+	other := zcncrypto.NewBLS0ChainScheme()
+	_, err = other.GenerateKeys()
+	require.NoError(t, err)
+
+	target := newFile("/docs/reports", "q1.txt", "first quarter")
+	reports := newDir("/docs/reports", "/docs", "reports", target, newFile("/docs/reports", "q2.txt", "second quarter"))
+	docs := newDir("/docs", "/", "docs", reports, newFile("/docs", "readme.md", "readme"))
+	root := newDir("/", "", "/", docs, newFile("/", "a.bin", "binary"), newFile("/", "b.bin", "more binary"))
+
+	_, err = root.CalculateHash(context.Background(), false)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		tamper  func(p *inclusionproof.Proof, wm *writemarker.WriteMarker) string
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			tamper: func(p *inclusionproof.Proof, wm *writemarker.WriteMarker) string {
+				return owner.GetPublicKey()
+			},
+		},
+		{
+			name: "forged root and timestamp",
+			tamper: func(p *inclusionproof.Proof, wm *writemarker.WriteMarker) string {
+				wm.Timestamp++
+				wm.AllocationRoot = encryption.Hash(p.RootHash + ":" + strconv.FormatInt(int64(wm.Timestamp), 10))
+				return owner.GetPublicKey()
+			},
+			wantErr: true,
+		},
+		{
+			name: "signed by another client",
+			tamper: func(p *inclusionproof.Proof, wm *writemarker.WriteMarker) string {
+				*wm = *signedWriteMarker(t, other, p.RootHash)
+				return owner.GetPublicKey()
+			},
+			wantErr: true,
+		},
+		{
+			name: "key of another client",
+			tamper: func(p *inclusionproof.Proof, wm *writemarker.WriteMarker) string {
+				return other.GetPublicKey()
+			},
+			wantErr: true,
+		},
+		{
+			name: "stale allocation root",
+			tamper: func(p *inclusionproof.Proof, wm *writemarker.WriteMarker) string {
+				*wm = *signedWriteMarker(t, owner, encryption.Hash("other"))
+				return owner.GetPublicKey()
+			},
+			wantErr: true,
+		},
+		{
+			name: "changed content hash",
+			tamper: func(p *inclusionproof.Proof, wm *writemarker.WriteMarker) string {
+				p.File.ContentHash = encryption.Hash("forged")
+				return owner.GetPublicKey()
+			},
+			wantErr: true,
+		},
+		{
+			name: "changed content hash with matching file hash",
+			tamper: func(p *inclusionproof.Proof, wm *writemarker.WriteMarker) string {
+				p.File.ContentHash = encryption.Hash("forged")
+				p.File.Hash = encryption.Hash(p.File.GetHashData())
+				return owner.GetPublicKey()
+			},
+			wantErr: true,
+		},
+		{
+			name: "moved file",
+			tamper: func(p *inclusionproof.Proof, wm *writemarker.WriteMarker) string {
+				p.File.Path = "/other/q1.txt"
+				return owner.GetPublicKey()
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong index",
+			tamper: func(p *inclusionproof.Proof, wm *writemarker.WriteMarker) string {
+				p.Levels[0].Index = 1 - p.Levels[0].Index
+				return owner.GetPublicKey()
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing level",
+			tamper: func(p *inclusionproof.Proof, wm *writemarker.WriteMarker) string {
+				p.Levels = p.Levels[:len(p.Levels)-1]
+				return owner.GetPublicKey()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proof := getInclusionProof(t, target, reports, docs, root)
+			require.Equal(t, root.Hash, proof.RootHash)
+			wm := signedWriteMarker(t, owner, root.Hash)
+			clientKey := tt.tamper(proof, wm)
+			err := proof.Verify(wm, clientKey)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -228,13 +228,13 @@ func (_m *PackageHandler) GetWriteMarkerEntity(ctx context.Context, allocation_r
 	return r0, r1
 }
 
-// IsACollaborator provides a mock function with given fields: ctx, refID, clientID
-func (_m *PackageHandler) IsACollaborator(ctx context.Context, refID int64, clientID string) bool {
-	ret := _m.Called(ctx, refID, clientID)
+// HasCollaboratorRole provides a mock function with given fields: ctx, allocationID, path, clientID, required
+func (_m *PackageHandler) HasCollaboratorRole(ctx context.Context, allocationID string, path string, clientID string, required reference.CollaboratorRole) bool {
+	ret := _m.Called(ctx, allocationID, path, clientID, required)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string) bool); ok {
-		r0 = rf(ctx, refID, clientID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, reference.CollaboratorRole) bool); ok {
+		r0 = rf(ctx, allocationID, path, clientID, required)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
@@ -0,0 +1,129 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+	"0chain.net/core/transaction"
+
+	"gorm.io/datatypes"
+)
+
+type TxnStatus int
+
+const (
+	Pending TxnStatus = iota
+	Confirmed
+	Failed
+)
+
+// PendingTxn is a smart contract transaction submitted to the miners whose
+// confirmation is awaited. Kind tells which Handler is told the outcome and
+// RefKey identifies the marker or challenge the transaction is for.
+type PendingTxn struct {
+	Hash          string           `gorm:"column:hash;primary_key" json:"hash"`
+	Kind          string           `gorm:"column:kind" json:"kind"`
+	RefKey        string           `gorm:"column:ref_key" json:"ref_key"`
+	Payload       datatypes.JSON   `gorm:"column:payload" json:"payload,omitempty"`
+	Status        TxnStatus        `gorm:"column:status" json:"status"`
+	StatusMessage string           `gorm:"column:status_message" json:"status_message"`
+	Output        string           `gorm:"column:output" json:"output,omitempty"`
+	Attempts      int              `gorm:"column:attempts" json:"attempts"`
+	SubmittedAt   common.Timestamp `gorm:"column:submitted_at" json:"submitted_at"`
+	NextCheckAt   common.Timestamp `gorm:"column:next_check_at" json:"next_check_at"`
+	ConfirmedAt   common.Timestamp `gorm:"column:confirmed_at" json:"confirmed_at,omitempty"`
+	datastore.ModelWithTS
+}
+
+func (PendingTxn) TableName() string {
+	return "txn_outbox"
+}
+
+// Handler is told the outcome of the transactions of a kind. The callbacks
+// run within the DB transaction recording the outcome; an error rolls it
+// back and the transaction is checked again later.
+type Handler struct {
+	OnConfirmed func(ctx context.Context, ptx *PendingTxn, txn *transaction.Transaction) error
+	OnFailed    func(ctx context.Context, ptx *PendingTxn) error
+}
+
+var (
+	handlersMutex sync.RWMutex
+	handlers      = make(map[string]*Handler)
+)
+
+// RegisterHandler sets the handler of the transactions of the kind.
+func RegisterHandler(kind string, handler *Handler) {
+	handlersMutex.Lock()
+	defer handlersMutex.Unlock()
+	handlers[kind] = handler
+}
+
+func getHandler(kind string) *Handler {
+	handlersMutex.RLock()
+	defer handlersMutex.RUnlock()
+	return handlers[kind]
+}
+
+// Enqueue records the submitted transaction so it gets confirmed in the
+// background. It is saved within the DB transaction of the context, together
+// with the state change of the submitter.
+func Enqueue(ctx context.Context, kind, refKey, hash string, payload interface{}) error {
+	ptx := &PendingTxn{
+		Hash:        hash,
+		Kind:        kind,
+		RefKey:      refKey,
+		Status:      Pending,
+		SubmittedAt: common.Now(),
+	}
+	ptx.NextCheckAt = ptx.SubmittedAt + common.Timestamp(config.Configuration.TxnConfirmInitialDelay)
+	if payload != nil {
+		payloadBytes, err := json.Marshal(payload)
+		if err != nil {
+			return common.NewErrorf("outbox_enqueue", "encoding payload: %v", err)
+		}
+		ptx.Payload = datatypes.JSON(payloadBytes)
+	}
+
+	db := datastore.GetStore().GetTransaction(ctx)
+	if err := db.Create(ptx).Error; err != nil {
+		return common.NewErrorf("outbox_enqueue", "saving transaction %s: %v", hash, err)
+	}
+	txnSubmitted(kind)
+	return nil
+}
+
+// HasPending returns true if a transaction of the kind for the ref awaits
+// confirmation.
+func HasPending(ctx context.Context, kind, refKey string) (bool, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var count int64
+	err := db.Model(&PendingTxn{}).
+		Where("kind = ? AND ref_key = ? AND status = ?", kind, refKey, Pending).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// retryDelay is the backoff before the next check of a transaction which
+// failed the given number of checks.
+func retryDelay(attempts int) common.Timestamp {
+	delay := config.Configuration.TxnConfirmInitialDelay
+	if delay <= 0 {
+		delay = 1
+	}
+	maxDelay := config.Configuration.TxnConfirmMaxBackoff
+	for i := 1; i < attempts && (maxDelay <= 0 || delay < maxDelay); i++ {
+		delay *= 2
+	}
+	if maxDelay > 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+	return common.Timestamp(delay)
+}
@@ -0,0 +1,22 @@
+package outbox
+
+import (
+	"testing"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/core/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryDelay(t *testing.T) {
+	config.Configuration.TxnConfirmInitialDelay = 5
+	config.Configuration.TxnConfirmMaxBackoff = 60
+
+	for attempts, want := range []common.Timestamp{5, 5, 10, 20, 40, 60, 60} {
+		require.Equal(t, want, retryDelay(attempts), "attempts %d", attempts)
+	}
+
+	config.Configuration.TxnConfirmInitialDelay = 0
+	require.Equal(t, common.Timestamp(1), retryDelay(1))
+}
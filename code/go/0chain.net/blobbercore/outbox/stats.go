@@ -0,0 +1,124 @@
+package outbox
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// KindStats are the counters of the transactions of a kind since start.
+// Latencies are the seconds from submission to confirmation.
+type KindStats struct {
+	Submitted   int64   `json:"submitted"`
+	Confirmed   int64   `json:"confirmed"`
+	Failed      int64   `json:"failed"`
+	Pending     int64   `json:"pending"`
+	AvgLatency  float64 `json:"avg_confirmation_latency"`
+	MaxLatency  int64   `json:"max_confirmation_latency"`
+	LastLatency int64   `json:"last_confirmation_latency"`
+
+	totalLatency int64
+}
+
+var (
+	statsMutex sync.Mutex
+	kindStats  = make(map[string]*KindStats)
+
+	txnsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blobber_chain_txns_total",
+		Help: "Smart contract transactions of the outbox, by kind and outcome: submitted, confirmed or failed.",
+	}, []string{"kind", "outcome"})
+	txnConfirmation = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "blobber_chain_txn_confirmation_seconds",
+		Help:    "Time from the submission of the transactions to their confirmation, by kind.",
+		Buckets: []float64{1, 2, 5, 10, 20, 30, 60, 120, 300},
+	}, []string{"kind"})
+)
+
+func getKindStats(kind string) *KindStats {
+	ks, ok := kindStats[kind]
+	if !ok {
+		ks = &KindStats{}
+		kindStats[kind] = ks
+	}
+	return ks
+}
+
+func txnSubmitted(kind string) {
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+	getKindStats(kind).Submitted++
+	txnsTotal.WithLabelValues(kind, "submitted").Inc()
+}
+
+func txnConfirmed(kind string, latency common.Timestamp) {
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+	ks := getKindStats(kind)
+	ks.Confirmed++
+	ks.LastLatency = int64(latency)
+	ks.totalLatency += int64(latency)
+	ks.AvgLatency = float64(ks.totalLatency) / float64(ks.Confirmed)
+	if ks.LastLatency > ks.MaxLatency {
+		ks.MaxLatency = ks.LastLatency
+	}
+	txnsTotal.WithLabelValues(kind, "confirmed").Inc()
+	txnConfirmation.WithLabelValues(kind).Observe(float64(latency))
+}
+
+func txnFailed(kind string) {
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+	getKindStats(kind).Failed++
+	txnsTotal.WithLabelValues(kind, "failed").Inc()
+}
+
+// GetStats returns the counters per kind, with the number of transactions
+// still awaiting confirmation.
+func GetStats(ctx context.Context) (map[string]*KindStats, error) {
+	var pending []struct {
+		Kind  string
+		Count int64
+	}
+	db := datastore.GetStore().GetTransaction(ctx)
+	err := db.Model(&PendingTxn{}).
+		Select("kind, count(*) AS count").
+		Where("status = ?", Pending).
+		Group("kind").
+		Scan(&pending).Error
+	if err != nil {
+		return nil, err
+	}
+
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+	result := make(map[string]*KindStats, len(kindStats))
+	for kind, ks := range kindStats {
+		cp := *ks
+		result[kind] = &cp
+	}
+	for _, p := range pending {
+		if _, ok := result[p.Kind]; !ok {
+			result[p.Kind] = &KindStats{}
+		}
+		result[p.Kind].Pending = p.Count
+	}
+	return result, nil
+}
+
+func StatsHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(ctx)
+	defer db.Rollback()
+	stats, err := GetStats(ctx)
+	if err != nil {
+		return nil, common.NewError("txn_stats_failed", err.Error())
+	}
+	return stats, nil
+}
@@ -0,0 +1,178 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/chain"
+	"0chain.net/core/common"
+	. "0chain.net/core/logging"
+	"0chain.net/core/metrics"
+	"0chain.net/core/transaction"
+
+	"github.com/remeh/sizedwaitgroup"
+	"go.uber.org/zap"
+)
+
+// confirmBatchSize limits the transactions checked per round.
+const confirmBatchSize = 100
+
+func SetupWorkers(ctx context.Context) {
+	go ConfirmTransactions(ctx)
+}
+
+// ConfirmTransactions checks the pending transactions with the sharders once
+// they are due, until each is confirmed or has timed out.
+func ConfirmTransactions(ctx context.Context) {
+	freq := config.Configuration.TxnConfirmFreq
+	if freq <= 0 {
+		freq = 1
+	}
+	ticker := time.NewTicker(time.Duration(freq) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			confirmDue(ctx)
+		}
+	}
+}
+
+func confirmDue(ctx context.Context) {
+	rctx := datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(rctx)
+	due := make([]*PendingTxn, 0)
+	err := db.Where("status = ? AND next_check_at <= ?", Pending, common.Now()).
+		Order("next_check_at").
+		Limit(confirmBatchSize).
+		Find(&due).Error
+	db.Rollback()
+	rctx.Done()
+	if err != nil {
+		Logger.Error("Error getting the transactions to confirm", zap.Error(err))
+		return
+	}
+	metrics.QueueDepth.WithLabelValues("txn_confirmation").Set(float64(len(due)))
+
+	swg := sizedwaitgroup.New(config.Configuration.TxnConfirmNumWorkers)
+	for _, ptx := range due {
+		swg.Add()
+		go func(ptx *PendingTxn) {
+			defer swg.Done()
+			confirm(ctx, ptx)
+		}(ptx)
+	}
+	swg.Wait()
+}
+
+func confirm(ctx context.Context, ptx *PendingTxn) {
+	// the sharders are asked outside of the DB transaction
+	t, verifyErr := transaction.VerifyTransaction(ptx.Hash, chain.GetServerChain())
+
+	now := common.Now()
+	cctx := datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(cctx)
+	var err error
+	if verifyErr == nil {
+		err = ptx.confirmed(cctx, t, now)
+	} else {
+		err = ptx.checkFailed(cctx, verifyErr, now)
+	}
+	if err != nil {
+		db.Rollback()
+		Logger.Error("Error recording the transaction confirmation",
+			zap.String("txn", ptx.Hash), zap.String("kind", ptx.Kind), zap.Error(err))
+		ptx.postpone(ctx, now)
+		return
+	}
+	if err = db.Commit().Error; err != nil {
+		Logger.Error("Error committing the transaction confirmation",
+			zap.String("txn", ptx.Hash), zap.Error(err))
+		return
+	}
+
+	switch ptx.Status {
+	case Confirmed:
+		txnConfirmed(ptx.Kind, ptx.ConfirmedAt-ptx.SubmittedAt)
+		Logger.Info("Transaction confirmed", zap.String("txn", ptx.Hash),
+			zap.String("kind", ptx.Kind), zap.String("ref", ptx.RefKey))
+	case Failed:
+		txnFailed(ptx.Kind)
+		Logger.Error("Transaction was not confirmed", zap.String("txn", ptx.Hash),
+			zap.String("kind", ptx.Kind), zap.String("ref", ptx.RefKey),
+			zap.String("reason", ptx.StatusMessage))
+	}
+}
+
+func (ptx *PendingTxn) confirmed(ctx context.Context, t *transaction.Transaction, now common.Timestamp) error {
+	ptx.Status = Confirmed
+	ptx.StatusMessage = "confirmed"
+	ptx.Output = t.TransactionOutput
+	ptx.ConfirmedAt = now
+	ptx.Attempts++
+	if err := ptx.save(ctx); err != nil {
+		return err
+	}
+	if h := getHandler(ptx.Kind); h != nil && h.OnConfirmed != nil {
+		return h.OnConfirmed(ctx, ptx, t)
+	}
+	return nil
+}
+
+func (ptx *PendingTxn) checkFailed(ctx context.Context, verifyErr error, now common.Timestamp) error {
+	ptx.Attempts++
+	ptx.StatusMessage = verifyErr.Error()
+	if timeout := config.Configuration.TxnConfirmTimeout; timeout > 0 &&
+		now-ptx.SubmittedAt >= common.Timestamp(timeout) {
+
+		ptx.Status = Failed
+		ptx.StatusMessage = fmt.Sprintf("not confirmed after %d checks: %v",
+			ptx.Attempts, verifyErr)
+		if err := ptx.save(ctx); err != nil {
+			return err
+		}
+		if h := getHandler(ptx.Kind); h != nil && h.OnFailed != nil {
+			return h.OnFailed(ctx, ptx)
+		}
+		return nil
+	}
+	ptx.NextCheckAt = now + retryDelay(ptx.Attempts)
+	return ptx.save(ctx)
+}
+
+// postpone the next check after the outcome could not be recorded.
+func (ptx *PendingTxn) postpone(ctx context.Context, now common.Timestamp) {
+	pctx := datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(pctx)
+	err := db.Model(&PendingTxn{}).Where("hash = ?", ptx.Hash).
+		Updates(map[string]interface{}{
+			"attempts":      ptx.Attempts,
+			"next_check_at": now + retryDelay(ptx.Attempts),
+		}).Error
+	if err == nil {
+		err = db.Commit().Error
+	} else {
+		db.Rollback()
+	}
+	if err != nil {
+		Logger.Error("Error postponing the transaction check",
+			zap.String("txn", ptx.Hash), zap.Error(err))
+	}
+}
+
+func (ptx *PendingTxn) save(ctx context.Context) error {
+	db := datastore.GetStore().GetTransaction(ctx)
+	return db.Model(ptx).Updates(map[string]interface{}{
+		"status":         ptx.Status,
+		"status_message": ptx.StatusMessage,
+		"output":         ptx.Output,
+		"attempts":       ptx.Attempts,
+		"next_check_at":  ptx.NextCheckAt,
+		"confirmed_at":   ptx.ConfirmedAt,
+	}).Error
+}
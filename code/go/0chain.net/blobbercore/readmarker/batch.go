@@ -0,0 +1,287 @@
+package readmarker
+
+import (
+	"context"
+	"encoding/json"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/outbox"
+	"0chain.net/core/chain"
+	"0chain.net/core/common"
+	"0chain.net/core/transaction"
+
+	. "0chain.net/core/logging"
+	"github.com/remeh/sizedwaitgroup"
+	"go.uber.org/zap"
+)
+
+// RedeemBatchTxnKind is the kind of the outbox transactions redeeming the
+// read markers of an allocation together.
+const RedeemBatchTxnKind = "read_redeem_batch"
+
+// ReadRedeemBatch is the input of the read_redeem_batch function of the
+// storage smart contract. The function is not in the storage smart contract
+// of the 0chain releases, only in the mock chain, so the batches are only
+// used with readmarker_redeem.batch, off by default, and if the chain lists
+// it at startup; otherwise the markers are redeemed one by one with
+// read_redeem.
+type ReadRedeemBatch struct {
+	ReadMarkers []*ReadMarker `json:"read_markers"`
+}
+
+// ReadRedeemResult is the outcome of a read marker of a batch. The output
+// of the transaction is the list of the results, a marker failing does not
+// fail the others.
+type ReadRedeemResult struct {
+	ClientID string                      `json:"client_id"`
+	Redeems  []allocation.ReadPoolRedeem `json:"redeems"`
+	Error    string                      `json:"error,omitempty"`
+}
+
+// redeemBatchSupported asks the chain for the functions of its storage
+// smart contract and returns true if read_redeem_batch is one of them. A
+// chain not listing them, as the 0chain releases, is taken as not having it.
+func redeemBatchSupported() bool {
+	resp, err := transaction.MakeSCRestAPICall(
+		transaction.STORAGE_CONTRACT_ADDRESS, "/functions", nil,
+		chain.GetServerChain(), nil)
+	if err != nil {
+		Logger.Info("The storage smart contract functions are unknown",
+			zap.Error(err))
+		return false
+	}
+	var sc struct {
+		Functions []string `json:"functions"`
+	}
+	if err = json.Unmarshal(resp, &sc); err != nil {
+		Logger.Info("Decoding the storage smart contract functions",
+			zap.Error(err))
+		return false
+	}
+	for _, name := range sc.Functions {
+		if name == transaction.READ_REDEEM_BATCH {
+			return true
+		}
+	}
+	return false
+}
+
+// redeemBatches redeems the read markers allocation by allocation.
+func redeemBatches(ctx context.Context, readMarkers []*ReadMarkerEntity) {
+	var (
+		byAllocation = make(map[string][]*ReadMarkerEntity)
+		order        []string
+	)
+	for _, rme := range readMarkers {
+		allocationID := rme.LatestRM.AllocationID
+		if _, ok := byAllocation[allocationID]; !ok {
+			order = append(order, allocationID)
+		}
+		byAllocation[allocationID] = append(byAllocation[allocationID], rme)
+	}
+
+	swg := sizedwaitgroup.New(config.Configuration.RMRedeemNumWorkers)
+	for _, allocationID := range order {
+		swg.Add()
+		go func(redeemCtx context.Context, allocationID string) {
+			defer swg.Done()
+			redeemCtx = datastore.GetStore().CreateTransaction(redeemCtx)
+			db := datastore.GetStore().GetTransaction(redeemCtx)
+			err := RedeemReadMarkers(redeemCtx, allocationID, byAllocation[allocationID])
+			if err != nil {
+				Logger.Error("Error redeeming the read markers.",
+					zap.String("allocation", allocationID), zap.Error(err))
+				db.Rollback()
+				return
+			}
+			if err = db.Commit().Error; err != nil {
+				Logger.Error("Error commiting the readmarkers redeem", zap.Error(err))
+			}
+		}(ctx, allocationID)
+	}
+	swg.Wait()
+}
+
+// RedeemReadMarkers redeems the read markers of the allocation which are
+// due in a single transaction, up to the configured batch size. The others
+// are left for the next rounds.
+func RedeemReadMarkers(ctx context.Context, allocationID string,
+	rmes []*ReadMarkerEntity) (err error) {
+
+	pending, err := outbox.HasPending(ctx, RedeemBatchTxnKind, allocationID)
+	if err != nil || pending {
+		return // the previous batch awaits confirmation
+	}
+
+	var alloc *allocation.Allocation
+	if alloc, err = loadAllocation(ctx, allocationID); err != nil {
+		return
+	}
+
+	var (
+		policy    = GetRedeemPolicy()
+		now       = common.Now()
+		batchSize = config.Configuration.RMRedeemBatchSize
+		batch     []*ReadMarkerEntity
+	)
+	for _, rme := range rmes {
+		if batchSize > 0 && len(batch) == batchSize {
+			break
+		}
+		clientID := rme.LatestRM.ClientID
+		if pending, err = outbox.HasPending(ctx, RedeemTxnKind, clientID); err != nil {
+			return
+		} else if pending {
+			continue
+		}
+
+		if due, dueErr := rme.redeemDue(alloc, policy, now); dueErr != nil {
+			Logger.Error("Skipping read marker of the batch",
+				zap.String("client", clientID), zap.Error(dueErr))
+			continue
+		} else if !due {
+			continue
+		}
+
+		var redeem bool
+		if redeem, err = syncLatestReadMarker(ctx, rme); err != nil {
+			return // the chain is not reachable
+		} else if !redeem {
+			continue
+		}
+
+		if prepErr := rme.prepareRedeem(ctx, alloc); prepErr != nil {
+			Logger.Info("Skipping read marker of the batch",
+				zap.String("client", clientID), zap.Error(prepErr))
+			continue
+		}
+		batch = append(batch, rme)
+	}
+
+	if len(batch) == 0 {
+		return
+	}
+
+	var (
+		input   = &ReadRedeemBatch{}
+		clients = make([]string, 0, len(batch))
+	)
+	for _, rme := range batch {
+		input.ReadMarkers = append(input.ReadMarkers, rme.LatestRM)
+		clients = append(clients, rme.LatestRM.ClientID)
+	}
+
+	var inputBytes []byte
+	if inputBytes, err = json.Marshal(input); err != nil {
+		return common.NewErrorf("redeem_read_markers",
+			"encoding SC data: %v", err)
+	}
+
+	var tx *transaction.Transaction
+	if tx, err = transaction.NewTransactionEntity(); err != nil {
+		return common.NewErrorf("redeem_read_markers",
+			"creating transaction: %v", err)
+	}
+
+	err = tx.ExecuteSmartContract(transaction.STORAGE_CONTRACT_ADDRESS,
+		transaction.READ_REDEEM_BATCH, string(inputBytes), 0)
+	if err != nil {
+		Logger.Info("Failed submitting read redeem batch", zap.Error(err))
+		return common.NewErrorf("redeem_read_markers",
+			"sending transaction: %v", err)
+	}
+
+	err = outbox.Enqueue(ctx, RedeemBatchTxnKind, allocationID, tx.Hash,
+		input.ReadMarkers)
+	if err != nil {
+		return common.NewErrorf("redeem_read_markers",
+			"recording transaction: %v", err)
+	}
+
+	db := datastore.GetStore().GetTransaction(ctx)
+	err = db.Model(&ReadMarkerEntity{}).
+		Where("client_id IN ?", clients).
+		Updates(map[string]interface{}{
+			"latest_redeem_txn_id": tx.Hash,
+			"status_message":       "submitted",
+		}).Error
+	if err != nil {
+		return common.NewErrorf("redeem_read_markers",
+			"updating read markers status: %v", err)
+	}
+
+	Logger.Info("submitted read redeem batch", zap.String("txn", tx.Hash),
+		zap.String("allocation", allocationID), zap.Int("markers", len(batch)))
+	return
+}
+
+// onRedeemBatchConfirmed updates the read markers redeemed by the batch.
+func onRedeemBatchConfirmed(ctx context.Context, ptx *outbox.PendingTxn,
+	t *transaction.Transaction) error {
+
+	var rms []*ReadMarker
+	if err := json.Unmarshal(ptx.Payload, &rms); err != nil {
+		return common.NewErrorf("redeem_read_markers",
+			"decoding redeemed read markers: %v", err)
+	}
+	var results []*ReadRedeemResult
+	if err := json.Unmarshal([]byte(t.TransactionOutput), &results); err != nil {
+		return common.NewErrorf("redeem_read_markers",
+			"decoding transaction output: %v", err)
+	}
+	byClient := make(map[string]*ReadRedeemResult, len(results))
+	for _, res := range results {
+		byClient[res.ClientID] = res
+	}
+
+	db := datastore.GetStore().GetTransaction(ctx)
+	for _, rm := range rms {
+		res, ok := byClient[rm.ClientID]
+		if !ok || res.Error != "" {
+			msg := "missing in the batch output"
+			if ok {
+				msg = res.Error
+			}
+			err := db.Model(&ReadMarkerEntity{}).
+				Where("client_id = ?", rm.ClientID).
+				Update("status_message", "redeem failed: "+msg).Error
+			if err != nil {
+				return err
+			}
+			markersRedeemed.WithLabelValues("failed").Inc()
+			continue
+		}
+
+		rps, err := allocation.ReadPools(db, rm.ClientID, rm.AllocationID,
+			rm.BlobberID, common.Now())
+		if err != nil {
+			return common.NewErrorf("redeem_read_markers",
+				"can't get read pools from DB: %v", err)
+		}
+		rme := &ReadMarkerEntity{LatestRM: rm}
+		if err = rme.updateRedeemed(ctx, rps, res.Redeems, t.Hash); err != nil {
+			return err
+		}
+		markersRedeemed.WithLabelValues("redeemed").Inc()
+	}
+	return nil
+}
+
+func onRedeemBatchFailed(ctx context.Context, ptx *outbox.PendingTxn) error {
+	var rms []*ReadMarker
+	if err := json.Unmarshal(ptx.Payload, &rms); err != nil {
+		return common.NewErrorf("redeem_read_markers",
+			"decoding redeemed read markers: %v", err)
+	}
+	clients := make([]string, 0, len(rms))
+	for _, rm := range rms {
+		clients = append(clients, rm.ClientID)
+	}
+	markersRedeemed.WithLabelValues("failed").Add(float64(len(rms)))
+	db := datastore.GetStore().GetTransaction(ctx)
+	return db.Model(&ReadMarkerEntity{}).
+		Where("client_id IN ?", clients).
+		Update("status_message", "redeem failed: "+ptx.StatusMessage).Error
+}
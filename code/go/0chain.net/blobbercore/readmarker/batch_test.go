@@ -0,0 +1,57 @@
+package readmarker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"0chain.net/core/chain"
+	"0chain.net/core/config"
+	"0chain.net/core/logging"
+	"0chain.net/core/mockchain"
+
+	"github.com/0chain/gosdk/zcncore"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRedeemBatchSupported(t *testing.T) {
+	logging.Logger = zap.NewNop()
+	config.Configuration.SignatureScheme = "bls0chain"
+	chain.SetServerChain(&chain.Chain{ID: "mock_chain"})
+
+	tests := []struct {
+		name      string
+		listed    bool
+		supported bool
+	}{
+		{
+			name:      "listed by the chain",
+			listed:    true,
+			supported: true,
+		},
+		{
+			// as the 0chain releases, which have no functions method
+			name: "not listed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := mockchain.New(mockchain.Config{ChainID: "mock_chain"})
+			handler := mc.Handler()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !tt.listed && strings.HasSuffix(r.URL.Path, "/functions") {
+					http.NotFound(w, r)
+					return
+				}
+				handler.ServeHTTP(w, r)
+			}))
+			defer server.Close()
+			require.NoError(t, zcncore.InitZCNSDK(server.URL, "bls0chain"))
+
+			require.Equal(t, tt.supported, redeemBatchSupported())
+		})
+	}
+}
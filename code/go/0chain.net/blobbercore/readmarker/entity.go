@@ -11,6 +11,7 @@ import (
 	"0chain.net/core/encryption"
 
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 
 	. "0chain.net/core/logging"
 	"go.uber.org/zap"
@@ -26,14 +27,28 @@ type AuthTicket struct {
 	Expiration      common.Timestamp `json:"expiration"`
 	Timestamp       common.Timestamp `json:"timestamp"`
 	ReEncryptionKey string           `json:"re_encryption_key"`
-	Signature       string           `json:"signature"`
+	// MaxDownloads, MaxBlocks and RateLimit (blocks per minute) constrain
+	// the reads made with the ticket; zero means unlimited.
+	MaxDownloads int64  `json:"max_downloads,omitempty"`
+	MaxBlocks    int64  `json:"max_blocks,omitempty"`
+	RateLimit    int64  `json:"rate_limit,omitempty"`
+	Signature    string `json:"signature"`
 }
 
 func (rm *AuthTicket) GetHashData() string {
 	hashData := fmt.Sprintf("%v:%v:%v:%v:%v:%v:%v:%v:%v", rm.AllocationID, rm.ClientID, rm.OwnerID, rm.FilePathHash, rm.FileName, rm.RefType, rm.ReEncryptionKey, rm.Expiration, rm.Timestamp)
+	// tickets without constraints keep the old hash data to stay valid
+	if rm.IsLimited() {
+		hashData = fmt.Sprintf("%v:%v:%v:%v", hashData, rm.MaxDownloads, rm.MaxBlocks, rm.RateLimit)
+	}
 	return hashData
 }
 
+// IsLimited returns true if the ticket constrains the reads made with it.
+func (rm *AuthTicket) IsLimited() bool {
+	return rm.MaxDownloads > 0 || rm.MaxBlocks > 0 || rm.RateLimit > 0
+}
+
 func (authToken *AuthTicket) Verify(allocationObj *allocation.Allocation, clientID string) error {
 	if authToken.AllocationID != allocationObj.ID {
 		return common.NewError("invalid_parameters", "Invalid auth ticket. Allocation id mismatch")
@@ -51,6 +66,9 @@ func (authToken *AuthTicket) Verify(allocationObj *allocation.Allocation, client
 	if authToken.Timestamp > (common.Now() + 2) {
 		return common.NewError("invalid_parameters", "Invalid auth ticket. Timestamp in future")
 	}
+	if authToken.MaxDownloads < 0 || authToken.MaxBlocks < 0 || authToken.RateLimit < 0 {
+		return common.NewError("invalid_parameters", "Invalid auth ticket. Negative usage limit")
+	}
 
 	hashData := authToken.GetHashData()
 	signatureHash := encryption.Hash(hashData)
@@ -88,6 +106,8 @@ type ReadMarkerEntity struct {
 	RedeemRequired       bool           `gorm:"column:redeem_required"`
 	LastRedeemTxnID      string         `gorm:"column:latest_redeem_txn_id" json:"last_redeem_txn_id"`
 	StatusMessage        string         `gorm:"column:status_message" json:"status_message"`
+	// PendingSince is when the reads not redeemed yet began, 0 if none.
+	PendingSince common.Timestamp `gorm:"column:pending_since" json:"pending_since"`
 	datastore.ModelWithTS
 }
 
@@ -115,10 +135,17 @@ func SaveLatestReadMarker(ctx context.Context, rm *ReadMarker, isCreate bool) er
 	rmEntity.RedeemRequired = true
 
 	if isCreate {
+		rmEntity.PendingSince = common.Now()
 		return db.Create(rmEntity).Error
 	}
 
-	return db.Model(rmEntity).Updates(rmEntity).Error
+	if err := db.Model(rmEntity).Updates(rmEntity).Error; err != nil {
+		return err
+	}
+
+	// keep the time of the first read of the pending ones
+	return db.Model(rmEntity).Where("pending_since = 0").
+		Update("pending_since", common.Now()).Error
 }
 
 // Sync read marker with 0chain to be sure its correct.
@@ -170,12 +197,22 @@ func (rm *ReadMarkerEntity) UpdateStatus(ctx context.Context,
 			"can't decode transaction output: %v", err)
 	}
 
+	return rm.updateRedeemed(ctx, rps, redeems, redeemTxn)
+}
+
+// updateRedeemed records the read marker redeemed by the transaction with
+// the read pool reductions it made.
+func (rm *ReadMarkerEntity) updateRedeemed(ctx context.Context,
+	rps []*allocation.ReadPool, redeems []allocation.ReadPoolRedeem,
+	redeemTxn string) (err error) {
+
 	var db = datastore.GetStore().GetTransaction(ctx)
 
 	var rmUpdates = make(map[string]interface{})
 	rmUpdates["latest_redeem_txn_id"] = redeemTxn
 	rmUpdates["status_message"] = "success"
 	rmUpdates["redeem_required"] = false
+	rmUpdates["pending_since"] = 0
 
 	var latestRMBytes []byte
 	if latestRMBytes, err = json.Marshal(rm.LatestRM); err != nil {
@@ -192,6 +229,18 @@ func (rm *ReadMarkerEntity) UpdateStatus(ctx context.Context,
 		return common.NewError("rme_update_status", err.Error())
 	}
 
+	var value int64
+	for _, rd := range redeems {
+		value += rd.Balance
+	}
+	err = db.Table(allocation.Allocation{}.TableName()).
+		Where("id = ?", rm.LatestRM.AllocationID).
+		UpdateColumn("read_redeemed", gorm.Expr("read_redeemed + ?", value)).Error
+	if err != nil {
+		return common.NewErrorf("rme_update_status",
+			"updating redeemed value of the allocation: %v", err)
+	}
+
 	// update cache using the transaction output
 	allocation.SubReadRedeemed(rps, redeems)
 	err = allocation.SetReadPools(db, rm.LatestRM.ClientID,
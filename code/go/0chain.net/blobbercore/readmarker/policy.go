@@ -0,0 +1,55 @@
+package readmarker
+
+import (
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/config"
+	"0chain.net/core/common"
+)
+
+// RedeemPolicy tells when the pending reads of a client are worth a redeem
+// transaction. Reads below MinValue are held back, to not pay a fee per
+// tiny read, until they are MaxAge old or MaxPendingBlocks blocks pending.
+// Zero MaxAge and MaxPendingBlocks disable the limits.
+type RedeemPolicy struct {
+	MinValue         int64            `json:"min_value"`
+	MaxAge           common.Timestamp `json:"max_age"`
+	MaxPendingBlocks int64            `json:"max_pending_blocks"`
+}
+
+// GetRedeemPolicy returns the policy of the configuration.
+func GetRedeemPolicy() RedeemPolicy {
+	return RedeemPolicy{
+		MinValue:         config.Configuration.RMRedeemMinValue,
+		MaxAge:           common.Timestamp(config.Configuration.RMRedeemMaxAge),
+		MaxPendingBlocks: config.Configuration.RMRedeemMaxPendingBlocks,
+	}
+}
+
+// Due returns true if the pending reads of the value and number of blocks,
+// pending since the given time, are to be redeemed now.
+func (p RedeemPolicy) Due(value, numBlocks int64,
+	pendingSince, now common.Timestamp) bool {
+
+	switch {
+	case value >= p.MinValue:
+		return true
+	case p.MaxAge > 0 && now-pendingSince >= p.MaxAge:
+		return true
+	case p.MaxPendingBlocks > 0 && numBlocks >= p.MaxPendingBlocks:
+		return true
+	}
+	return false
+}
+
+// redeemDue values the pending reads of the marker with the terms of the
+// allocation and checks them against the policy.
+func (rme *ReadMarkerEntity) redeemDue(alloc *allocation.Allocation,
+	policy RedeemPolicy, now common.Timestamp) (bool, error) {
+
+	numBlocks, err := rme.PendNumBlocks()
+	if err != nil {
+		return false, err
+	}
+	value := alloc.WantRead(rme.LatestRM.BlobberID, numBlocks)
+	return policy.Due(value, numBlocks, rme.PendingSince, now), nil
+}
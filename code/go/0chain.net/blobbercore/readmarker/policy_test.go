@@ -0,0 +1,40 @@
+package readmarker
+
+import (
+	"testing"
+
+	"0chain.net/blobbercore/allocation"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedeemPolicyDue(t *testing.T) {
+	p := RedeemPolicy{MinValue: 100, MaxAge: 60, MaxPendingBlocks: 50}
+	require.True(t, p.Due(100, 1, 1000, 1000), "worth it")
+	require.False(t, p.Due(99, 49, 1000, 1059), "held back")
+	require.True(t, p.Due(99, 1, 1000, 1060), "too old")
+	require.True(t, p.Due(99, 50, 1000, 1000), "too many blocks")
+
+	require.True(t, RedeemPolicy{}.Due(0, 1, 1000, 1000), "no policy")
+	require.False(t, RedeemPolicy{MinValue: 100}.Due(99, 1e6, 0, 1e9), "no limits")
+}
+
+func TestRedeemDue(t *testing.T) {
+	alloc := &allocation.Allocation{Terms: []*allocation.Terms{
+		{BlobberID: "blobber", ReadPrice: 1e10},
+	}}
+	rme := &ReadMarkerEntity{
+		LatestRM:             &ReadMarker{BlobberID: "blobber", ReadCounter: 24 * 1024},
+		LatestRedeemedRMBlob: []byte(`{"counter":8192}`),
+		RedeemRequired:       true,
+		PendingSince:         1000,
+	}
+
+	// 16384 pending blocks of 64 KB are worth 1e10
+	due, err := rme.redeemDue(alloc, RedeemPolicy{MinValue: 1e10}, 1000)
+	require.NoError(t, err)
+	require.True(t, due)
+	due, err = rme.redeemDue(alloc, RedeemPolicy{MinValue: 1e10 + 1}, 1000)
+	require.NoError(t, err)
+	require.False(t, due)
+}
@@ -3,19 +3,20 @@ package readmarker
 import (
 	"context"
 	"encoding/json"
-	"time"
 
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/constants"
 
 	"0chain.net/blobbercore/datastore"
-	"0chain.net/core/chain"
+	"0chain.net/blobbercore/outbox"
 	"0chain.net/core/common"
 	"0chain.net/core/encryption"
 	"0chain.net/core/node"
 	"0chain.net/core/transaction"
 
 	. "0chain.net/core/logging"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/zap"
 )
 
@@ -197,32 +198,37 @@ func (rme *ReadMarkerEntity) preRedeem(ctx context.Context,
 	return
 }
 
-// RedeemReadMarker redeems the read marker.
-func (rme *ReadMarkerEntity) RedeemReadMarker(ctx context.Context) (
-	err error) {
-
-	if rme.LatestRM.Suspend == rme.LatestRM.ReadCounter {
-		// suspended read marker, no tokens in related read pools
-		// don't request 0chain to refresh the read pools; let user
-		// download more (he is unable to download for now) and the
-		// downloading forces the read pools cache refreshing
-		return common.NewError("redeem_read_marker",
-			"read marker redeeming suspended until next successful download")
-	}
+// loadAllocation with its terms, needed to value the reads.
+func loadAllocation(ctx context.Context, allocationID string) (
+	alloc *allocation.Allocation, err error) {
 
-	var alloc *allocation.Allocation
-	alloc, err = allocation.GetAllocationByID(ctx,
-		rme.LatestRM.AllocationID)
+	alloc, err = allocation.GetAllocationByID(ctx, allocationID)
 	if err != nil {
-		return common.NewErrorf("redeem_read_marker",
+		return nil, common.NewErrorf("redeem_read_marker",
 			"can't get allocation from DB: %v", err)
 	}
 
 	// load corresponding terms
 	if err = alloc.LoadTerms(ctx); err != nil {
-		return common.NewErrorf("redeem_read_marker",
+		return nil, common.NewErrorf("redeem_read_marker",
 			"can't load allocation terms from DB: %v", err)
 	}
+	return
+}
+
+// prepareRedeem checks the marker is not suspended and the read pools hold
+// the tokens for the reads to redeem.
+func (rme *ReadMarkerEntity) prepareRedeem(ctx context.Context,
+	alloc *allocation.Allocation) (err error) {
+
+	if rme.LatestRM.Suspend == rme.LatestRM.ReadCounter {
+		// suspended read marker, no tokens in related read pools
+		// don't request 0chain to refresh the read pools; let user
+		// download more (he is unable to download for now) and the
+		// downloading forces the read pools cache refreshing
+		return common.NewError("redeem_read_marker",
+			"read marker redeeming suspended until next successful download")
+	}
 
 	var numBlocks int64
 	if numBlocks, err = rme.getNumBlocks(); err != nil {
@@ -230,12 +236,28 @@ func (rme *ReadMarkerEntity) RedeemReadMarker(ctx context.Context) (
 			"can't get number of blocks read to redeem: %v", err)
 	}
 
-	var rps []*allocation.ReadPool
-	if rps, err = rme.preRedeem(ctx, alloc, numBlocks); err != nil {
+	// the read pools are updated from the DB cache on confirmation
+	if _, err = rme.preRedeem(ctx, alloc, numBlocks); err != nil {
 		return common.NewErrorf("redeem_read_marker",
 			"pre-redeeming error: %v", err)
 	}
 
+	return
+}
+
+// RedeemReadMarker redeems the read marker.
+func (rme *ReadMarkerEntity) RedeemReadMarker(ctx context.Context) (
+	err error) {
+
+	var alloc *allocation.Allocation
+	if alloc, err = loadAllocation(ctx, rme.LatestRM.AllocationID); err != nil {
+		return
+	}
+
+	if err = rme.prepareRedeem(ctx, alloc); err != nil {
+		return
+	}
+
 	// ok, now we can redeem the marker and then update pools in cache
 
 	var tx *transaction.Transaction
@@ -266,18 +288,17 @@ func (rme *ReadMarkerEntity) RedeemReadMarker(ctx context.Context) (
 			"sending transaction: %v", err)
 	}
 
-	time.Sleep(transaction.SLEEP_FOR_TXN_CONFIRMATION * time.Second)
-
-	var logHash = tx.Hash // keep transaction hash for error logs
-	tx, err = transaction.VerifyTransaction(tx.Hash, chain.GetServerChain())
+	err = outbox.Enqueue(ctx, RedeemTxnKind, rm.ClientID, tx.Hash, rm)
 	if err != nil {
-		Logger.Error("Error verifying the read redeem transaction",
-			zap.Error(err), zap.String("txn", logHash))
 		return common.NewErrorf("redeem_read_marker",
-			"verifying transaction: %v", err)
+			"recording transaction: %v", err)
 	}
 
-	err = rme.UpdateStatus(ctx, rps, tx.TransactionOutput, tx.Hash)
+	db := datastore.GetStore().GetTransaction(ctx)
+	err = db.Model(rme).Updates(map[string]interface{}{
+		"latest_redeem_txn_id": tx.Hash,
+		"status_message":       "submitted",
+	}).Error
 	if err != nil {
 		return common.NewErrorf("redeem_read_marker",
 			"updating read marker status: %v", err)
@@ -285,3 +306,46 @@ func (rme *ReadMarkerEntity) RedeemReadMarker(ctx context.Context) (
 
 	return // nil, ok
 }
+
+// RedeemTxnKind is the kind of the outbox transactions redeeming read markers.
+const RedeemTxnKind = "read_redeem"
+
+// markersRedeemed counts the read markers of the confirmed and failed
+// transactions, the ones of a batch each on its own.
+var markersRedeemed = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "blobber_read_markers_redeemed_total",
+	Help: "Read markers of the redeem transactions, by outcome: redeemed or failed.",
+}, []string{"outcome"})
+
+// onRedeemConfirmed updates the read marker redeemed, kept in the payload as
+// later downloads may have replaced the latest one since.
+func onRedeemConfirmed(ctx context.Context, ptx *outbox.PendingTxn, t *transaction.Transaction) error {
+	rm := &ReadMarker{}
+	if err := json.Unmarshal(ptx.Payload, rm); err != nil {
+		return common.NewErrorf("redeem_read_marker",
+			"decoding redeemed read marker: %v", err)
+	}
+
+	db := datastore.GetStore().GetTransaction(ctx)
+	rps, err := allocation.ReadPools(db, rm.ClientID, rm.AllocationID,
+		rm.BlobberID, common.Now())
+	if err != nil {
+		return common.NewErrorf("redeem_read_marker",
+			"can't get read pools from DB: %v", err)
+	}
+
+	rme := &ReadMarkerEntity{LatestRM: rm}
+	if err = rme.UpdateStatus(ctx, rps, t.TransactionOutput, t.Hash); err != nil {
+		return err
+	}
+	markersRedeemed.WithLabelValues("redeemed").Inc()
+	return nil
+}
+
+func onRedeemFailed(ctx context.Context, ptx *outbox.PendingTxn) error {
+	markersRedeemed.WithLabelValues("failed").Inc()
+	db := datastore.GetStore().GetTransaction(ctx)
+	return db.Model(&ReadMarkerEntity{}).
+		Where("client_id = ?", ptx.RefKey).
+		Update("status_message", "redeem failed: "+ptx.StatusMessage).Error
+}
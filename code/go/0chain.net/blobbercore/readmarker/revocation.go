@@ -0,0 +1,89 @@
+package readmarker
+
+import (
+	"context"
+	"time"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+)
+
+// AuthTicketRevocation invalidates auth tickets of an allocation before they
+// expire. Exactly one of SignatureHash, FilePathHash and IssuedBefore is set:
+// it revokes a single ticket, all tickets shared at a path, or all tickets
+// issued before the given time respectively.
+type AuthTicketRevocation struct {
+	ID            int64            `gorm:"column:id;primary_key" json:"id"`
+	AllocationID  string           `gorm:"column:allocation_id" json:"allocation_id"`
+	SignatureHash string           `gorm:"column:signature_hash" json:"signature_hash,omitempty"`
+	FilePathHash  string           `gorm:"column:file_path_hash" json:"file_path_hash,omitempty"`
+	IssuedBefore  common.Timestamp `gorm:"column:issued_before" json:"issued_before,omitempty"`
+	// Expiration is the time after which the revocation is no longer needed,
+	// because every ticket it matches has expired; zero keeps it forever.
+	Expiration common.Timestamp `gorm:"column:expiration" json:"expiration,omitempty"`
+	CreatedAt  time.Time        `gorm:"column:created_at" json:"created_at"`
+}
+
+func (AuthTicketRevocation) TableName() string {
+	return "auth_ticket_revocations"
+}
+
+// Validate the AuthTicketRevocation.
+func (rev *AuthTicketRevocation) Validate() error {
+	var set int
+	if len(rev.SignatureHash) > 0 {
+		set++
+	}
+	if len(rev.FilePathHash) > 0 {
+		set++
+	}
+	if rev.IssuedBefore > 0 {
+		set++
+	}
+	if set != 1 {
+		return common.NewError("invalid_revocation",
+			"exactly one of signature_hash, file_path_hash and issued_before is required")
+	}
+	if rev.Expiration < 0 {
+		return common.NewError("invalid_revocation", "negative expiration")
+	}
+	return nil
+}
+
+// GetSignatureHash returns the hash the ticket is revoked by individually.
+func (authToken *AuthTicket) GetSignatureHash() string {
+	return encryption.Hash(authToken.Signature)
+}
+
+// AddAuthTicketRevocation persists the revocation.
+func AddAuthTicketRevocation(ctx context.Context, rev *AuthTicketRevocation) error {
+	db := datastore.GetStore().GetTransaction(ctx)
+	return db.Create(rev).Error
+}
+
+// GetActiveAuthTicketRevocations lists the revocations of the allocation which
+// can still match an unexpired ticket.
+func GetActiveAuthTicketRevocations(ctx context.Context, allocationID string) ([]*AuthTicketRevocation, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	revs := make([]*AuthTicketRevocation, 0)
+	err := db.Where("allocation_id = ? AND (expiration = 0 OR expiration > ?)", allocationID, common.Now()).
+		Order("created_at desc").
+		Find(&revs).Error
+	return revs, err
+}
+
+// IsAuthTicketRevoked returns true if any active revocation matches the ticket.
+func IsAuthTicketRevoked(ctx context.Context, authToken *AuthTicket) (bool, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	var count int64
+	err := db.Model(&AuthTicketRevocation{}).
+		Where("allocation_id = ? AND (expiration = 0 OR expiration > ?)", authToken.AllocationID, common.Now()).
+		Where("signature_hash = ? OR (file_path_hash <> '' AND file_path_hash = ?) OR issued_before > ?",
+			authToken.GetSignatureHash(), authToken.FilePathHash, authToken.Timestamp).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,124 @@
+package readmarker
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+)
+
+// AllocationRedeemStatus is the value of the reads of an allocation waiting
+// to be redeemed and redeemed so far.
+type AllocationRedeemStatus struct {
+	AllocationID   string `json:"allocation_id"`
+	PendingClients int    `json:"pending_clients"`
+	DueClients     int    `json:"due_clients"`
+	PendingBlocks  int64  `json:"pending_blocks"`
+	PendingValue   int64  `json:"pending_value"`
+	RedeemedValue  int64  `json:"redeemed_value"`
+}
+
+// GetRedeemStatus returns the status of the allocation given, or of all the
+// allocations with reads pending or redeemed if the ID is empty.
+func GetRedeemStatus(ctx context.Context, allocationID string) (
+	[]*AllocationRedeemStatus, error) {
+
+	db := datastore.GetStore().GetTransaction(ctx)
+	statuses := make(map[string]*AllocationRedeemStatus)
+	get := func(id string) *AllocationRedeemStatus {
+		if _, ok := statuses[id]; !ok {
+			statuses[id] = &AllocationRedeemStatus{AllocationID: id}
+		}
+		return statuses[id]
+	}
+
+	var redeemed []struct {
+		ID           string
+		ReadRedeemed int64
+	}
+	query := db.Table(allocation.Allocation{}.TableName()).
+		Select("id, read_redeemed").
+		Where("read_redeemed > 0")
+	if allocationID != "" {
+		query = query.Where("id = ?", allocationID)
+	}
+	if err := query.Scan(&redeemed).Error; err != nil {
+		return nil, err
+	}
+	for _, r := range redeemed {
+		get(r.ID).RedeemedValue = r.ReadRedeemed
+	}
+
+	var rmes []*ReadMarkerEntity
+	query = db.Where("redeem_required = ?", true)
+	if allocationID != "" {
+		query = query.Where("allocation_id = ?", allocationID)
+	}
+	if err := query.Find(&rmes).Error; err != nil {
+		return nil, err
+	}
+
+	var (
+		allocs = make(map[string]*allocation.Allocation)
+		policy = GetRedeemPolicy()
+		now    = common.Now()
+	)
+	for _, rme := range rmes {
+		id := rme.LatestRM.AllocationID
+		alloc, ok := allocs[id]
+		if !ok {
+			var err error
+			if alloc, err = loadAllocation(ctx, id); err != nil {
+				return nil, err
+			}
+			allocs[id] = alloc
+		}
+
+		numBlocks, err := rme.PendNumBlocks()
+		if err != nil {
+			return nil, err
+		}
+		value := alloc.WantRead(rme.LatestRM.BlobberID, numBlocks)
+
+		status := get(id)
+		status.PendingClients++
+		status.PendingBlocks += numBlocks
+		status.PendingValue += value
+		if policy.Due(value, numBlocks, rme.PendingSince, now) {
+			status.DueClients++
+		}
+	}
+
+	result := make([]*AllocationRedeemStatus, 0, len(statuses))
+	for _, status := range statuses {
+		result = append(result, status)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].AllocationID < result[j].AllocationID
+	})
+	return result, nil
+}
+
+// RedeemStatusHandler serves the redeem policy and the pending and redeemed
+// read values per allocation, of the one of the "allocation" parameter if
+// given.
+func RedeemStatusHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(ctx)
+	defer db.Rollback()
+
+	allocations, err := GetRedeemStatus(ctx, r.FormValue("allocation"))
+	if err != nil {
+		return nil, common.NewError("read_redeem_status_failed", err.Error())
+	}
+	return map[string]interface{}{
+		"policy":      GetRedeemPolicy(),
+		"batch":       config.Configuration.RMRedeemBatch,
+		"batch_size":  config.Configuration.RMRedeemBatchSize,
+		"allocations": allocations,
+	}, nil
+}
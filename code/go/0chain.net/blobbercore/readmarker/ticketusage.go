@@ -0,0 +1,121 @@
+package readmarker
+
+import (
+	"context"
+	"time"
+
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+
+	"gorm.io/gorm/clause"
+)
+
+// rateLimitWindow is the period RateLimit of an auth ticket is measured over.
+const rateLimitWindow common.Timestamp = 60
+
+// AuthTicketUsage is the consumption of a single auth ticket on this blobber.
+// A download is counted each time the first block of the file is read.
+type AuthTicketUsage struct {
+	SignatureHash string           `gorm:"column:signature_hash;primary_key" json:"signature_hash"`
+	AllocationID  string           `gorm:"column:allocation_id" json:"allocation_id"`
+	FilePathHash  string           `gorm:"column:file_path_hash" json:"file_path_hash"`
+	ClientID      string           `gorm:"column:client_id" json:"client_id"`
+	MaxDownloads  int64            `gorm:"column:max_downloads" json:"max_downloads"`
+	MaxBlocks     int64            `gorm:"column:max_blocks" json:"max_blocks"`
+	RateLimit     int64            `gorm:"column:rate_limit" json:"rate_limit"`
+	Downloads     int64            `gorm:"column:downloads" json:"downloads"`
+	Blocks        int64            `gorm:"column:blocks" json:"blocks"`
+	WindowStart   common.Timestamp `gorm:"column:window_start" json:"-"`
+	WindowBlocks  int64            `gorm:"column:window_blocks" json:"-"`
+	LastReadAt    common.Timestamp `gorm:"column:last_read_at" json:"last_read_at"`
+	CreatedAt     time.Time        `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt     time.Time        `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (AuthTicketUsage) TableName() string {
+	return "auth_ticket_usages"
+}
+
+// checkAndAdd accounts the read of numBlocks starting at blockNum, or returns
+// an error if it would exceed a limit of the ticket.
+func (u *AuthTicketUsage) checkAndAdd(blockNum, numBlocks int64, now common.Timestamp) error {
+	var download int64
+	if blockNum == 1 {
+		download = 1
+	}
+	if u.MaxDownloads > 0 && u.Downloads+download > u.MaxDownloads {
+		return common.NewErrorf("auth_ticket_exhausted",
+			"download limit reached: %d of %d downloads used", u.Downloads, u.MaxDownloads)
+	}
+	if u.MaxBlocks > 0 && u.Blocks+numBlocks > u.MaxBlocks {
+		return common.NewErrorf("auth_ticket_exhausted",
+			"block limit reached: %d of %d blocks read, %d requested", u.Blocks, u.MaxBlocks, numBlocks)
+	}
+
+	if now-u.WindowStart >= rateLimitWindow {
+		u.WindowStart, u.WindowBlocks = now, 0
+	}
+	if u.RateLimit > 0 && u.WindowBlocks+numBlocks > u.RateLimit {
+		return common.NewErrorf("auth_ticket_rate_limited",
+			"rate limit of %d blocks per minute reached, retry after %d",
+			u.RateLimit, u.WindowStart+rateLimitWindow)
+	}
+
+	u.Downloads += download
+	u.Blocks += numBlocks
+	u.WindowBlocks += numBlocks
+	u.LastReadAt = now
+	return nil
+}
+
+// ConsumeAuthTicket records the read of numBlocks starting at blockNum made
+// with the ticket, failing if the ticket is exhausted or rate limited.
+func ConsumeAuthTicket(ctx context.Context, authToken *AuthTicket, blockNum, numBlocks int64) error {
+	db := datastore.GetStore().GetTransaction(ctx)
+	usage := &AuthTicketUsage{
+		SignatureHash: authToken.GetSignatureHash(),
+		AllocationID:  authToken.AllocationID,
+		FilePathHash:  authToken.FilePathHash,
+		ClientID:      authToken.ClientID,
+		MaxDownloads:  authToken.MaxDownloads,
+		MaxBlocks:     authToken.MaxBlocks,
+		RateLimit:     authToken.RateLimit,
+	}
+	err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(usage).Error
+	if err != nil {
+		return common.NewError("auth_ticket_usage", err.Error())
+	}
+	err = db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(usage, "signature_hash = ?", usage.SignatureHash).Error
+	if err != nil {
+		return common.NewError("auth_ticket_usage", err.Error())
+	}
+
+	if err = usage.checkAndAdd(blockNum, numBlocks, common.Now()); err != nil {
+		return err
+	}
+	err = db.Model(usage).Updates(map[string]interface{}{
+		"downloads":     usage.Downloads,
+		"blocks":        usage.Blocks,
+		"window_start":  usage.WindowStart,
+		"window_blocks": usage.WindowBlocks,
+		"last_read_at":  usage.LastReadAt,
+	}).Error
+	if err != nil {
+		return common.NewError("auth_ticket_usage", err.Error())
+	}
+	return nil
+}
+
+// GetAuthTicketUsages returns the consumption of the tickets of the
+// allocation, or of the single ticket if signatureHash is given.
+func GetAuthTicketUsages(ctx context.Context, allocationID string, signatureHash string) ([]*AuthTicketUsage, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	db = db.Where("allocation_id = ?", allocationID)
+	if len(signatureHash) > 0 {
+		db = db.Where("signature_hash = ?", signatureHash)
+	}
+	usages := make([]*AuthTicketUsage, 0)
+	err := db.Order("last_read_at desc").Find(&usages).Error
+	return usages, err
+}
@@ -0,0 +1,43 @@
+package readmarker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthTicketHashDataUnlimited(t *testing.T) {
+	at := &AuthTicket{AllocationID: "alloc", OwnerID: "owner", Expiration: 20, Timestamp: 10}
+	require.Equal(t, "alloc::owner:::::20:10", at.GetHashData())
+
+	at.MaxBlocks = 5
+	require.Equal(t, "alloc::owner:::::20:10:0:5:0", at.GetHashData())
+}
+
+func TestAuthTicketUsageCheckAndAdd(t *testing.T) {
+	u := &AuthTicketUsage{MaxDownloads: 2, MaxBlocks: 10, RateLimit: 6}
+
+	require.NoError(t, u.checkAndAdd(1, 4, 100))
+	require.NoError(t, u.checkAndAdd(5, 2, 110))
+	require.EqualValues(t, 1, u.Downloads)
+	require.EqualValues(t, 6, u.Blocks)
+
+	// the minute started at 100 is used up
+	err := u.checkAndAdd(1, 1, 120)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "auth_ticket_rate_limited")
+
+	require.NoError(t, u.checkAndAdd(1, 3, 160))
+	require.EqualValues(t, 2, u.Downloads)
+
+	err = u.checkAndAdd(1, 1, 230)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "download limit reached")
+
+	err = u.checkAndAdd(4, 2, 230)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "block limit reached")
+
+	require.NoError(t, u.checkAndAdd(4, 1, 230))
+	require.EqualValues(t, 10, u.Blocks)
+}
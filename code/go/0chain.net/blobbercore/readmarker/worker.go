@@ -7,8 +7,11 @@ import (
 
 	"0chain.net/blobbercore/config"
 	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/outbox"
 	"0chain.net/core/chain"
+	"0chain.net/core/common"
 	. "0chain.net/core/logging"
+	"0chain.net/core/metrics"
 	"0chain.net/core/transaction"
 
 	"github.com/remeh/sizedwaitgroup"
@@ -16,14 +19,63 @@ import (
 )
 
 func SetupWorkers(ctx context.Context) {
+	outbox.RegisterHandler(RedeemTxnKind, &outbox.Handler{
+		OnConfirmed: onRedeemConfirmed,
+		OnFailed:    onRedeemFailed,
+	})
+	outbox.RegisterHandler(RedeemBatchTxnKind, &outbox.Handler{
+		OnConfirmed: onRedeemBatchConfirmed,
+		OnFailed:    onRedeemBatchFailed,
+	})
+	if config.Configuration.RMRedeemBatch && !redeemBatchSupported() {
+		Logger.Error("The storage smart contract has no read_redeem_batch " +
+			"function, the read markers are redeemed one by one")
+		config.Configuration.RMRedeemBatch = false
+	}
 	go RedeemMarkers(ctx)
 }
 
 func RedeemReadMarker(ctx context.Context, rmEntity *ReadMarkerEntity) (
 	err error) {
 
+	pending, err := outbox.HasPending(ctx, RedeemTxnKind, rmEntity.LatestRM.ClientID)
+	if err != nil || pending {
+		return // the previous redeem awaits confirmation
+	}
+
+	alloc, err := loadAllocation(ctx, rmEntity.LatestRM.AllocationID)
+	if err != nil {
+		return
+	}
+	due, err := rmEntity.redeemDue(alloc, GetRedeemPolicy(), common.Now())
+	if err != nil || !due {
+		return // held back until worth a transaction
+	}
+
 	Logger.Info("Redeeming the read marker", zap.Any("rm", rmEntity.LatestRM))
 
+	redeem, err := syncLatestReadMarker(ctx, rmEntity)
+	if err != nil || !redeem {
+		return
+	}
+
+	if err = rmEntity.RedeemReadMarker(ctx); err != nil {
+		Logger.Error("error redeeming the read marker.",
+			zap.Any("rm", rmEntity), zap.Error(err))
+		return
+	}
+
+	Logger.Info("successfully redeemed read marker",
+		zap.Any("rm", rmEntity.LatestRM))
+	return
+}
+
+// syncLatestReadMarker compares the marker with the latest one redeemed on
+// the chain, syncing the local state if the chain is ahead. It returns true
+// if there are reads left to redeem.
+func syncLatestReadMarker(ctx context.Context, rmEntity *ReadMarkerEntity) (
+	redeem bool, err error) {
+
 	var params = make(map[string]string)
 	params["blobber"] = rmEntity.LatestRM.BlobberID
 	params["client"] = rmEntity.LatestRM.ClientID
@@ -60,21 +112,9 @@ func RedeemReadMarker(ctx context.Context, rmEntity *ReadMarkerEntity) (
 		return // synced from blockchain, no redeeming needed
 	}
 
-	if latestRM.ReadCounter == rmEntity.LatestRM.ReadCounter {
-		return // nothing to redeem
-	}
-
-	// so, now the latestRM.ReadCounter is less than rmEntity.LatestRM.ReadCounter
-
-	if err = rmEntity.RedeemReadMarker(ctx); err != nil {
-		Logger.Error("error redeeming the read marker.",
-			zap.Any("rm", rmEntity), zap.Error(err))
-		return
-	}
-
-	Logger.Info("successfully redeemed read marker",
-		zap.Any("rm", rmEntity.LatestRM))
-	return
+	// so, the latestRM.ReadCounter is less than rmEntity.LatestRM.ReadCounter
+	// unless they are equal and there is nothing to redeem
+	return latestRM.ReadCounter != rmEntity.LatestRM.ReadCounter, nil
 }
 
 var iterInprogress = false
@@ -95,7 +135,10 @@ func RedeemMarkers(ctx context.Context) {
 				db.Where(rm). // redeem_required = true
 						Where("counter <> suspend"). // and not suspended
 						Order("created_at ASC").Find(&readMarkers)
-				if len(readMarkers) > 0 {
+				metrics.QueueDepth.WithLabelValues("read_redeem").Set(float64(len(readMarkers)))
+				if len(readMarkers) > 0 && config.Configuration.RMRedeemBatch {
+					redeemBatches(ctx, readMarkers)
+				} else if len(readMarkers) > 0 {
 					swg := sizedwaitgroup.New(config.Configuration.RMRedeemNumWorkers)
 					for _, rmEntity := range readMarkers {
 						swg.Add()
@@ -2,36 +2,102 @@ package reference
 
 import (
 	"context"
+	"path/filepath"
 	"time"
 
 	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
 )
 
+// CollaboratorRole is the set of rights granted to a collaborator. Roles are
+// ordered: a writer can do whatever a reader can, and a manager whatever a
+// writer can, plus managing other collaborators.
+type CollaboratorRole string
+
+const (
+	CollaboratorReader  CollaboratorRole = "reader"
+	CollaboratorWriter  CollaboratorRole = "writer"
+	CollaboratorManager CollaboratorRole = "manager"
+)
+
+var collaboratorRoleLevels = map[CollaboratorRole]int{
+	CollaboratorReader:  1,
+	CollaboratorWriter:  2,
+	CollaboratorManager: 3,
+}
+
+// Validate the CollaboratorRole.
+func (role CollaboratorRole) Validate() error {
+	if _, ok := collaboratorRoleLevels[role]; !ok {
+		return common.NewErrorf("invalid_collaborator_role",
+			"unknown collaborator role %q", role)
+	}
+	return nil
+}
+
+// Allows returns true if the role includes the rights of the required one.
+func (role CollaboratorRole) Allows(required CollaboratorRole) bool {
+	level, ok := collaboratorRoleLevels[role]
+	return ok && level >= collaboratorRoleLevels[required]
+}
+
+// Collaborator is a role granted to a client on a ref of the allocation, a
+// file or a directory with everything below it. The grant is of the ref, by
+// its ID, not of the path: it follows the ref when renamed, and is lost when
+// the ref is deleted, a file or directory later created at the same path
+// having no grant until one is added again.
 type Collaborator struct {
-	RefID     int64     `gorm:"ref_id" json:"ref_id"`
-	ClientID  string    `gorm:"client_id" json:"client_id"`
-	CreatedAt time.Time `gorm:"created_at" json:"created_at"`
+	RefID     int64            `gorm:"ref_id" json:"ref_id"`
+	ClientID  string           `gorm:"client_id" json:"client_id"`
+	Role      CollaboratorRole `gorm:"role" json:"role"`
+	CreatedAt time.Time        `gorm:"created_at" json:"created_at"`
 }
 
 func (Collaborator) TableName() string {
 	return "collaborators"
 }
 
-func AddCollaborator(ctx context.Context, refID int64, clientID string) error {
+// CollaboratorGrant is a collaborator together with the path it was granted on.
+type CollaboratorGrant struct {
+	Collaborator
+	Path string `json:"path"`
+}
+
+// AddCollaborator grants the role on the ref, replacing the role the client
+// already had on it.
+func AddCollaborator(ctx context.Context, refID int64, clientID string, role CollaboratorRole) error {
 	db := datastore.GetStore().GetTransaction(ctx)
+	res := db.Table((&Collaborator{}).TableName()).
+		Where(&Collaborator{RefID: refID, ClientID: clientID}).
+		Update("role", role)
+	if res.Error != nil || res.RowsAffected > 0 {
+		return res.Error
+	}
 	return db.Create(&Collaborator{
 		RefID:    refID,
 		ClientID: clientID,
+		Role:     role,
 	}).Error
 }
 
 func RemoveCollaborator(ctx context.Context, refID int64, clientID string) error {
 	db := datastore.GetStore().GetTransaction(ctx)
 	return db.Table((&Collaborator{}).TableName()).
-		Where(&Collaborator{RefID: refID}).
+		Where(&Collaborator{RefID: refID, ClientID: clientID}).
 		Delete(&Collaborator{}).Error
 }
 
+// RemoveAllocationCollaborator revokes every grant the client holds in the
+// allocation.
+func RemoveAllocationCollaborator(ctx context.Context, allocationID string, clientID string) (int64, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	res := db.Table((&Collaborator{}).TableName()).
+		Where("client_id = ? AND ref_id IN (SELECT id FROM reference_objects WHERE allocation_id = ?)",
+			clientID, allocationID).
+		Delete(&Collaborator{})
+	return res.RowsAffected, res.Error
+}
+
 func GetCollaborators(ctx context.Context, refID int64) ([]Collaborator, error) {
 	db := datastore.GetStore().GetTransaction(ctx)
 	collaborators := []Collaborator{}
@@ -42,17 +108,63 @@ func GetCollaborators(ctx context.Context, refID int64) ([]Collaborator, error)
 	return collaborators, err
 }
 
-func IsACollaborator(ctx context.Context, refID int64, clientID string) bool {
+// GetAllocationCollaborators lists the grants in the allocation on the path
+// and everything below it, optionally only those of a single client.
+func GetAllocationCollaborators(ctx context.Context, allocationID string, path string, clientID string) ([]CollaboratorGrant, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	db = db.Table((&Collaborator{}).TableName()).
+		Select("collaborators.*, reference_objects.path").
+		Joins("INNER JOIN reference_objects ON reference_objects.id = collaborators.ref_id").
+		Where("reference_objects.allocation_id = ? AND reference_objects.deleted_at IS NULL", allocationID)
+	if path != "/" {
+		db = db.Where("(reference_objects.path = ? OR reference_objects.path LIKE ?)", path, path+"/%")
+	}
+	if len(clientID) > 0 {
+		db = db.Where("collaborators.client_id = ?", clientID)
+	}
+	grants := []CollaboratorGrant{}
+	err := db.Order("reference_objects.path, collaborators.created_at").Find(&grants).Error
+	return grants, err
+}
+
+// GetCollaboratorRole returns the strongest role the client was granted on the
+// path itself or on any directory above it, or an empty role if there is none.
+func GetCollaboratorRole(ctx context.Context, allocationID string, path string, clientID string) (CollaboratorRole, error) {
+	paths := []string{path}
+	for p := path; p != "/" && p != "."; {
+		p = filepath.Dir(p)
+		paths = append(paths, p)
+	}
+
 	db := datastore.GetStore().GetTransaction(ctx)
-	var collaboratorCount int64
+	var roles []CollaboratorRole
 	err := db.Table((&Collaborator{}).TableName()).
-		Where(&Collaborator{
-			RefID:    refID,
-			ClientID: clientID,
-		}).
-		Count(&collaboratorCount).Error
+		Joins("INNER JOIN reference_objects ON reference_objects.id = collaborators.ref_id").
+		Where("reference_objects.allocation_id = ? AND reference_objects.path IN (?) AND reference_objects.deleted_at IS NULL", allocationID, paths).
+		Where("collaborators.client_id = ?", clientID).
+		Pluck("collaborators.role", &roles).Error
+	if err != nil {
+		return "", err
+	}
+
+	var best CollaboratorRole
+	for _, role := range roles {
+		if collaboratorRoleLevels[role] > collaboratorRoleLevels[best] {
+			best = role
+		}
+	}
+	return best, nil
+}
+
+// HasCollaboratorRole returns true if the client holds at least the required
+// role on the path, directly or through a parent directory.
+func HasCollaboratorRole(ctx context.Context, allocationID string, path string, clientID string, required CollaboratorRole) bool {
+	if len(clientID) == 0 {
+		return false
+	}
+	role, err := GetCollaboratorRole(ctx, allocationID, path, clientID)
 	if err != nil {
 		return false
 	}
-	return collaboratorCount > 0
+	return role.Allows(required)
 }
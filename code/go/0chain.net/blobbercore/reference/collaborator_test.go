@@ -0,0 +1,47 @@
+package reference
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"0chain.net/blobbercore/datastore"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollaboratorRoleAllows(t *testing.T) {
+	require.True(t, CollaboratorManager.Allows(CollaboratorWriter))
+	require.True(t, CollaboratorWriter.Allows(CollaboratorReader))
+	require.True(t, CollaboratorReader.Allows(CollaboratorReader))
+	require.False(t, CollaboratorReader.Allows(CollaboratorWriter))
+	require.False(t, CollaboratorWriter.Allows(CollaboratorManager))
+	require.False(t, CollaboratorRole("").Allows(CollaboratorReader))
+	require.Error(t, CollaboratorRole("owner").Validate())
+}
+
+func TestGetCollaboratorRoleInheritance(t *testing.T) {
+	mock := datastore.MockTheStore(t)
+	mock.ExpectBegin()
+	ctx := datastore.GetStore().CreateTransaction(context.Background())
+
+	// the grants on the file itself and on every directory above it are considered
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "collaborators"."role" FROM "collaborators"`)).
+		WithArgs("alloc", "/team/docs/a.txt", "/team/docs", "/team", "/", "client").
+		WillReturnRows(sqlmock.NewRows([]string{"role"}).
+			AddRow(string(CollaboratorReader)).
+			AddRow(string(CollaboratorManager)).
+			AddRow(string(CollaboratorWriter)))
+
+	role, err := GetCollaboratorRole(ctx, "alloc", "/team/docs/a.txt", "client")
+	require.NoError(t, err)
+	require.Equal(t, CollaboratorManager, role)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "collaborators"."role" FROM "collaborators"`)).
+		WithArgs("alloc", "/team", "/", "stranger").
+		WillReturnRows(sqlmock.NewRows([]string{"role"}))
+
+	require.False(t, HasCollaboratorRole(ctx, "alloc", "/team", "stranger", CollaboratorReader))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
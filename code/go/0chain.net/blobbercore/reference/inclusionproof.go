@@ -0,0 +1,65 @@
+package reference
+
+import (
+	"context"
+
+	"0chain.net/blobbercore/inclusionproof"
+	"0chain.net/core/common"
+)
+
+// GetInclusionProof walks from the file with the given lookup hash up to the
+// root directory, collecting the sorted child hashes of every directory on
+// the way.
+func GetInclusionProof(ctx context.Context, allocationID string, pathHash string) (*inclusionproof.Proof, error) {
+	fileRef, err := GetReferenceFromLookupHash(ctx, allocationID, pathHash)
+	if err != nil {
+		return nil, common.NewError("invalid_parameters", "Invalid file path. "+err.Error())
+	}
+	if fileRef.Type != FILE {
+		return nil, common.NewError("invalid_parameters", "Path is not a file.")
+	}
+
+	proof := &inclusionproof.Proof{
+		File: &inclusionproof.FileMeta{
+			AllocationID:   fileRef.AllocationID,
+			Type:           fileRef.Type,
+			Name:           fileRef.Name,
+			Path:           fileRef.Path,
+			LookupHash:     fileRef.LookupHash,
+			Hash:           fileRef.Hash,
+			Size:           fileRef.Size,
+			ContentHash:    fileRef.ContentHash,
+			MerkleRoot:     fileRef.MerkleRoot,
+			ActualFileSize: fileRef.ActualFileSize,
+			ActualFileHash: fileRef.ActualFileHash,
+			Attributes:     string(fileRef.Attributes),
+		},
+	}
+
+	curRef := fileRef
+	for curRef.Path != "/" {
+		dirRef, err := GetRefWithSortedChildren(ctx, allocationID, curRef.ParentPath)
+		if err != nil {
+			return nil, common.NewError("invalid_dir_struct", "Failed to get the parent directory of "+curRef.Path)
+		}
+		level := &inclusionproof.Level{
+			Path:        dirRef.Path,
+			Index:       -1,
+			ChildHashes: make([]string, len(dirRef.Children)),
+		}
+		for idx, child := range dirRef.Children {
+			level.ChildHashes[idx] = child.Hash
+			if child.LookupHash == curRef.LookupHash {
+				level.Index = idx
+			}
+		}
+		if level.Index < 0 {
+			return nil, common.NewError("invalid_dir_struct", "Reference not found in its parent directory "+dirRef.Path)
+		}
+		proof.Levels = append(proof.Levels, level)
+		curRef = dirRef
+	}
+	proof.RootHash = curRef.Hash
+
+	return proof, nil
+}
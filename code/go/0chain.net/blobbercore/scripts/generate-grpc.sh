@@ -1,3 +1,3 @@
 #!/usr/bin/env bash
 
-protoc -I ./blobbergrpc/proto --go-grpc_out=. --go_out=. --grpc-gateway_out=. --openapiv2_out=./openapi ./blobbergrpc/proto/blobber.proto
\ No newline at end of file
+protoc -I ./blobbergrpc/proto --go-grpc_out=. --go_out=. --grpc-gateway_out=. --openapiv2_out=./openapi ./blobbergrpc/proto/blobber.proto ./blobbergrpc/proto/admin.proto
\ No newline at end of file
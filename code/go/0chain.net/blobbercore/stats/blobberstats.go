@@ -55,6 +55,7 @@ type Stats struct {
 	SuccessChallenges  int64 `json:"num_success_challenges"`
 	FailedChallenges   int64 `json:"num_failed_challenges"`
 	RedeemedChallenges int64 `json:"num_redeemed_challenges"`
+	ExpiredChallenges  int64 `json:"num_expired_challenges"`
 }
 
 var LastMinioScan time.Time
@@ -93,6 +94,9 @@ type BlobberStats struct {
 	// total for all allocations
 	ReadMarkers  ReadMarkersStat  `json:"read_markers"`
 	WriteMarkers WriteMarkersStat `json:"write_markers"`
+
+	ChallengeQueue ChallengeQueue    `json:"challenge_queue"`
+	Validators     []*ValidatorStats `json:"validators"`
 }
 
 type AllocationId struct {
@@ -127,6 +131,8 @@ func (bs *BlobberStats) loadBasicStats(ctx context.Context) {
 	bs.DiskSizeUsed = du
 	bs.loadStats(ctx)
 	bs.loadMinioStats(ctx)
+	bs.loadChallengeQueue(ctx)
+	bs.Validators = GetValidatorStats()
 }
 
 func (bs *BlobberStats) loadDetailedStats(ctx context.Context) {
@@ -332,6 +338,8 @@ func (bs *BlobberStats) loadChallengeStats(ctx context.Context) {
 		bs.TotalChallenges += total
 		if status == 3 {
 			bs.RedeemedChallenges += total
+		} else if status == 4 {
+			bs.ExpiredChallenges += total
 		} else {
 			bs.OpenChallenges += total
 		}
@@ -402,6 +410,8 @@ func (bs *BlobberStats) loadAllocationChallengeStats(ctx context.Context) {
 		as.TotalChallenges += total
 		if status == 3 {
 			as.RedeemedChallenges += total
+		} else if status == 4 {
+			as.ExpiredChallenges += total
 		} else {
 			as.OpenChallenges += total
 		}
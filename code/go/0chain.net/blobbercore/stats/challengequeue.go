@@ -0,0 +1,64 @@
+package stats
+
+import (
+	"context"
+	"time"
+
+	"0chain.net/blobbercore/config"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+	. "0chain.net/core/logging"
+
+	"go.uber.org/zap"
+)
+
+// ChallengeQueue is the challenges waiting to be answered: the accepted ones
+// wait for their validation tickets, the processed ones for their response
+// to be committed. NextDeadline is the closest deadline of them, 0 if none
+// known, and TimeToDeadline the time left before it, negative if past.
+type ChallengeQueue struct {
+	Accepted       int64            `json:"accepted"`
+	Processed      int64            `json:"processed"`
+	NextDeadline   common.Timestamp `json:"next_deadline"`
+	TimeToDeadline Duration         `json:"time_to_deadline"`
+}
+
+func (bs *BlobberStats) loadChallengeQueue(ctx context.Context) {
+	var (
+		db   = datastore.GetStore().GetTransaction(ctx)
+		rows []struct {
+			Status  int
+			Total   int64
+			Created common.Timestamp
+		}
+	)
+	err := db.Table("challenges").
+		Select("status, COUNT(*) AS total, COALESCE(MIN(NULLIF(created, 0)), 0) AS created").
+		Where("status IN (1, 2)").
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		Logger.Error("Error in getting the challenge queue stats",
+			zap.Error(err))
+		return
+	}
+
+	var first common.Timestamp
+	for _, r := range rows {
+		if r.Status == 1 {
+			bs.ChallengeQueue.Accepted = r.Total
+		} else {
+			bs.ChallengeQueue.Processed = r.Total
+		}
+		if r.Created > 0 && (first == 0 || r.Created < first) {
+			first = r.Created
+		}
+	}
+
+	completion := config.Configuration.ChallengeCompletionTime
+	if first == 0 || completion <= 0 {
+		return
+	}
+	bs.ChallengeQueue.NextDeadline = first + common.Timestamp(completion/time.Second)
+	bs.ChallengeQueue.TimeToDeadline = Duration(bs.ChallengeQueue.NextDeadline - common.Now())
+}
@@ -123,6 +123,19 @@ const tpl = `<!DOCTYPE html>
         <td>Redeemed Challenges</td>
         <td>{{ .RedeemedChallenges }}</td>
       </tr>
+      <tr>
+        <td>Expired Challenges</td>
+        <td>{{ .ExpiredChallenges }}</td>
+      </tr>
+      <tr>
+        <table>
+          <tr><th colspan="2">Challenge queue</th></tr>
+          <tr><td>Awaiting validation tickets</td><td>{{ .ChallengeQueue.Accepted }}</td></tr>
+          <tr><td>Awaiting commit</td><td>{{ .ChallengeQueue.Processed }}</td></tr>
+          <tr><td>Next deadline</td><td>{{ .ChallengeQueue.NextDeadline }}</td></tr>
+          <tr><td>Time to deadline</td><td>{{ .ChallengeQueue.TimeToDeadline }}</td></tr>
+        </table>
+      </tr>
       <tr>
         <table>
           <tr><th colspan="2">Configurations</th></tr>
@@ -171,6 +184,37 @@ const tpl = `<!DOCTYPE html>
           </tr>
         </table>
       </tr>
+      <tr>
+        <table>
+          <tr><th colspan="9">Validators</th></tr>
+          <tr>
+            <td>ID</td>
+            <td>URL</td>
+            <td>Requests</td>
+            <td>Failures</td>
+            <td>Timeouts</td>
+            <td>Avg latency</td>
+            <td>Max latency</td>
+            <td>Last success</td>
+            <td>Last error</td>
+          </tr>
+          {{ range .Validators }}
+          <tr>
+            <td>{{ .ID }}</td>
+            <td>{{ .URL }}</td>
+            <td>{{ .Requests }}</td>
+            <td>{{ .Failures }}</td>
+            <td>{{ .Timeouts }}</td>
+            <td>{{ .AvgLatency }}</td>
+            <td>{{ .MaxLatency }}</td>
+            <td>{{ .LastSuccess }}</td>
+            <td>{{ .LastError }}</td>
+          </tr>
+          {{ else }}
+          <tr><td colspan="9">No challenge requests yet.</td></tr>
+          {{ end }}
+        </table>
+      </tr>
     </table>
 
     <h1>
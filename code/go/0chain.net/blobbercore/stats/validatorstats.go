@@ -0,0 +1,80 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"0chain.net/core/common"
+)
+
+// ValidatorStats are the outcomes of the challenge requests sent to a
+// validator since the blobber started.
+type ValidatorStats struct {
+	ID       string `json:"id"`
+	URL      string `json:"url"`
+	Requests int64  `json:"requests"`
+	Failures int64  `json:"failures"`
+	Timeouts int64  `json:"timeouts"`
+	// AvgLatency is of all the requests, the failed ones included
+	AvgLatency  time.Duration    `json:"avg_latency"`
+	MaxLatency  time.Duration    `json:"max_latency"`
+	LastError   string           `json:"last_error,omitempty"`
+	LastFailure common.Timestamp `json:"last_failure,omitempty"`
+	LastSuccess common.Timestamp `json:"last_success,omitempty"`
+
+	totalLatency time.Duration
+}
+
+var validatorStats = struct {
+	sync.Mutex
+	byID map[string]*ValidatorStats
+}{byID: make(map[string]*ValidatorStats)}
+
+// RecordValidatorRequest accounts a challenge request to the validator, of
+// the latency given, failed if the error isn't nil.
+func RecordValidatorRequest(id, url string, latency time.Duration, err error) {
+	validatorStats.Lock()
+	defer validatorStats.Unlock()
+
+	vs, ok := validatorStats.byID[id]
+	if !ok {
+		vs = &ValidatorStats{ID: id}
+		validatorStats.byID[id] = vs
+	}
+	vs.URL = url
+	vs.Requests++
+	vs.totalLatency += latency
+	vs.AvgLatency = vs.totalLatency / time.Duration(vs.Requests)
+	if latency > vs.MaxLatency {
+		vs.MaxLatency = latency
+	}
+	if err == nil {
+		vs.LastSuccess = common.Now()
+		return
+	}
+	vs.Failures++
+	if errors.Is(err, context.DeadlineExceeded) {
+		vs.Timeouts++
+	}
+	vs.LastError = err.Error()
+	vs.LastFailure = common.Now()
+}
+
+// GetValidatorStats returns a copy of the stats of the validators, by ID.
+func GetValidatorStats() []*ValidatorStats {
+	validatorStats.Lock()
+	defer validatorStats.Unlock()
+
+	result := make([]*ValidatorStats, 0, len(validatorStats.byID))
+	for _, vs := range validatorStats.byID {
+		c := *vs
+		result = append(result, &c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
@@ -0,0 +1,348 @@
+package writemarker
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+
+	"gorm.io/gorm"
+)
+
+// The kinds of the breaks found auditing a write marker chain.
+const (
+	BreakInvalidSignature    = "invalid_signature"
+	BreakMissingGenesis      = "missing_genesis"
+	BreakBrokenLink          = "broken_link"
+	BreakFork                = "fork"
+	BreakTimestampRegression = "timestamp_regression"
+	BreakFutureTimestamp     = "future_timestamp"
+	BreakAllocationMismatch  = "allocation_mismatch"
+	BreakRootMismatch        = "root_mismatch"
+	BreakSizeMismatch        = "size_mismatch"
+	BreakMissingAllocation   = "missing_allocation"
+	BreakMissingConnection   = "missing_connection"
+	BreakConnectionMismatch  = "connection_mismatch"
+	BreakChangesMismatch     = "changes_size_mismatch"
+)
+
+// AuditBreak is an inconsistency of a write marker chain. The allocation
+// root and sequence are of the marker at fault, empty for the breaks of the
+// allocation as a whole.
+type AuditBreak struct {
+	AllocationRoot string `json:"allocation_root,omitempty"`
+	Sequence       int64  `json:"sequence,omitempty"`
+	Kind           string `json:"kind"`
+	Message        string `json:"message"`
+}
+
+// AllocationAudit is the outcome of the audit of the write marker chain of
+// an allocation.
+type AllocationAudit struct {
+	AllocationID    string        `json:"allocation_id"`
+	Markers         int           `json:"markers"`
+	LatestRoot      string        `json:"latest_root"`
+	AllocationRoot  string        `json:"allocation_root"`
+	BlobberSizeUsed int64         `json:"blobber_size_used"`
+	TotalSize       int64         `json:"total_size"`
+	OK              bool          `json:"ok"`
+	Breaks          []*AuditBreak `json:"breaks"`
+}
+
+// AuditReport is the outcome of the audit of the allocations.
+type AuditReport struct {
+	GeneratedAt common.Timestamp   `json:"generated_at"`
+	OK          bool               `json:"ok"`
+	Allocations []*AllocationAudit `json:"allocations"`
+}
+
+// AuditMarker is a write marker of a chain under audit, with its sequence
+// number and the connection it committed. Connection is nil if the
+// connection is not in the DB.
+type AuditMarker struct {
+	*WriteMarkerEntity
+	Sequence    int64
+	Connection  *allocation.AllocationChangeCollector
+	ChangesSize int64
+}
+
+func (aa *AllocationAudit) add(am *AuditMarker, kind, format string,
+	args ...interface{}) {
+
+	b := &AuditBreak{Kind: kind, Message: fmt.Sprintf(format, args...)}
+	if am != nil {
+		b.AllocationRoot = am.WM.AllocationRoot
+		b.Sequence = am.Sequence
+	}
+	aa.Breaks = append(aa.Breaks, b)
+}
+
+// AuditChain checks the write markers of the allocation, in their sequence
+// order, from the genesis one to the latest: the signatures, the linkage of
+// the roots, the timestamps, the connections and their changes, and that
+// the chain ends at the root and the size used on record. The allocation
+// is nil if not in the DB.
+func AuditChain(alloc *allocation.Allocation, markers []*AuditMarker,
+	now common.Timestamp) *AllocationAudit {
+
+	aa := &AllocationAudit{Markers: len(markers), Breaks: []*AuditBreak{}}
+	if alloc != nil {
+		aa.AllocationID = alloc.ID
+		aa.AllocationRoot = alloc.AllocationRoot
+		aa.BlobberSizeUsed = alloc.BlobberSizeUsed
+	} else if len(markers) > 0 {
+		aa.AllocationID = markers[0].WM.AllocationID
+	}
+
+	var (
+		roots = make(map[string]*AuditMarker, len(markers))
+		prevs = make(map[string]*AuditMarker, len(markers))
+		last  *AuditMarker
+	)
+	for _, am := range markers {
+		wm := &am.WM
+		switch prev := prevs[wm.PreviousAllocationRoot]; {
+		case prev != nil:
+			aa.add(am, BreakFork,
+				"previous root %s is also the previous root of %s",
+				wm.PreviousAllocationRoot, prev.WM.AllocationRoot)
+		case last == nil && wm.PreviousAllocationRoot != "":
+			aa.add(am, BreakMissingGenesis,
+				"first marker has previous root %s", wm.PreviousAllocationRoot)
+		case last == nil, wm.PreviousAllocationRoot == last.WM.AllocationRoot:
+		case wm.PreviousAllocationRoot == "":
+			aa.add(am, BreakFork, "second genesis marker after %s",
+				last.WM.AllocationRoot)
+		case roots[wm.PreviousAllocationRoot] != nil:
+			aa.add(am, BreakFork,
+				"previous root %s is not the latest root %s",
+				wm.PreviousAllocationRoot, last.WM.AllocationRoot)
+		default:
+			aa.add(am, BreakBrokenLink,
+				"previous root %s is not in the chain, expected %s",
+				wm.PreviousAllocationRoot, last.WM.AllocationRoot)
+		}
+		roots[wm.AllocationRoot] = am
+		prevs[wm.PreviousAllocationRoot] = am
+
+		if wm.AllocationID != aa.AllocationID {
+			aa.add(am, BreakAllocationMismatch, "marker is for allocation %s",
+				wm.AllocationID)
+		}
+		if last != nil && wm.Timestamp < last.WM.Timestamp {
+			aa.add(am, BreakTimestampRegression,
+				"timestamp %d is before the previous one %d", wm.Timestamp,
+				last.WM.Timestamp)
+		}
+		if wm.Timestamp > now {
+			aa.add(am, BreakFutureTimestamp, "timestamp %d is in the future",
+				wm.Timestamp)
+		}
+		if err := verifySignature(am.WriteMarkerEntity); err != nil {
+			aa.add(am, BreakInvalidSignature, "%v", err)
+		}
+		auditConnection(aa, am)
+
+		aa.TotalSize += wm.Size
+		last = am
+	}
+
+	if last != nil {
+		aa.LatestRoot = last.WM.AllocationRoot
+	}
+	if alloc == nil {
+		aa.add(nil, BreakMissingAllocation, "allocation is not in the DB")
+		aa.OK = false
+		return aa
+	}
+	if aa.LatestRoot != alloc.AllocationRoot {
+		aa.add(last, BreakRootMismatch,
+			"chain ends at %q, the allocation root is %q", aa.LatestRoot,
+			alloc.AllocationRoot)
+	}
+	if aa.TotalSize != alloc.BlobberSizeUsed {
+		aa.add(nil, BreakSizeMismatch,
+			"markers add up to %d, the allocation uses %d", aa.TotalSize,
+			alloc.BlobberSizeUsed)
+	}
+	aa.OK = len(aa.Breaks) == 0
+	return aa
+}
+
+func verifySignature(wm *WriteMarkerEntity) error {
+	if wm.ClientPublicKey == "" {
+		return errors.New("no client key on record")
+	}
+	keyBytes, err := hex.DecodeString(wm.ClientPublicKey)
+	if err != nil || encryption.Hash(keyBytes) != wm.WM.ClientID {
+		return errors.New("client key on record is not of the marker client")
+	}
+	ok, err := encryption.Verify(wm.ClientPublicKey, wm.WM.Signature,
+		encryption.Hash(wm.WM.GetHashData()))
+	if err != nil {
+		return fmt.Errorf("verifying signature: %v", err)
+	}
+	if !ok {
+		return errors.New("signature is not valid")
+	}
+	return nil
+}
+
+func auditConnection(aa *AllocationAudit, am *AuditMarker) {
+	if am.ConnectionID == "" || am.Connection == nil {
+		aa.add(am, BreakMissingConnection, "connection %q is not in the DB",
+			am.ConnectionID)
+		return
+	}
+	var (
+		wm = &am.WM
+		cc = am.Connection
+	)
+	switch {
+	case cc.AllocationID != wm.AllocationID:
+		aa.add(am, BreakConnectionMismatch,
+			"connection %s is of the allocation %s", cc.ConnectionID,
+			cc.AllocationID)
+	case cc.ClientID != wm.ClientID:
+		aa.add(am, BreakConnectionMismatch, "connection %s is of the client %s",
+			cc.ConnectionID, cc.ClientID)
+	case cc.Size != wm.Size:
+		aa.add(am, BreakConnectionMismatch,
+			"connection %s has size %d, the marker %d", cc.ConnectionID,
+			cc.Size, wm.Size)
+	case cc.Status != allocation.CommittedConnection:
+		aa.add(am, BreakConnectionMismatch,
+			"connection %s is not committed, status %d", cc.ConnectionID,
+			cc.Status)
+	}
+	if am.ChangesSize != wm.Size {
+		aa.add(am, BreakChangesMismatch,
+			"changes of the connection %s add up to %d, the marker %d",
+			cc.ConnectionID, am.ChangesSize, wm.Size)
+	}
+}
+
+// LoadAuditChain loads the write markers of the allocation in their sequence
+// order, with their connections.
+func LoadAuditChain(ctx context.Context, allocationID string) (
+	[]*AuditMarker, error) {
+
+	db := datastore.GetStore().GetTransaction(ctx)
+
+	var wms []*WriteMarkerEntity
+	err := db.Where("allocation_id = ?", allocationID).
+		Order("sequence").Find(&wms).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(wms) == 0 {
+		return nil, nil
+	}
+
+	var sequences []struct {
+		AllocationRoot string
+		Sequence       int64
+	}
+	err = db.Table(WriteMarkerEntity{}.TableName()).
+		Select("allocation_root, sequence").
+		Where("allocation_id = ?", allocationID).
+		Scan(&sequences).Error
+	if err != nil {
+		return nil, err
+	}
+	seqs := make(map[string]int64, len(sequences))
+	for _, s := range sequences {
+		seqs[s.AllocationRoot] = s.Sequence
+	}
+
+	ids := make([]string, 0, len(wms))
+	for _, wm := range wms {
+		if wm.ConnectionID != "" {
+			ids = append(ids, wm.ConnectionID)
+		}
+	}
+	var ccs []*allocation.AllocationChangeCollector
+	if err = db.Where("connection_id IN ?", ids).Find(&ccs).Error; err != nil {
+		return nil, err
+	}
+	conns := make(map[string]*allocation.AllocationChangeCollector, len(ccs))
+	for _, cc := range ccs {
+		conns[cc.ConnectionID] = cc
+	}
+	var sums []struct {
+		ConnectionID string
+		Size         int64
+	}
+	err = db.Model(&allocation.AllocationChange{}).
+		Select("connection_id, SUM(size) AS size").
+		Where("connection_id IN ?", ids).
+		Group("connection_id").
+		Scan(&sums).Error
+	if err != nil {
+		return nil, err
+	}
+	changes := make(map[string]int64, len(sums))
+	for _, s := range sums {
+		changes[s.ConnectionID] = s.Size
+	}
+
+	markers := make([]*AuditMarker, 0, len(wms))
+	for _, wm := range wms {
+		markers = append(markers, &AuditMarker{
+			WriteMarkerEntity: wm,
+			Sequence:          seqs[wm.WM.AllocationRoot],
+			Connection:        conns[wm.ConnectionID],
+			ChangesSize:       changes[wm.ConnectionID],
+		})
+	}
+	return markers, nil
+}
+
+// Audit audits the write marker chain of the allocation given, or of all
+// the allocations with an allocation or a write marker in the DB if the ID
+// is empty.
+func Audit(ctx context.Context, allocationID string) (*AuditReport, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+
+	ids := []string{allocationID}
+	if allocationID == "" {
+		ids = nil
+		err := db.Raw(`SELECT id FROM allocations UNION
+			SELECT DISTINCT allocation_id FROM write_markers ORDER BY 1`).
+			Scan(&ids).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	report := &AuditReport{
+		GeneratedAt: common.Now(),
+		OK:          true,
+		Allocations: make([]*AllocationAudit, 0, len(ids)),
+	}
+	for _, id := range ids {
+		alloc, err := allocation.GetAllocationByID(ctx, id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			alloc = nil
+		} else if err != nil {
+			return nil, err
+		}
+		markers, err := LoadAuditChain(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if alloc == nil && len(markers) == 0 {
+			return nil, common.NewErrorf("write_marker_audit",
+				"no allocation or write marker for %s", id)
+		}
+		aa := AuditChain(alloc, markers, report.GeneratedAt)
+		report.OK = report.OK && aa.OK
+		report.Allocations = append(report.Allocations, aa)
+	}
+	return report, nil
+}
@@ -0,0 +1,141 @@
+package writemarker
+
+import (
+	"fmt"
+	"testing"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/core/common"
+	"0chain.net/core/config"
+	"0chain.net/core/encryption"
+
+	"github.com/0chain/gosdk/core/zcncrypto"
+	"github.com/stretchr/testify/require"
+)
+
+type auditChainBuilder struct {
+	t       *testing.T
+	scheme  zcncrypto.SignatureScheme
+	wallet  *zcncrypto.Wallet
+	markers []*AuditMarker
+}
+
+func newAuditChainBuilder(t *testing.T) *auditChainBuilder {
+	config.Configuration.SignatureScheme = "bls0chain"
+	scheme := zcncrypto.NewSignatureScheme("bls0chain")
+	wallet, err := scheme.GenerateKeys()
+	require.NoError(t, err)
+	return &auditChainBuilder{t: t, scheme: scheme, wallet: wallet}
+}
+
+// add signs and appends a marker of the given previous root and size,
+// committed by a connection of matching changes.
+func (b *auditChainBuilder) add(prev string, size int64,
+	ts common.Timestamp) *AuditMarker {
+
+	seq := int64(len(b.markers) + 1)
+	wm := WriteMarker{
+		AllocationRoot:         fmt.Sprintf("root-%d", seq),
+		PreviousAllocationRoot: prev,
+		AllocationID:           "alloc",
+		Size:                   size,
+		BlobberID:              "blobber",
+		Timestamp:              ts,
+		ClientID:               b.wallet.ClientID,
+	}
+	sig, err := b.scheme.Sign(encryption.Hash(wm.GetHashData()))
+	require.NoError(b.t, err)
+	wm.Signature = sig
+
+	connID := fmt.Sprintf("conn-%d", seq)
+	am := &AuditMarker{
+		WriteMarkerEntity: &WriteMarkerEntity{
+			WM:              wm,
+			ConnectionID:    connID,
+			ClientPublicKey: b.wallet.Keys[0].PublicKey,
+		},
+		Sequence: seq,
+		Connection: &allocation.AllocationChangeCollector{
+			ConnectionID: connID,
+			AllocationID: "alloc",
+			ClientID:     b.wallet.ClientID,
+			Size:         size,
+			Status:       allocation.CommittedConnection,
+		},
+		ChangesSize: size,
+	}
+	b.markers = append(b.markers, am)
+	return am
+}
+
+func breakKinds(aa *AllocationAudit) (kinds []string) {
+	for _, b := range aa.Breaks {
+		kinds = append(kinds, b.Kind)
+	}
+	return
+}
+
+func TestAuditChain(t *testing.T) {
+	const now = common.Timestamp(1000)
+
+	t.Run("intact", func(t *testing.T) {
+		b := newAuditChainBuilder(t)
+		b.add("", 10, 100)
+		b.add("root-1", 20, 200)
+		b.add("root-2", -5, 200)
+		alloc := &allocation.Allocation{ID: "alloc",
+			AllocationRoot: "root-3", BlobberSizeUsed: 25}
+
+		aa := AuditChain(alloc, b.markers, now)
+		require.Empty(t, aa.Breaks)
+		require.True(t, aa.OK)
+		require.Equal(t, "root-3", aa.LatestRoot)
+		require.EqualValues(t, 25, aa.TotalSize)
+	})
+
+	t.Run("fork and broken link", func(t *testing.T) {
+		b := newAuditChainBuilder(t)
+		b.add("", 10, 100)
+		b.add("root-1", 10, 200)
+		b.add("root-1", 10, 300) // restored over the second one
+		b.add("unknown", 10, 400)
+		alloc := &allocation.Allocation{ID: "alloc",
+			AllocationRoot: "root-4", BlobberSizeUsed: 40}
+
+		aa := AuditChain(alloc, b.markers, now)
+		require.False(t, aa.OK)
+		require.Equal(t, []string{BreakFork, BreakBrokenLink}, breakKinds(aa))
+		require.EqualValues(t, 3, aa.Breaks[0].Sequence)
+		require.Equal(t, "root-4", aa.Breaks[1].AllocationRoot)
+	})
+
+	t.Run("marker checks", func(t *testing.T) {
+		b := newAuditChainBuilder(t)
+		b.add("genesis-lost", 10, 300)
+		b.add("root-1", 10, 200).WM.Size = 11 // tampered after signing
+		b.add("root-2", 10, 2000).Connection = nil
+		b.add("root-3", 10, 2000).ChangesSize = 7
+		alloc := &allocation.Allocation{ID: "alloc",
+			AllocationRoot: "root-2", BlobberSizeUsed: 41}
+
+		aa := AuditChain(alloc, b.markers, now)
+		require.Equal(t, []string{
+			BreakMissingGenesis,
+			BreakTimestampRegression, BreakInvalidSignature,
+			BreakConnectionMismatch, BreakChangesMismatch,
+			BreakFutureTimestamp, BreakMissingConnection,
+			BreakFutureTimestamp, BreakChangesMismatch,
+			BreakRootMismatch,
+		}, breakKinds(aa))
+	})
+
+	t.Run("missing allocation", func(t *testing.T) {
+		b := newAuditChainBuilder(t)
+		b.add("", 10, 100)
+
+		aa := AuditChain(nil, b.markers, now)
+		require.False(t, aa.OK)
+		require.Equal(t, "alloc", aa.AllocationID)
+		require.Equal(t, []string{BreakMissingAllocation}, breakKinds(aa))
+	})
+}
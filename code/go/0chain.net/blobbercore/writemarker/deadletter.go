@@ -0,0 +1,171 @@
+package writemarker
+
+import (
+	"context"
+	"net/http"
+
+	"0chain.net/blobbercore/allocation"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/core/common"
+)
+
+func (s WriteMarkerStatus) String() string {
+	switch s {
+	case Accepted:
+		return "accepted"
+	case Committed:
+		return "committed"
+	case Failed:
+		return "failed"
+	case Submitted:
+		return "submitted"
+	case DeadLetter:
+		return "dead_letter"
+	}
+	return "unknown"
+}
+
+// StuckMarker is a write marker whose redeem failed.
+type StuckMarker struct {
+	AllocationRoot     string           `json:"allocation_root"`
+	PrevAllocationRoot string           `json:"prev_allocation_root"`
+	Status             string           `json:"status"`
+	Error              string           `json:"error"`
+	Retries            int64            `json:"retries"`
+	NextRetryAt        common.Timestamp `json:"next_retry_at,omitempty"`
+	CloseTxnID         string           `json:"close_txn_id,omitempty"`
+}
+
+// StuckAllocation lists the stuck write markers of an allocation, in chain
+// order, with the number of markers waiting behind them.
+type StuckAllocation struct {
+	AllocationID     string         `json:"allocation_id"`
+	LatestRedeemedWM string         `json:"latest_redeemed_write_marker"`
+	Stuck            []*StuckMarker `json:"stuck"`
+	Waiting          int64          `json:"waiting"`
+}
+
+var stuckStatuses = []WriteMarkerStatus{Failed, DeadLetter}
+
+// GetStuckMarkers returns the allocations with failed or dead-letter write
+// markers, or the allocation given only.
+func GetStuckMarkers(ctx context.Context, allocationID string) (
+	[]*StuckAllocation, error) {
+
+	db := datastore.GetStore().GetTransaction(ctx)
+	query := db.Where("status IN ?", stuckStatuses)
+	if allocationID != "" {
+		query = query.Where("allocation_id = ?", allocationID)
+	}
+	var wms []*WriteMarkerEntity
+	if err := query.Order("allocation_id, sequence").Find(&wms).Error; err != nil {
+		return nil, err
+	}
+
+	var result []*StuckAllocation
+	for _, wm := range wms {
+		if n := len(result); n == 0 || result[n-1].AllocationID != wm.WM.AllocationID {
+			result = append(result, &StuckAllocation{AllocationID: wm.WM.AllocationID})
+		}
+		sa := result[len(result)-1]
+		sa.Stuck = append(sa.Stuck, &StuckMarker{
+			AllocationRoot:     wm.WM.AllocationRoot,
+			PrevAllocationRoot: wm.WM.PreviousAllocationRoot,
+			Status:             wm.Status.String(),
+			Error:              wm.StatusMessage,
+			Retries:            wm.ReedeemRetries,
+			NextRetryAt:        wm.NextRetryAt,
+			CloseTxnID:         wm.CloseTxnID,
+		})
+	}
+
+	for _, sa := range result {
+		alloc, err := allocation.GetAllocationByID(ctx, sa.AllocationID)
+		if err != nil {
+			return nil, err
+		}
+		sa.LatestRedeemedWM = alloc.LatestRedeemedWM
+		err = db.Model(&WriteMarkerEntity{}).
+			Where("allocation_id = ? AND status = ?", sa.AllocationID, Accepted).
+			Count(&sa.Waiting).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Redrive resets the failed and dead-letter write markers of the allocation
+// so that they are redeemed again, from a fresh retry count, in their chain
+// order. It returns the number of markers reset.
+func Redrive(ctx context.Context, allocationID string) (int64, error) {
+	db := datastore.GetStore().GetTransaction(ctx)
+	res := db.Model(&WriteMarkerEntity{}).
+		Where("allocation_id = ? AND status IN ?", allocationID, stuckStatuses).
+		Updates(map[string]interface{}{
+			"status":         Accepted,
+			"redeem_retries": 0,
+			"next_retry_at":  0,
+		})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return 0, nil
+	}
+	err := db.Model(&allocation.Allocation{}).
+		Where("id = ?", allocationID).
+		Update("is_redeem_required", true).Error
+	return res.RowsAffected, err
+}
+
+// StuckMarkersHandler lists the stuck write markers per allocation, of the
+// one of the "allocation" parameter if given.
+func StuckMarkersHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	ctx = datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(ctx)
+	defer db.Rollback()
+
+	stuck, err := GetStuckMarkers(ctx, r.FormValue("allocation"))
+	if err != nil {
+		return nil, common.NewError("stuck_write_markers_failed", err.Error())
+	}
+	return map[string]interface{}{
+		"retry_policy": GetRetryPolicy(),
+		"allocations":  stuck,
+	}, nil
+}
+
+// RedriveHandler re-drives the stuck write markers of the allocation. The
+// redeem worker picks them up in its next round, the only one redeeming the
+// markers of an allocation not to submit them twice.
+func RedriveHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	if r.Method != http.MethodPost {
+		return nil, common.NewError("invalid_method",
+			"Invalid method used. Use POST instead")
+	}
+	allocationID := r.FormValue("allocation")
+	if allocationID == "" {
+		return nil, common.NewError("invalid_parameters",
+			"missing allocation")
+	}
+
+	ctx = datastore.GetStore().CreateTransaction(ctx)
+	db := datastore.GetStore().GetTransaction(ctx)
+	count, err := Redrive(ctx, allocationID)
+	if err != nil {
+		db.Rollback()
+		return nil, common.NewError("redrive_failed", err.Error())
+	}
+	if count == 0 {
+		db.Rollback()
+		return nil, common.NewError("redrive_failed",
+			"no stuck write markers for the allocation")
+	}
+	if err = db.Commit().Error; err != nil {
+		return nil, common.NewError("redrive_failed", err.Error())
+	}
+	return map[string]interface{}{
+		"redriven": count,
+	}, nil
+}
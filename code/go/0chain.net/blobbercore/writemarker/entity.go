@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/datastore"
@@ -34,6 +35,11 @@ const (
 	Accepted  WriteMarkerStatus = 0
 	Committed WriteMarkerStatus = 1
 	Failed    WriteMarkerStatus = 2
+	// Submitted markers await the confirmation of their redeem transaction
+	Submitted WriteMarkerStatus = 3
+	// DeadLetter markers failed all the redeem attempts and are parked, with
+	// the markers after them, until re-driven by the operator
+	DeadLetter WriteMarkerStatus = 4
 )
 
 type WriteMarkerEntity struct {
@@ -44,6 +50,7 @@ type WriteMarkerEntity struct {
 	CloseTxnID      string            `gorm:"column:close_txn_id"`
 	ConnectionID    string            `gorm:"column:connection_id"`
 	ClientPublicKey string            `gorm:"column:client_key"`
+	NextRetryAt     common.Timestamp  `gorm:"column:next_retry_at"`
 	datastore.ModelWithTS
 }
 
@@ -60,11 +67,21 @@ func (wm *WriteMarkerEntity) UpdateStatus(ctx context.Context,
 	fmt.Println(string(statusBytes))
 	if status == Failed {
 		wm.ReedeemRetries++
-		err = db.Model(wm).Updates(WriteMarkerEntity{
-			Status:         status,
-			StatusMessage:  string(statusBytes),
-			CloseTxnID:     redeemTxn,
-			ReedeemRetries: wm.ReedeemRetries,
+		policy := GetRetryPolicy()
+		if policy.Exhausted(wm.ReedeemRetries) {
+			status = DeadLetter
+			wm.NextRetryAt = 0
+		} else {
+			wm.NextRetryAt = common.Now() +
+				policy.Delay(wm.ReedeemRetries, rand.Float64())
+		}
+		wm.Status = status
+		err = db.Model(wm).Updates(map[string]interface{}{
+			"status":         status,
+			"status_message": string(statusBytes),
+			"close_txn_id":   redeemTxn,
+			"redeem_retries": wm.ReedeemRetries,
+			"next_retry_at":  wm.NextRetryAt,
 		}).Error
 		return
 	}
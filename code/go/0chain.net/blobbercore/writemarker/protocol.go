@@ -3,17 +3,21 @@ package writemarker
 import (
 	"context"
 	"encoding/json"
-	"time"
 
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/constants"
+	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/outbox"
 	"0chain.net/core/chain"
 	"0chain.net/core/common"
 	"0chain.net/core/encryption"
+	"0chain.net/core/lock"
 	. "0chain.net/core/logging"
 	"0chain.net/core/node"
 	"0chain.net/core/transaction"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/zap"
 )
 
@@ -36,7 +40,7 @@ func (wm *WriteMarkerEntity) VerifyMarker(ctx context.Context, sa *allocation.Al
 
 	wmEntity, err := GetWriteMarkerEntity(ctx, wm.WM.AllocationRoot)
 
-	if err == nil && wmEntity.Status != Failed {
+	if err == nil && wmEntity.Status != Failed && wmEntity.Status != DeadLetter {
 		return common.NewError("write_marker_validation_failed", "Duplicate write marker. Validation failed")
 	}
 
@@ -87,7 +91,6 @@ func (wm *WriteMarkerEntity) RedeemMarker(ctx context.Context) error {
 	txn, err := transaction.NewTransactionEntity()
 	if err != nil {
 		wm.StatusMessage = "Error creating transaction entity. " + err.Error()
-		wm.ReedeemRetries++
 		if err := wm.UpdateStatus(ctx, Failed, "Error creating transaction entity. "+err.Error(), ""); err != nil {
 			Logger.Error("WriteMarkerEntity_UpdateStatus", zap.Error(err))
 		}
@@ -104,7 +107,6 @@ func (wm *WriteMarkerEntity) RedeemMarker(ctx context.Context) error {
 		Logger.Error("Error encoding sc input", zap.String("err:", err.Error()), zap.Any("scdata", sn))
 		wm.Status = Failed
 		wm.StatusMessage = "Error encoding sc input. " + err.Error()
-		wm.ReedeemRetries++
 		if err := wm.UpdateStatus(ctx, Failed, "Error encoding sc input. "+err.Error(), ""); err != nil {
 			Logger.Error("WriteMarkerEntity_UpdateStatus", zap.Error(err))
 		}
@@ -116,29 +118,72 @@ func (wm *WriteMarkerEntity) RedeemMarker(ctx context.Context) error {
 		Logger.Error("Failed during sending close connection to the miner. ", zap.String("err:", err.Error()))
 		wm.Status = Failed
 		wm.StatusMessage = "Failed during sending close connection to the miner. " + err.Error()
-		wm.ReedeemRetries++
 		if err := wm.UpdateStatus(ctx, Failed, "Failed during sending close connection to the miner. "+err.Error(), ""); err != nil {
 			Logger.Error("WriteMarkerEntity_UpdateStatus", zap.Error(err))
 		}
 		return err
 	}
 
-	time.Sleep(transaction.SLEEP_FOR_TXN_CONFIRMATION * time.Second)
-	t, err := transaction.VerifyTransaction(txn.Hash, chain.GetServerChain())
+	wm.Status = Submitted
+	wm.StatusMessage = "Awaiting confirmation of the close connection transaction"
+	wm.CloseTxnID = txn.Hash
+	if err = outbox.Enqueue(ctx, RedeemTxnKind, wm.WM.AllocationRoot, txn.Hash, nil); err != nil {
+		return err
+	}
+	return wm.UpdateStatus(ctx, Submitted, wm.StatusMessage, txn.Hash)
+}
+
+// RedeemTxnKind is the kind of the outbox transactions redeeming write markers.
+const RedeemTxnKind = "write_marker_redeem"
+
+// markersRedeemed counts the write markers of the confirmed and failed
+// transactions.
+var markersRedeemed = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "blobber_write_markers_redeemed_total",
+	Help: "Write markers of the redeem transactions, by outcome: redeemed or failed.",
+}, []string{"outcome"})
+
+// onRedeemConfirmed commits the write marker and moves the latest redeemed
+// marker of its allocation, under the lock of the allocation the commits and
+// the other confirmations take too.
+func onRedeemConfirmed(ctx context.Context, ptx *outbox.PendingTxn, t *transaction.Transaction) error {
+	wm, err := GetWriteMarkerEntity(ctx, ptx.RefKey)
 	if err != nil {
-		Logger.Error("Error verifying the close connection transaction", zap.String("err:", err.Error()), zap.String("txn", txn.Hash))
-		wm.Status = Failed
-		wm.StatusMessage = "Error verifying the close connection transaction." + err.Error()
-		wm.ReedeemRetries++
-		wm.CloseTxnID = txn.Hash
-		if err := wm.UpdateStatus(ctx, Failed, "Error verifying the close connection transaction."+err.Error(), txn.Hash); err != nil {
-			Logger.Error("WriteMarkerEntity_UpdateStatus", zap.Error(err))
-		}
 		return err
 	}
-	wm.Status = Committed
-	wm.StatusMessage = t.TransactionOutput
-	wm.CloseTxnID = t.Hash
-	err = wm.UpdateStatus(ctx, Committed, t.TransactionOutput, t.Hash)
-	return err
+	mutex := lock.GetMutex(allocation.Allocation{}.TableName(), wm.WM.AllocationID)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if wm.Status == Committed {
+		return nil
+	}
+	if err = wm.UpdateStatus(ctx, Committed, t.TransactionOutput, t.Hash); err != nil {
+		return err
+	}
+	markersRedeemed.WithLabelValues("redeemed").Inc()
+
+	db := datastore.GetStore().GetTransaction(ctx)
+	err = db.Model(&allocation.Allocation{}).
+		Where("id = ?", wm.WM.AllocationID).
+		Update("latest_redeemed_write_marker", wm.WM.AllocationRoot).Error
+	if err != nil {
+		return err
+	}
+	return db.Model(&allocation.Allocation{}).
+		Where("id = ? AND allocation_root = latest_redeemed_write_marker", wm.WM.AllocationID).
+		Update("is_redeem_required", false).Error
+}
+
+func onRedeemFailed(ctx context.Context, ptx *outbox.PendingTxn) error {
+	wm, err := GetWriteMarkerEntity(ctx, ptx.RefKey)
+	if err != nil {
+		return err
+	}
+	mutex := lock.GetMutex(allocation.Allocation{}.TableName(), wm.WM.AllocationID)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	markersRedeemed.WithLabelValues("failed").Inc()
+	return wm.UpdateStatus(ctx, Failed, ptx.StatusMessage, ptx.Hash)
 }
@@ -0,0 +1,47 @@
+package writemarker
+
+import (
+	"0chain.net/blobbercore/config"
+	"0chain.net/core/common"
+)
+
+// RetryPolicy schedules the redeem attempts of a failed write marker.
+type RetryPolicy struct {
+	MaxAttempts  int              `json:"max_attempts"`
+	InitialDelay common.Timestamp `json:"initial_delay"`
+	MaxDelay     common.Timestamp `json:"max_delay"`
+}
+
+// GetRetryPolicy returns the policy of the configuration.
+func GetRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:  config.Configuration.WMRedeemMaxRetries,
+		InitialDelay: common.Timestamp(config.Configuration.WMRedeemRetryDelay),
+		MaxDelay:     common.Timestamp(config.Configuration.WMRedeemMaxRetryDelay),
+	}
+}
+
+// Exhausted returns true if no attempt is left after the given number of
+// failed ones. A zero MaxAttempts retries forever.
+func (p RetryPolicy) Exhausted(attempts int64) bool {
+	return p.MaxAttempts > 0 && attempts >= int64(p.MaxAttempts)
+}
+
+// Delay before the attempt following the given number of failed ones. The
+// delay doubles on every failure up to MaxDelay; its upper half is scaled
+// by the jitter, in [0, 1), so that the markers failed together are not
+// retried together.
+func (p RetryPolicy) Delay(attempts int64, jitter float64) common.Timestamp {
+	delay := p.InitialDelay
+	if delay <= 0 {
+		delay = 1
+	}
+	for i := int64(1); i < attempts && (p.MaxDelay <= 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	half := delay / 2
+	return delay - half + common.Timestamp(float64(half)*jitter)
+}
@@ -0,0 +1,25 @@
+package writemarker
+
+import (
+	"testing"
+
+	"0chain.net/core/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, InitialDelay: 10, MaxDelay: 60}
+
+	for attempts, want := range map[int64]common.Timestamp{
+		1: 10, 2: 20, 3: 40, 4: 60, 10: 60,
+	} {
+		require.Equal(t, want/2, p.Delay(attempts, 0), "attempts %d", attempts)
+		require.True(t, p.Delay(attempts, 0.999999) < want, "attempts %d", attempts)
+	}
+	require.Equal(t, common.Timestamp(15), p.Delay(2, 0.5))
+
+	require.False(t, p.Exhausted(4))
+	require.True(t, p.Exhausted(5))
+	require.False(t, RetryPolicy{}.Exhausted(1000), "retries forever")
+}
@@ -7,13 +7,20 @@ import (
 	"0chain.net/blobbercore/allocation"
 	"0chain.net/blobbercore/config"
 	"0chain.net/blobbercore/datastore"
+	"0chain.net/blobbercore/outbox"
+	"0chain.net/core/common"
 	. "0chain.net/core/logging"
+	"0chain.net/core/metrics"
 	"github.com/remeh/sizedwaitgroup"
 
 	"go.uber.org/zap"
 )
 
 func SetupWorkers(ctx context.Context) {
+	outbox.RegisterHandler(RedeemTxnKind, &outbox.Handler{
+		OnConfirmed: onRedeemConfirmed,
+		OnFailed:    onRedeemFailed,
+	})
 	go RedeemWriteMarkers(ctx)
 }
 
@@ -38,15 +45,30 @@ func RedeemMarkersForAllocation(ctx context.Context, allocationObj *allocation.A
 		return err
 	}
 	startredeem := false
+	now := common.Now()
 	for _, wm := range writemarkers {
 		if wm.WM.PreviousAllocationRoot == allocationObj.LatestRedeemedWM && !startredeem {
 			startredeem = true
 		}
+		if wm.Status == Submitted {
+			break // the markers after it wait for its confirmation
+		}
 		if startredeem || len(allocationObj.LatestRedeemedWM) == 0 {
+			// the markers after a failed one can't be redeemed before it
+			if wm.Status == DeadLetter {
+				break // parked until re-driven
+			}
+			if wm.Status == Failed && wm.NextRetryAt > now {
+				break // backing off
+			}
 			err := wm.RedeemMarker(rctx)
 			if err != nil {
 				Logger.Error("Error redeeming the write marker.", zap.Any("wm", wm.WM.AllocationID), zap.Any("error", err))
-				continue
+				break
+			}
+			if wm.Status != Committed {
+				Logger.Info("Submitted the write marker redeem", zap.Any("wm", wm.WM.AllocationRoot), zap.Any("txn", wm.CloseTxnID))
+				break
 			}
 			err = db.Model(allocationObj).Updates(allocation.Allocation{LatestRedeemedWM: wm.WM.AllocationRoot}).Error
 			if err != nil {
@@ -82,6 +104,7 @@ func RedeemWriteMarkers(ctx context.Context) {
 			allocations := make([]*allocation.Allocation, 0)
 			alloc := &allocation.Allocation{IsRedeemRequired: true}
 			db.Where(alloc).Find(&allocations)
+			metrics.QueueDepth.WithLabelValues("write_redeem").Set(float64(len(allocations)))
 			if len(allocations) > 0 {
 				swg := sizedwaitgroup.New(config.Configuration.WMRedeemNumWorkers)
 				for _, allocationObj := range allocations {
@@ -11,6 +11,8 @@ import (
 
 var (
 	Logger *zap.Logger
+	// AuditLogger logs the admin calls, apart from the other logs
+	AuditLogger = zap.NewNop()
 )
 
 func InitLogging(mode string, logDir string, logFile string) {
@@ -46,6 +48,23 @@ func InitLogging(mode string, logDir string, logFile string) {
 	Logger = l
 }
 
+// InitAuditLogging sets up the AuditLogger, writing json lines to the log
+// file given whatever the logging level.
+func InitAuditLogging(logDir string, logFile string) {
+	cfg := zap.NewProductionConfig()
+	cfg.DisableCaller = true
+	cfg.DisableStacktrace = true
+	cfg.EncoderConfig.TimeKey = "timestamp"
+	cfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+
+	l, err := cfg.Build(SetOutput(getWriteSyncer(logDir+"/"+logFile), cfg))
+	if err != nil {
+		panic(err)
+	}
+
+	AuditLogger = l
+}
+
 // SetOutput replaces existing Core with new, that writes to passed WriteSyncer.
 func SetOutput(ws zapcore.WriteSyncer, conf zap.Config) zap.Option {
 	var enc zapcore.Encoder
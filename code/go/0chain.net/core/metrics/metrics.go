@@ -0,0 +1,192 @@
+// Package metrics serves the Prometheus metrics of the blobber and of the
+// validator at /metrics. The metrics are registered by the packages they're
+// of, and updated as things happen rather than computed on scrape; this
+// package has the ones shared by both: the requests of the HTTP and gRPC
+// servers, the database pool and the worker queues.
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Requests of the HTTP server, by route, method and status code.",
+	}, []string{"handler", "method", "code"})
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Time serving the requests of the HTTP server, by route and method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"handler", "method"})
+
+	grpcHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "Calls of the gRPC server, by service, method and status code.",
+	}, []string{"grpc_service", "grpc_method", "grpc_code"})
+	grpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "Time serving the calls of the gRPC server, by service and method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"grpc_service", "grpc_method"})
+
+	// QueueDepth is the number of the items a worker took in its latest
+	// round, by queue.
+	QueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "worker_queue_depth",
+		Help: "Items taken by the latest round of a worker, by queue.",
+	}, []string{"queue"})
+)
+
+// Handler serves the metrics in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Outcome is the result label of an operation failed if err isn't nil.
+func Outcome(err error) string {
+	if err != nil {
+		return "failure"
+	}
+	return "success"
+}
+
+// statusRecorder keeps the status code of the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (rec *statusRecorder) WriteHeader(status int) {
+	rec.status = status
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *statusRecorder) Flush() {
+	if f, ok := rec.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Middleware is the mux middleware counting the requests of the routes and
+// their latency, by the path template of the route not to have a series per
+// allocation.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := "unknown"
+		if cr := mux.CurrentRoute(r); cr != nil {
+			if tpl, err := cr.GetPathTemplate(); err == nil {
+				route = tpl
+			}
+		}
+
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		httpDuration.WithLabelValues(route, r.Method).
+			Observe(time.Since(start).Seconds())
+		httpRequests.WithLabelValues(route, r.Method, strconv.Itoa(rec.status)).Inc()
+	})
+}
+
+func observeGRPC(fullMethod string, start time.Time, err error) {
+	service, method := path.Split(fullMethod)
+	service = path.Clean(service)[1:]
+	grpcDuration.WithLabelValues(service, method).
+		Observe(time.Since(start).Seconds())
+	grpcHandled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+}
+
+// UnaryServerInterceptor counts the calls of the gRPC server and their
+// latency.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observeGRPC(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor is the UnaryServerInterceptor of the streams,
+// timed to their end.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observeGRPC(info.FullMethod, start, err)
+		return err
+	}
+}
+
+// dbStatsCollector collects the stats of the connection pool of a database,
+// kept by database/sql.
+type dbStatsCollector struct {
+	db func() *sql.DB
+
+	maxOpen      *prometheus.Desc
+	open         *prometheus.Desc
+	inUse        *prometheus.Desc
+	idle         *prometheus.Desc
+	waitCount    *prometheus.Desc
+	waitDuration *prometheus.Desc
+	closed       *prometheus.Desc
+}
+
+// RegisterDBStats registers the collector of the pool stats of the database
+// given, named by the db label; the database is nil while not connected.
+func RegisterDBStats(name string, db func() *sql.DB) {
+	labels := prometheus.Labels{"db": name}
+	desc := func(metric, help string) *prometheus.Desc {
+		return prometheus.NewDesc("db_"+metric, help, nil, labels)
+	}
+	prometheus.MustRegister(&dbStatsCollector{
+		db:           db,
+		maxOpen:      desc("max_open_connections", "Maximum number of open connections to the database."),
+		open:         desc("open_connections", "Established connections, in use and idle."),
+		inUse:        desc("in_use_connections", "Connections in use."),
+		idle:         desc("idle_connections", "Idle connections."),
+		waitCount:    desc("wait_count_total", "Connections waited for."),
+		waitDuration: desc("wait_duration_seconds_total", "Time blocked waiting for a connection."),
+		closed:       desc("closed_connections_total", "Connections closed for the idle or lifetime limits."),
+	})
+}
+
+func (c *dbStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.maxOpen
+	ch <- c.open
+	ch <- c.inUse
+	ch <- c.idle
+	ch <- c.waitCount
+	ch <- c.waitDuration
+	ch <- c.closed
+}
+
+func (c *dbStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	db := c.db()
+	if db == nil {
+		return
+	}
+	s := db.Stats()
+	ch <- prometheus.MustNewConstMetric(c.maxOpen, prometheus.GaugeValue, float64(s.MaxOpenConnections))
+	ch <- prometheus.MustNewConstMetric(c.open, prometheus.GaugeValue, float64(s.OpenConnections))
+	ch <- prometheus.MustNewConstMetric(c.inUse, prometheus.GaugeValue, float64(s.InUse))
+	ch <- prometheus.MustNewConstMetric(c.idle, prometheus.GaugeValue, float64(s.Idle))
+	ch <- prometheus.MustNewConstMetric(c.waitCount, prometheus.CounterValue, float64(s.WaitCount))
+	ch <- prometheus.MustNewConstMetric(c.waitDuration, prometheus.CounterValue, s.WaitDuration.Seconds())
+	ch <- prometheus.MustNewConstMetric(c.closed, prometheus.CounterValue,
+		float64(s.MaxIdleClosed+s.MaxIdleTimeClosed+s.MaxLifetimeClosed))
+}
@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMiddleware(t *testing.T) {
+	r := mux.NewRouter()
+	r.Use(Middleware)
+	r.HandleFunc("/v1/file/meta/{allocation}", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad request", http.StatusBadRequest)
+	})
+	r.Handle("/metrics", Handler())
+
+	requests := httpRequests.WithLabelValues("/v1/file/meta/{allocation}", http.MethodGet, "400")
+	before := testutil.ToFloat64(requests)
+	for _, allocation := range []string{"a1", "a2"} {
+		r.ServeHTTP(httptest.NewRecorder(),
+			httptest.NewRequest(http.MethodGet, "/v1/file/meta/"+allocation, nil))
+	}
+	assert.Equal(t, before+2, testutil.ToFloat64(requests))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(),
+		`http_requests_total{code="400",handler="/v1/file/meta/{allocation}",method="GET"}`)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/blobber.service.v1.Blobber/GetAllocation"}
+	handled := grpcHandled.WithLabelValues("blobber.service.v1.Blobber", "GetAllocation", "NotFound")
+	before := testutil.ToFloat64(handled)
+
+	_, err := UnaryServerInterceptor()(context.Background(), nil, info,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.NotFound, "no allocation")
+		})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, before+1, testutil.ToFloat64(handled))
+}
+
+func TestOutcome(t *testing.T) {
+	assert.Equal(t, "success", Outcome(nil))
+	assert.Equal(t, "failure", Outcome(errors.New("failed")))
+}
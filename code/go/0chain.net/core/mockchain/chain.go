@@ -0,0 +1,279 @@
+// Package mockchain is an in-process stand-in for the miners and sharders of
+// a 0chain network. It accepts the transactions the blobber and the validator
+// submit through gosdk, executes the storage smart contract calls they make
+// against an in-memory state and serves the sharder APIs (confirmations,
+// blocks, SC REST) so that the transactions verify as on the real chain.
+//
+// Every transaction is put into a block of its own and the chain grows with
+// empty blocks on demand, so confirmations are available right away.
+package mockchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	. "0chain.net/core/logging"
+	"0chain.net/core/transaction"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// MinerID is the ID of the single miner generating the blocks.
+	MinerID = "mock_miner"
+
+	// maxRoundsAhead limits the empty blocks created for a request of a
+	// round not generated yet.
+	maxRoundsAhead = 16
+)
+
+// TxnStatus of an executed transaction, as reported in the confirmation.
+const (
+	TxnSuccess = 1
+	TxnFailure = 2
+)
+
+// Block is a block of the mock chain. Blocks hold at most one transaction.
+type Block struct {
+	Version               string                   `json:"version"`
+	CreationDate          common.Timestamp         `json:"creation_date"`
+	Hash                  string                   `json:"hash"`
+	PrevHash              string                   `json:"prev_hash"`
+	MinerID               string                   `json:"miner_id"`
+	Round                 int64                    `json:"round"`
+	RoundRandomSeed       int64                    `json:"round_random_seed"`
+	MerkleTreeRoot        string                   `json:"merkle_tree_root"`
+	ReceiptMerkleTreeRoot string                   `json:"receipt_merkle_tree_root"`
+	NumTxns               int                      `json:"num_txns"`
+	Txn                   *transaction.Transaction `json:"-"`
+	TxnStatus             int                      `json:"-"`
+}
+
+// computeHash as the sharders and gosdk do to check the blocks extend
+// each other.
+func (b *Block) computeHash() string {
+	data := fmt.Sprintf("%v:%v:%v:%v:%v:%v:%v", b.MinerID, b.PrevHash,
+		b.CreationDate, b.Round, b.RoundRandomSeed, b.MerkleTreeRoot,
+		b.ReceiptMerkleTreeRoot)
+	return encryption.Hash(data)
+}
+
+// Config of a mock chain.
+type Config struct {
+	ChainID string
+	// VerifySignatures of the submitted transactions and markers with the
+	// signature scheme of the configuration.
+	VerifySignatures bool
+	// PourAmount is the value credited by the faucet "pour" function.
+	PourAmount int64
+}
+
+// Chain is the state of the mock chain.
+type Chain struct {
+	Config
+
+	mutex  sync.Mutex
+	rand   *rand.Rand
+	blocks []*Block
+	txns   map[string]*Block
+
+	clients  map[string]string // client ID -> public key
+	balances map[string]int64
+
+	storage *storageState
+}
+
+// New creates a chain with its genesis block.
+func New(cfg Config) *Chain {
+	if cfg.PourAmount == 0 {
+		cfg.PourAmount = 10 * 1e10 // 10 tokens
+	}
+	c := &Chain{
+		Config:   cfg,
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		txns:     make(map[string]*Block),
+		clients:  make(map[string]string),
+		balances: make(map[string]int64),
+		storage:  newStorageState(),
+	}
+	c.appendBlock(nil, 0)
+	return c
+}
+
+func (c *Chain) appendBlock(txn *transaction.Transaction, status int) *Block {
+	b := &Block{
+		Version:         "1.0",
+		CreationDate:    common.Now(),
+		MinerID:         MinerID,
+		Round:           int64(len(c.blocks)),
+		RoundRandomSeed: c.rand.Int63(),
+	}
+	if n := len(c.blocks); n > 0 {
+		b.PrevHash = c.blocks[n-1].Hash
+	}
+	if txn != nil {
+		// the merkle root of a single leaf is the leaf itself
+		b.MerkleTreeRoot = txn.Hash
+		b.ReceiptMerkleTreeRoot = txn.OutputHash
+		b.NumTxns = 1
+		b.Txn = txn
+		b.TxnStatus = status
+		c.txns[txn.Hash] = b
+	}
+	b.Hash = b.computeHash()
+	c.blocks = append(c.blocks, b)
+	return b
+}
+
+// LatestFinalizedBlock returns the last block of the chain.
+func (c *Chain) LatestFinalizedBlock() *Block {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.blocks[len(c.blocks)-1]
+}
+
+// GetBlock returns the block of the round. Rounds shortly ahead of the chain
+// are generated as empty blocks.
+func (c *Chain) GetBlock(round int64) (*Block, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	latest := int64(len(c.blocks) - 1)
+	if round < 0 || round > latest+maxRoundsAhead {
+		return nil, common.NewErrorf("block_not_available",
+			"round %v is not available, latest round is %v", round, latest)
+	}
+	for int64(len(c.blocks)) <= round {
+		c.appendBlock(nil, 0)
+	}
+	return c.blocks[round], nil
+}
+
+// GetTransaction returns the confirmed transaction with the block it is in.
+func (c *Chain) GetTransaction(hash string) (*transaction.Transaction, *Block, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	b, ok := c.txns[hash]
+	if !ok {
+		return nil, nil, common.NewError("entity_not_found",
+			"transaction not found: "+hash)
+	}
+	return b.Txn, b, nil
+}
+
+// Transactions returns the blocks of the smart contract transactions calling
+// the function name, in the order of the chain, failed ones included.
+func (c *Chain) Transactions(name string) []*Block {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	blocks := make([]*Block, 0)
+	for _, b := range c.blocks {
+		if b.Txn == nil || b.Txn.TransactionType != transaction.TxnTypeSmartContract {
+			continue
+		}
+		var sc scTxnData
+		if json.Unmarshal([]byte(b.Txn.TransactionData), &sc) != nil || sc.Name != name {
+			continue
+		}
+		cp := *b
+		blocks = append(blocks, &cp)
+	}
+	return blocks
+}
+
+// RegisterClient records the public key of a client.
+func (c *Chain) RegisterClient(clientID, publicKey string) error {
+	if clientID == "" || publicKey == "" {
+		return common.NewError("invalid_client", "missing client id or public key")
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.clients[clientID] = publicKey
+	return nil
+}
+
+// GetBalance returns the balance of the client.
+func (c *Chain) GetBalance(clientID string) int64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.balances[clientID]
+}
+
+// SubmitTransaction checks the transaction, executes it and puts it into a
+// new block. A transaction failing its execution is still included, with
+// the error as the output, as on the real chain.
+func (c *Chain) SubmitTransaction(txn *transaction.Transaction) error {
+	hashData := fmt.Sprintf("%v:%v:%v:%v:%v", txn.CreationDate, txn.ClientID,
+		txn.ToClientID, txn.Value, encryption.Hash(txn.TransactionData))
+	if hash := encryption.Hash(hashData); txn.Hash != hash {
+		return common.NewErrorf("invalid_transaction",
+			"hash mismatch, expected %v, got %v", hash, txn.Hash)
+	}
+	if c.VerifySignatures {
+		ok, err := encryption.Verify(txn.PublicKey, txn.Signature, txn.Hash)
+		if err != nil || !ok {
+			return common.NewError("invalid_transaction", "invalid signature")
+		}
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.txns[txn.Hash]; ok {
+		return common.NewError("invalid_transaction",
+			"transaction already exists: "+txn.Hash)
+	}
+	if txn.PublicKey != "" {
+		c.clients[txn.ClientID] = txn.PublicKey
+	}
+
+	status := TxnSuccess
+	output, err := c.execute(txn)
+	if err != nil {
+		status = TxnFailure
+		output = err.Error()
+	}
+	txn.TransactionOutput = output
+	txn.OutputHash = encryption.Hash(output)
+	b := c.appendBlock(txn, status)
+
+	Logger.Info("mock chain: transaction executed", zap.String("txn", txn.Hash),
+		zap.Int64("round", b.Round), zap.Int("status", status),
+		zap.String("output", output))
+	return nil
+}
+
+// newTransaction returns a transaction generated by the chain itself, with
+// its hash computed. It is put into a block by commitTransaction once its
+// output is set.
+func (c *Chain) newTransaction(clientID, toClientID, data string) *transaction.Transaction {
+	txn := &transaction.Transaction{
+		Version:         "1.0",
+		ClientID:        clientID,
+		ToClientID:      toClientID,
+		ChainID:         c.ChainID,
+		TransactionData: data,
+		CreationDate:    common.Now(),
+		TransactionType: transaction.TxnTypeSmartContract,
+	}
+	// keep the hash unique for identical data within the same second
+	for {
+		hashData := fmt.Sprintf("%v:%v:%v:%v:%v", txn.CreationDate,
+			txn.ClientID, txn.ToClientID, txn.Value,
+			encryption.Hash(txn.TransactionData))
+		txn.Hash = encryption.Hash(hashData)
+		if _, ok := c.txns[txn.Hash]; !ok {
+			break
+		}
+		txn.CreationDate++
+	}
+	return txn
+}
+
+func (c *Chain) commitTransaction(txn *transaction.Transaction) {
+	txn.OutputHash = encryption.Hash(txn.TransactionOutput)
+	c.appendBlock(txn, TxnSuccess)
+}
@@ -0,0 +1,201 @@
+package mockchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"0chain.net/core/chain"
+	"0chain.net/core/common"
+	"0chain.net/core/config"
+	"0chain.net/core/encryption"
+	"0chain.net/core/logging"
+	"0chain.net/core/node"
+	"0chain.net/core/transaction"
+
+	"github.com/0chain/gosdk/core/zcncrypto"
+	"github.com/0chain/gosdk/zcncore"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func init() {
+	logging.Logger = zap.NewNop()
+}
+
+func TestChainWithGosdk(t *testing.T) {
+	config.Configuration.SignatureScheme = "bls0chain"
+	mc := New(Config{ChainID: "mock_chain", VerifySignatures: true})
+	server := httptest.NewServer(mc.Handler())
+	defer server.Close()
+
+	wallet, err := zcncrypto.NewSignatureScheme("bls0chain").GenerateKeys()
+	require.NoError(t, err)
+	node.Self.SetKeys(wallet.Keys[0].PublicKey, wallet.Keys[0].PrivateKey)
+	chain.SetServerChain(&chain.Chain{ID: "mock_chain"})
+
+	require.NoError(t, zcncore.InitZCNSDK(server.URL, "bls0chain"))
+	require.NoError(t, zcncore.SetWalletInfo(node.Self.GetWalletString(), false))
+
+	txn, err := transaction.NewTransactionEntity()
+	require.NoError(t, err)
+	input := `{"url":"http://localhost:5051","capacity":1073741824}`
+	require.NoError(t, txn.ExecuteSmartContract(transaction.STORAGE_CONTRACT_ADDRESS,
+		transaction.ADD_BLOBBER_SC_NAME, input, 0))
+
+	confirmed, err := transaction.VerifyTransaction(txn.Hash, chain.GetServerChain())
+	require.NoError(t, err)
+	require.Equal(t, txn.Hash, confirmed.Hash)
+	require.Contains(t, confirmed.TransactionOutput, node.Self.ID)
+
+	resp, err := transaction.MakeSCRestAPICall(transaction.STORAGE_CONTRACT_ADDRESS,
+		"/getblobbers", nil, chain.GetServerChain(), nil)
+	require.NoError(t, err)
+	var blobbers struct {
+		Nodes []*transaction.StorageNode
+	}
+	require.NoError(t, json.Unmarshal(resp, &blobbers))
+	require.Len(t, blobbers.Nodes, 1)
+	require.Equal(t, node.Self.ID, blobbers.Nodes[0].ID)
+	require.Equal(t, "http://localhost:5051", blobbers.Nodes[0].BaseURL)
+}
+
+var txnSequence common.Timestamp
+
+// submit a storage SC transaction, unsigned, and return its block.
+func submit(t *testing.T, mc *Chain, clientID, name string, input interface{}) *Block {
+	data, err := json.Marshal(&transaction.SmartContractTxnData{Name: name, InputArgs: input})
+	require.NoError(t, err)
+	txn := &transaction.Transaction{
+		ClientID:        clientID,
+		ToClientID:      transaction.STORAGE_CONTRACT_ADDRESS,
+		TransactionData: string(data),
+		CreationDate:    common.Now() + txnSequence,
+		TransactionType: transaction.TxnTypeSmartContract,
+	}
+	txnSequence++ // resubmitted markers must not repeat a hash
+	txn.Hash = encryption.Hash(fmt.Sprintf("%v:%v:%v:%v:%v", txn.CreationDate,
+		txn.ClientID, txn.ToClientID, txn.Value, encryption.Hash(txn.TransactionData)))
+	require.NoError(t, mc.SubmitTransaction(txn))
+	_, b, err := mc.GetTransaction(txn.Hash)
+	require.NoError(t, err)
+	return b
+}
+
+func TestStorageSC(t *testing.T) {
+	mc := New(Config{ChainID: "mock_chain"})
+	const blobberID, validatorID, ownerID = "blobber", "validator", "owner"
+
+	b := submit(t, mc, blobberID, transaction.ADD_BLOBBER_SC_NAME, map[string]interface{}{
+		"url":   "http://blobber",
+		"terms": map[string]interface{}{"read_price": 1e10},
+	})
+	require.Equal(t, TxnSuccess, b.TxnStatus)
+	b = submit(t, mc, validatorID, transaction.ADD_VALIDATOR_SC_NAME,
+		map[string]interface{}{"url": "http://validator"})
+	require.Equal(t, TxnSuccess, b.TxnStatus)
+
+	sa, err := mc.NewAllocation(&transaction.StorageAllocation{
+		OwnerID: ownerID, OwnerPublicKey: "owner_key", Size: 1 << 30,
+	})
+	require.NoError(t, err)
+	require.Len(t, sa.Blobbers, 1)
+	allocTxn, _, err := mc.GetTransaction(sa.ID)
+	require.NoError(t, err)
+	require.Contains(t, allocTxn.TransactionOutput, `"id":"`+sa.ID+`"`)
+
+	_, err = mc.NewChallenge(sa.ID, blobberID)
+	require.Error(t, err, "nothing committed yet")
+
+	t.Run("write markers chain", func(t *testing.T) {
+		wm := &WriteMarker{AllocationRoot: "root1", AllocationID: sa.ID,
+			BlobberID: blobberID, ClientID: ownerID, Size: 100}
+		commit := map[string]interface{}{"allocation_root": "root1", "write_marker": wm}
+		b := submit(t, mc, blobberID, transaction.CLOSE_CONNECTION_SC_NAME, commit)
+		require.Equal(t, TxnSuccess, b.TxnStatus, b.Txn.TransactionOutput)
+		require.Equal(t, "root1", mc.AllocationRoot(sa.ID, blobberID))
+
+		wm = &WriteMarker{AllocationRoot: "root2", PreviousAllocationRoot: "other",
+			AllocationID: sa.ID, BlobberID: blobberID, ClientID: ownerID}
+		commit = map[string]interface{}{"allocation_root": "root2",
+			"prev_allocation_root": "other", "write_marker": wm}
+		b = submit(t, mc, blobberID, transaction.CLOSE_CONNECTION_SC_NAME, commit)
+		require.Equal(t, TxnFailure, b.TxnStatus)
+		require.Equal(t, "root1", mc.AllocationRoot(sa.ID, blobberID))
+	})
+
+	t.Run("read redeem", func(t *testing.T) {
+		pool := mc.AddReadPool(ownerID, sa.ID, blobberID, 1e10, common.Now()+3600)
+		rm := &ReadMarker{ClientID: ownerID, BlobberID: blobberID,
+			AllocationID: sa.ID, OwnerID: ownerID, ReadCounter: 16 * 1024}
+		b := submit(t, mc, blobberID, transaction.READ_REDEEM,
+			map[string]interface{}{"read_marker": rm})
+		require.Equal(t, TxnSuccess, b.TxnStatus, b.Txn.TransactionOutput)
+
+		// 16384 blocks of 64 KB are 1 GB, at 1e10 per GB
+		var redeems []*PoolStat
+		require.NoError(t, json.Unmarshal([]byte(b.Txn.TransactionOutput), &redeems))
+		require.Equal(t, []*PoolStat{{PoolID: pool.PoolID, Balance: 1e10}}, redeems)
+		require.Zero(t, mc.GetReadPools(ownerID, sa.ID, blobberID)[0].Balance)
+		require.EqualValues(t, 16*1024, mc.GetLatestReadMarker(blobberID, ownerID).ReadCounter)
+
+		b = submit(t, mc, blobberID, transaction.READ_REDEEM,
+			map[string]interface{}{"read_marker": rm})
+		require.Equal(t, TxnFailure, b.TxnStatus)
+	})
+
+	t.Run("read redeem batch", func(t *testing.T) {
+		const readerID = "reader"
+		mc.AddReadPool(readerID, sa.ID, blobberID, 1e10, common.Now()+3600)
+		rms := []*ReadMarker{
+			{ClientID: readerID, BlobberID: blobberID, AllocationID: sa.ID,
+				OwnerID: ownerID, ReadCounter: 8 * 1024},
+			{ClientID: ownerID, BlobberID: blobberID, AllocationID: sa.ID,
+				OwnerID: ownerID, ReadCounter: 16 * 1024}, // redeemed already
+		}
+		b := submit(t, mc, blobberID, transaction.READ_REDEEM_BATCH,
+			map[string]interface{}{"read_markers": rms})
+		require.Equal(t, TxnSuccess, b.TxnStatus, b.Txn.TransactionOutput)
+
+		var results []*readRedeemResult
+		require.NoError(t, json.Unmarshal([]byte(b.Txn.TransactionOutput), &results))
+		require.Len(t, results, 2)
+		require.Equal(t, readerID, results[0].ClientID)
+		require.Empty(t, results[0].Error)
+		require.Len(t, results[0].Redeems, 1)
+		require.EqualValues(t, 5e9, results[0].Redeems[0].Balance)
+		require.Equal(t, ownerID, results[1].ClientID)
+		require.NotEmpty(t, results[1].Error)
+		require.EqualValues(t, 8*1024, mc.GetLatestReadMarker(blobberID, readerID).ReadCounter)
+	})
+
+	t.Run("challenge", func(t *testing.T) {
+		ch, err := mc.NewChallenge(sa.ID, blobberID)
+		require.NoError(t, err)
+		require.Equal(t, "root1", ch.AllocationRoot)
+		require.Len(t, mc.GetOpenChallenges(blobberID), 1)
+
+		next, err := mc.NewChallenge(sa.ID, blobberID)
+		require.NoError(t, err)
+		require.Equal(t, ch.ID, next.PrevID)
+
+		tickets := []*ValidationTicket{{ChallengeID: ch.ID, BlobberID: blobberID,
+			ValidatorID: validatorID, Result: true}}
+		b := submit(t, mc, blobberID, transaction.CHALLENGE_RESPONSE,
+			map[string]interface{}{"challenge_id": ch.ID, "validation_tickets": tickets})
+		require.Equal(t, TxnSuccess, b.TxnStatus, b.Txn.TransactionOutput)
+		require.True(t, mc.GetChallenge(ch.ID).Passed)
+
+		open := mc.GetOpenChallenges(blobberID)
+		require.Len(t, open, 1)
+		require.Equal(t, next.ID, open[0].ID)
+	})
+
+	responses := mc.Transactions(transaction.CHALLENGE_RESPONSE)
+	require.Len(t, responses, 1)
+	require.Equal(t, TxnSuccess, responses[0].TxnStatus)
+	redeems := mc.Transactions(transaction.READ_REDEEM)
+	require.Len(t, redeems, 2)
+	require.Equal(t, TxnFailure, redeems[1].TxnStatus)
+}
@@ -0,0 +1,271 @@
+package mockchain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"0chain.net/core/common"
+	"0chain.net/core/transaction"
+
+	"github.com/gorilla/mux"
+)
+
+type merklePath struct {
+	Nodes     []string `json:"nodes"`
+	LeafIndex int      `json:"leaf_index"`
+}
+
+// confirmation of a transaction as served by the sharders.
+type confirmation struct {
+	Version               string                   `json:"version"`
+	Hash                  string                   `json:"hash"`
+	BlockHash             string                   `json:"block_hash"`
+	PreviousBlockHash     string                   `json:"previous_block_hash"`
+	Transaction           *transaction.Transaction `json:"txn"`
+	CreationDate          common.Timestamp         `json:"creation_date"`
+	MinerID               string                   `json:"miner_id"`
+	Round                 int64                    `json:"round"`
+	Status                int                      `json:"transaction_status"`
+	RoundRandomSeed       int64                    `json:"round_random_seed"`
+	MerkleTreeRoot        string                   `json:"merkle_tree_root"`
+	MerkleTreePath        *merklePath              `json:"merkle_tree_path"`
+	ReceiptMerkleTreeRoot string                   `json:"receipt_merkle_tree_root"`
+	ReceiptMerkleTreePath *merklePath              `json:"receipt_merkle_tree_path"`
+}
+
+// Handler returns the HTTP handler serving the chain.
+func (c *Chain) Handler() http.Handler {
+	r := mux.NewRouter()
+	c.SetupHandlers(r)
+	return r
+}
+
+// SetupHandlers registers the block worker, miner and sharder APIs used by
+// the blobber and the validator, and the /_mock API setting up the state.
+func (c *Chain) SetupHandlers(r *mux.Router) {
+	// block worker
+	r.HandleFunc("/network", common.ToJSONResponse(c.NetworkHandler))
+
+	// miner
+	r.HandleFunc("/v1/client/put", common.ToJSONResponse(c.RegisterClientHandler))
+	r.HandleFunc("/v1/transaction/put", common.ToJSONResponse(c.PutTransactionHandler))
+
+	// sharder
+	r.HandleFunc("/v1/client/get/balance", common.ToJSONResponse(c.BalanceHandler))
+	r.HandleFunc("/v1/transaction/get/confirmation", common.ToJSONResponse(c.ConfirmationHandler))
+	r.HandleFunc("/v1/block/get", common.ToJSONResponse(c.BlockHandler))
+	r.HandleFunc("/v1/block/get/latest_finalized", common.ToJSONResponse(c.LatestFinalizedBlockHandler))
+	r.HandleFunc("/v1/screst/{sc_address}/{method}", common.ToJSONResponse(c.SCRestHandler))
+
+	// state setup
+	r.HandleFunc("/_mock/allocation", common.ToJSONResponse(c.AllocationHandler))
+	r.HandleFunc("/_mock/readpool", common.ToJSONResponse(c.ReadPoolHandler))
+	r.HandleFunc("/_mock/writepool", common.ToJSONResponse(c.WritePoolHandler))
+	r.HandleFunc("/_mock/challenge", common.ToJSONResponse(c.ChallengeHandler))
+}
+
+// NetworkHandler serves the chain itself as the only miner and sharder.
+func (c *Chain) NetworkHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	self := "http://" + r.Host
+	return map[string][]string{
+		"miners":   {self},
+		"sharders": {self},
+	}, nil
+}
+
+func (c *Chain) RegisterClientHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	var client struct {
+		ID        string `json:"id"`
+		PublicKey string `json:"public_key"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&client); err != nil {
+		return nil, common.NewErrorf("invalid_request", "decoding client: %v", err)
+	}
+	if err := c.RegisterClient(client.ID, client.PublicKey); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func (c *Chain) PutTransactionHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	txn := new(transaction.Transaction)
+	if err := json.NewDecoder(r.Body).Decode(txn); err != nil {
+		return nil, common.NewErrorf("invalid_request", "decoding transaction: %v", err)
+	}
+	if err := c.SubmitTransaction(txn); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"async": true, "entity": txn}, nil
+}
+
+func (c *Chain) BalanceHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	clientID := r.FormValue("client_id")
+	return map[string]interface{}{
+		"client_id": clientID,
+		"round":     c.LatestFinalizedBlock().Round,
+		"balance":   c.GetBalance(clientID),
+	}, nil
+}
+
+func (c *Chain) ConfirmationHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	txn, b, err := c.GetTransaction(r.FormValue("hash"))
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"confirmation": &confirmation{
+			Version:               b.Version,
+			Hash:                  txn.Hash,
+			BlockHash:             b.Hash,
+			PreviousBlockHash:     b.PrevHash,
+			Transaction:           txn,
+			CreationDate:          b.CreationDate,
+			MinerID:               b.MinerID,
+			Round:                 b.Round,
+			Status:                b.TxnStatus,
+			RoundRandomSeed:       b.RoundRandomSeed,
+			MerkleTreeRoot:        b.MerkleTreeRoot,
+			MerkleTreePath:        &merklePath{Nodes: []string{}},
+			ReceiptMerkleTreeRoot: b.ReceiptMerkleTreeRoot,
+			ReceiptMerkleTreePath: &merklePath{Nodes: []string{}},
+		},
+		"latest_finalized_block": c.LatestFinalizedBlock(),
+	}, nil
+}
+
+func (c *Chain) BlockHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	round, err := strconv.ParseInt(r.FormValue("round"), 10, 64)
+	if err != nil {
+		return nil, common.NewError("invalid_request", "invalid round")
+	}
+	b, err := c.GetBlock(round)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"header": b}, nil
+}
+
+func (c *Chain) LatestFinalizedBlockHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	return c.LatestFinalizedBlock(), nil
+}
+
+// SCRestHandler serves the storage smart contract REST API.
+func (c *Chain) SCRestHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	if vars["sc_address"] != transaction.STORAGE_CONTRACT_ADDRESS {
+		return nil, common.NewError("invalid_sc", "unknown smart contract "+vars["sc_address"])
+	}
+
+	switch vars["method"] {
+	case "allocation":
+		return c.GetAllocation(r.FormValue("allocation"))
+	case "getblobbers":
+		return map[string]interface{}{"Nodes": c.GetBlobbers()}, nil
+	case "getReadPoolAllocBlobberStat":
+		return c.GetReadPools(r.FormValue("client_id"),
+			r.FormValue("allocation_id"), r.FormValue("blobber_id")), nil
+	case "getWritePoolAllocBlobberStat":
+		return c.GetWritePools(r.FormValue("client_id"),
+			r.FormValue("allocation_id"), r.FormValue("blobber_id")), nil
+	case "latestreadmarker":
+		rm := c.GetLatestReadMarker(r.FormValue("blobber"), r.FormValue("client"))
+		if rm == nil {
+			return map[string]interface{}{}, nil
+		}
+		return rm, nil
+	case "openchallenges":
+		blobberID := r.FormValue("blobber")
+		return map[string]interface{}{
+			"blobber_id": blobberID,
+			"challenges": c.GetOpenChallenges(blobberID),
+		}, nil
+	case "getchallenge":
+		ch := c.GetChallenge(r.FormValue("challenge"))
+		if ch == nil || ch.BlobberID != r.FormValue("blobber") {
+			return nil, common.NewError("challenge_not_found", "challenge not found")
+		}
+		return ch, nil
+	case "functions":
+		return map[string]interface{}{"functions": storageFunctions}, nil
+	}
+	return nil, common.NewError("invalid_request", "unknown method "+vars["method"])
+}
+
+// AllocationHandler creates an allocation on POST and returns it on GET.
+func (c *Chain) AllocationHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	if r.Method == http.MethodGet {
+		return c.GetAllocation(r.FormValue("id"))
+	}
+	req := new(transaction.StorageAllocation)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		return nil, common.NewErrorf("invalid_request", "decoding allocation: %v", err)
+	}
+	return c.NewAllocation(req)
+}
+
+type poolRequest struct {
+	ClientID     string           `json:"client_id"`
+	AllocationID string           `json:"allocation_id"`
+	BlobberID    string           `json:"blobber_id"`
+	Balance      int64            `json:"balance"`
+	ExpireAt     common.Timestamp `json:"expire_at"`
+}
+
+func decodePoolRequest(r *http.Request) (*poolRequest, error) {
+	req := new(poolRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		return nil, common.NewErrorf("invalid_request", "decoding pool: %v", err)
+	}
+	if req.ClientID == "" || req.AllocationID == "" || req.BlobberID == "" {
+		return nil, common.NewError("invalid_request",
+			"client_id, allocation_id and blobber_id are required")
+	}
+	return req, nil
+}
+
+func (c *Chain) ReadPoolHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	if r.Method == http.MethodGet {
+		return c.GetReadPools(r.FormValue("client_id"),
+			r.FormValue("allocation_id"), r.FormValue("blobber_id")), nil
+	}
+	req, err := decodePoolRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	return c.AddReadPool(req.ClientID, req.AllocationID, req.BlobberID,
+		req.Balance, req.ExpireAt), nil
+}
+
+func (c *Chain) WritePoolHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	if r.Method == http.MethodGet {
+		return c.GetWritePools(r.FormValue("client_id"),
+			r.FormValue("allocation_id"), r.FormValue("blobber_id")), nil
+	}
+	req, err := decodePoolRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	return c.AddWritePool(req.ClientID, req.AllocationID, req.BlobberID,
+		req.Balance, req.ExpireAt), nil
+}
+
+// ChallengeHandler challenges a blobber on POST and returns a challenge on GET.
+func (c *Chain) ChallengeHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	if r.Method == http.MethodGet {
+		ch := c.GetChallenge(r.FormValue("id"))
+		if ch == nil {
+			return nil, common.NewError("challenge_not_found", "challenge not found")
+		}
+		return ch, nil
+	}
+	var req struct {
+		AllocationID string `json:"allocation_id"`
+		BlobberID    string `json:"blobber_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, common.NewErrorf("invalid_request", "decoding challenge: %v", err)
+	}
+	return c.NewChallenge(req.AllocationID, req.BlobberID)
+}
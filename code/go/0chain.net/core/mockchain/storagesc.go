@@ -0,0 +1,823 @@
+package mockchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"0chain.net/core/transaction"
+
+	"github.com/0chain/gosdk/zcncore"
+)
+
+const (
+	NEW_ALLOCATION_SC_NAME = "new_allocation_request"
+	POUR_SC_NAME           = "pour"
+
+	chunkSize = 64 * 1024
+	gb        = 1024 * 1024 * 1024
+
+	defaultAllocationDuration = time.Hour
+	defaultTimeUnit           = 48 * time.Hour
+	defaultCCT                = 2 * time.Minute
+)
+
+// Node is a blobber or a validator as referred to by a challenge.
+type Node struct {
+	ID      string `json:"id"`
+	BaseURL string `json:"url"`
+}
+
+// PoolStat is the balance of a read or write pool of a client for an
+// allocation and a blobber.
+type PoolStat struct {
+	PoolID   string           `json:"pool_id"`
+	Balance  int64            `json:"balance"`
+	ExpireAt common.Timestamp `json:"expire_at"`
+}
+
+type poolKey struct {
+	clientID, allocationID, blobberID string
+}
+
+// ReadMarker as redeemed by the blobbers.
+type ReadMarker struct {
+	ClientID        string           `json:"client_id"`
+	ClientPublicKey string           `json:"client_public_key"`
+	BlobberID       string           `json:"blobber_id"`
+	AllocationID    string           `json:"allocation_id"`
+	OwnerID         string           `json:"owner_id"`
+	Timestamp       common.Timestamp `json:"timestamp"`
+	ReadCounter     int64            `json:"counter"`
+	Signature       string           `json:"signature"`
+	Suspend         int64            `json:"suspend"`
+	PayerID         string           `json:"payer_id"`
+	AuthTicket      json.RawMessage  `json:"auth_ticket"`
+}
+
+func (rm *ReadMarker) hashData() string {
+	return fmt.Sprintf("%v:%v:%v:%v:%v:%v:%v", rm.AllocationID,
+		rm.BlobberID, rm.ClientID, rm.ClientPublicKey, rm.OwnerID,
+		rm.ReadCounter, rm.Timestamp)
+}
+
+// WriteMarker as committed by the blobbers.
+type WriteMarker struct {
+	AllocationRoot         string           `json:"allocation_root"`
+	PreviousAllocationRoot string           `json:"prev_allocation_root"`
+	AllocationID           string           `json:"allocation_id"`
+	Size                   int64            `json:"size"`
+	BlobberID              string           `json:"blobber_id"`
+	Timestamp              common.Timestamp `json:"timestamp"`
+	ClientID               string           `json:"client_id"`
+	Signature              string           `json:"signature"`
+}
+
+func (wm *WriteMarker) hashData() string {
+	return fmt.Sprintf("%v:%v:%v:%v:%v:%v:%v", wm.AllocationRoot,
+		wm.PreviousAllocationRoot, wm.AllocationID, wm.BlobberID, wm.ClientID,
+		wm.Size, wm.Timestamp)
+}
+
+// ValidationTicket as signed by the validators.
+type ValidationTicket struct {
+	ChallengeID  string           `json:"challenge_id"`
+	BlobberID    string           `json:"blobber_id"`
+	ValidatorID  string           `json:"validator_id"`
+	ValidatorKey string           `json:"validator_key"`
+	Result       bool             `json:"success"`
+	Message      string           `json:"message"`
+	MessageCode  string           `json:"message_code"`
+	Timestamp    common.Timestamp `json:"timestamp"`
+	Signature    string           `json:"signature"`
+}
+
+func (vt *ValidationTicket) hashData() string {
+	return fmt.Sprintf("%v:%v:%v:%v:%v:%v", vt.ChallengeID, vt.BlobberID,
+		vt.ValidatorID, vt.ValidatorKey, vt.Result, vt.Timestamp)
+}
+
+// Challenge of a blobber for an allocation. It serves both the blobber
+// (open challenges) and the validator (challenge lookup).
+type Challenge struct {
+	ID             string           `json:"id"`
+	PrevID         string           `json:"prev_id"`
+	RandomNumber   int64            `json:"seed"`
+	AllocationID   string           `json:"allocation_id"`
+	AllocationRoot string           `json:"allocation_root"`
+	BlobberID      string           `json:"blobber_id"`
+	Blobber        *Node            `json:"blobber"`
+	Validators     []*Node          `json:"validators"`
+	Created        common.Timestamp `json:"created"`
+	Responded      bool             `json:"responded"`
+	Passed         bool             `json:"passed"`
+	ResponseTxn    string           `json:"response_txn,omitempty"`
+}
+
+type storageState struct {
+	blobbers     map[string]*transaction.StorageNode
+	blobberIDs   []string
+	validators   map[string]*transaction.StorageNode
+	validatorIDs []string
+	healthChecks map[string]common.Timestamp
+
+	allocations map[string]*transaction.StorageAllocation
+	// allocation ID -> blobber ID -> allocation root of the last
+	// committed write marker
+	roots map[string]map[string]string
+
+	readPools   map[poolKey][]*PoolStat
+	writePools  map[poolKey][]*PoolStat
+	readMarkers map[string]*ReadMarker // blobber ID:client ID
+
+	challenges     map[string]*Challenge
+	challengeIDs   map[string][]string // blobber ID -> challenges, in order
+	poolSequence   int64
+	challengeCount int64
+}
+
+func newStorageState() *storageState {
+	return &storageState{
+		blobbers:     make(map[string]*transaction.StorageNode),
+		validators:   make(map[string]*transaction.StorageNode),
+		healthChecks: make(map[string]common.Timestamp),
+		allocations:  make(map[string]*transaction.StorageAllocation),
+		roots:        make(map[string]map[string]string),
+		readPools:    make(map[poolKey][]*PoolStat),
+		writePools:   make(map[poolKey][]*PoolStat),
+		readMarkers:  make(map[string]*ReadMarker),
+		challenges:   make(map[string]*Challenge),
+		challengeIDs: make(map[string][]string),
+	}
+}
+
+type scTxnData struct {
+	Name  string          `json:"name"`
+	Input json.RawMessage `json:"input"`
+}
+
+// storageFunctions are the functions of the storage smart contract the
+// chain executes, as listed by its functions REST method.
+var storageFunctions = []string{
+	transaction.ADD_BLOBBER_SC_NAME,
+	transaction.ADD_VALIDATOR_SC_NAME,
+	transaction.BLOBBER_HEALTH_CHECK,
+	transaction.CLOSE_CONNECTION_SC_NAME,
+	transaction.READ_REDEEM,
+	transaction.READ_REDEEM_BATCH,
+	transaction.CHALLENGE_RESPONSE,
+	transaction.FINALIZE_ALLOCATION,
+}
+
+// execute the transaction against the state, returning its output.
+func (c *Chain) execute(txn *transaction.Transaction) (string, error) {
+	switch txn.TransactionType {
+	case transaction.TxnTypeSend:
+		if c.balances[txn.ClientID] < txn.Value {
+			return "", common.NewError("insufficient_balance",
+				"not enough tokens to send")
+		}
+		c.balances[txn.ClientID] -= txn.Value
+		c.balances[txn.ToClientID] += txn.Value
+		return "transfer", nil
+	case transaction.TxnTypeSmartContract:
+	default:
+		return "", nil // data transactions have no effect
+	}
+
+	var sc scTxnData
+	if err := json.Unmarshal([]byte(txn.TransactionData), &sc); err != nil {
+		return "", common.NewErrorf("invalid_transaction",
+			"decoding smart contract data: %v", err)
+	}
+
+	switch txn.ToClientID {
+	case zcncore.FaucetSmartContractAddress:
+		if sc.Name != POUR_SC_NAME {
+			break
+		}
+		c.balances[txn.ClientID] += c.PourAmount
+		return fmt.Sprintf("%d", c.PourAmount), nil
+	case transaction.STORAGE_CONTRACT_ADDRESS:
+		switch sc.Name {
+		case transaction.ADD_BLOBBER_SC_NAME:
+			return c.addBlobber(txn, sc.Input)
+		case transaction.ADD_VALIDATOR_SC_NAME:
+			return c.addValidator(txn, sc.Input)
+		case transaction.BLOBBER_HEALTH_CHECK:
+			return c.blobberHealthCheck(txn)
+		case transaction.CLOSE_CONNECTION_SC_NAME:
+			return c.commitConnection(txn, sc.Input)
+		case transaction.READ_REDEEM:
+			return c.readRedeem(txn, sc.Input)
+		case transaction.READ_REDEEM_BATCH:
+			return c.readRedeemBatch(txn, sc.Input)
+		case transaction.CHALLENGE_RESPONSE:
+			return c.challengeResponse(txn, sc.Input)
+		case transaction.FINALIZE_ALLOCATION:
+			return c.finalizeAllocation(txn, sc.Input)
+		}
+	}
+	return "", common.NewErrorf("invalid_transaction",
+		"unknown smart contract function %v of %v", sc.Name, txn.ToClientID)
+}
+
+func (c *Chain) verify(publicKey, signature, hash string) error {
+	if !c.VerifySignatures {
+		return nil
+	}
+	ok, err := encryption.Verify(publicKey, signature, hash)
+	if err != nil || !ok {
+		return common.NewError("invalid_signature", "signature verification failed")
+	}
+	return nil
+}
+
+func (c *Chain) addBlobber(txn *transaction.Transaction, input []byte) (string, error) {
+	sn := new(transaction.StorageNode)
+	if err := json.Unmarshal(input, sn); err != nil {
+		return "", common.NewErrorf("add_blobber_failed", "decoding input: %v", err)
+	}
+	if sn.BaseURL == "" {
+		return "", common.NewError("add_blobber_failed", "missing blobber url")
+	}
+	sn.ID = txn.ClientID
+	sn.PublicKey = txn.PublicKey
+
+	s := c.storage
+	if _, ok := s.blobbers[sn.ID]; !ok {
+		s.blobberIDs = append(s.blobberIDs, sn.ID)
+	}
+	s.blobbers[sn.ID] = sn
+	s.healthChecks[sn.ID] = common.Now()
+	out, _ := json.Marshal(sn)
+	return string(out), nil
+}
+
+func (c *Chain) addValidator(txn *transaction.Transaction, input []byte) (string, error) {
+	sn := new(transaction.StorageNode)
+	if err := json.Unmarshal(input, sn); err != nil {
+		return "", common.NewErrorf("add_validator_failed", "decoding input: %v", err)
+	}
+	if sn.BaseURL == "" {
+		return "", common.NewError("add_validator_failed", "missing validator url")
+	}
+	sn.ID = txn.ClientID
+	sn.PublicKey = txn.PublicKey
+
+	s := c.storage
+	if _, ok := s.validators[sn.ID]; !ok {
+		s.validatorIDs = append(s.validatorIDs, sn.ID)
+	}
+	s.validators[sn.ID] = sn
+	out, _ := json.Marshal(sn)
+	return string(out), nil
+}
+
+func (c *Chain) blobberHealthCheck(txn *transaction.Transaction) (string, error) {
+	if _, ok := c.storage.blobbers[txn.ClientID]; !ok {
+		return "", common.NewError("blobber_health_check_failed", "blobber not found")
+	}
+	c.storage.healthChecks[txn.ClientID] = common.Now()
+	return "health check", nil
+}
+
+func (c *Chain) allocationOfBlobber(allocationID, blobberID string) (*transaction.StorageAllocation, *transaction.BlobberAllocation, error) {
+	sa, ok := c.storage.allocations[allocationID]
+	if !ok {
+		return nil, nil, common.NewError("allocation_not_found",
+			"allocation not found: "+allocationID)
+	}
+	for _, d := range sa.BlobberDetails {
+		if d.BlobberID == blobberID {
+			return sa, d, nil
+		}
+	}
+	return nil, nil, common.NewError("invalid_blobber",
+		"blobber is not part of the allocation")
+}
+
+func (c *Chain) commitConnection(txn *transaction.Transaction, input []byte) (string, error) {
+	var cc struct {
+		AllocationRoot     string       `json:"allocation_root"`
+		PrevAllocationRoot string       `json:"prev_allocation_root"`
+		WriteMarker        *WriteMarker `json:"write_marker"`
+	}
+	if err := json.Unmarshal(input, &cc); err != nil {
+		return "", common.NewErrorf("commit_connection_failed", "decoding input: %v", err)
+	}
+	wm := cc.WriteMarker
+	if wm == nil || wm.AllocationRoot != cc.AllocationRoot ||
+		wm.PreviousAllocationRoot != cc.PrevAllocationRoot {
+		return "", common.NewError("commit_connection_failed", "invalid write marker")
+	}
+	if wm.BlobberID != txn.ClientID {
+		return "", common.NewError("commit_connection_failed",
+			"write marker is not for the sending blobber")
+	}
+	sa, _, err := c.allocationOfBlobber(wm.AllocationID, wm.BlobberID)
+	if err != nil {
+		return "", err
+	}
+	if sa.Finalized {
+		return "", common.NewError("commit_connection_failed", "allocation is finalized")
+	}
+
+	roots := c.storage.roots[sa.ID]
+	if roots == nil {
+		roots = make(map[string]string)
+		c.storage.roots[sa.ID] = roots
+	}
+	if roots[wm.BlobberID] != wm.PreviousAllocationRoot {
+		return "", common.NewErrorf("commit_connection_failed",
+			"previous allocation root %v doesn't match the latest %v",
+			wm.PreviousAllocationRoot, roots[wm.BlobberID])
+	}
+
+	clientKey := c.clients[wm.ClientID]
+	if wm.ClientID == sa.OwnerID {
+		clientKey = sa.OwnerPublicKey
+	}
+	if clientKey != "" {
+		if err := c.verify(clientKey, wm.Signature, encryption.Hash(wm.hashData())); err != nil {
+			return "", err
+		}
+	}
+
+	roots[wm.BlobberID] = wm.AllocationRoot
+	sa.UsedSize += wm.Size
+	out, _ := json.Marshal(wm)
+	return string(out), nil
+}
+
+func (c *Chain) readRedeem(txn *transaction.Transaction, input []byte) (string, error) {
+	var rr struct {
+		ReadMarker *ReadMarker `json:"read_marker"`
+	}
+	if err := json.Unmarshal(input, &rr); err != nil {
+		return "", common.NewErrorf("redeem_failed", "decoding input: %v", err)
+	}
+	redeems, err := c.redeemReadMarker(txn, rr.ReadMarker)
+	if err != nil {
+		return "", err
+	}
+	out, _ := json.Marshal(redeems)
+	return string(out), nil
+}
+
+// readRedeemResult is the outcome of a read marker of a batch.
+type readRedeemResult struct {
+	ClientID string      `json:"client_id"`
+	Redeems  []*PoolStat `json:"redeems"`
+	Error    string      `json:"error,omitempty"`
+}
+
+// readRedeemBatch redeems the read markers one by one, those failing are
+// reported in the output without failing the transaction.
+func (c *Chain) readRedeemBatch(txn *transaction.Transaction, input []byte) (string, error) {
+	var rr struct {
+		ReadMarkers []*ReadMarker `json:"read_markers"`
+	}
+	if err := json.Unmarshal(input, &rr); err != nil {
+		return "", common.NewErrorf("redeem_failed", "decoding input: %v", err)
+	}
+	if len(rr.ReadMarkers) == 0 {
+		return "", common.NewError("redeem_failed", "no read markers")
+	}
+	results := make([]*readRedeemResult, 0, len(rr.ReadMarkers))
+	for _, rm := range rr.ReadMarkers {
+		res := &readRedeemResult{}
+		if rm != nil {
+			res.ClientID = rm.ClientID
+		}
+		redeems, err := c.redeemReadMarker(txn, rm)
+		if err != nil {
+			res.Error = err.Error()
+		}
+		res.Redeems = redeems
+		results = append(results, res)
+	}
+	out, _ := json.Marshal(results)
+	return string(out), nil
+}
+
+func (c *Chain) redeemReadMarker(txn *transaction.Transaction, rm *ReadMarker) ([]*PoolStat, error) {
+	if rm == nil {
+		return nil, common.NewError("redeem_failed", "missing read marker")
+	}
+	if rm.BlobberID != txn.ClientID {
+		return nil, common.NewError("redeem_failed",
+			"read marker is not for the sending blobber")
+	}
+	_, details, err := c.allocationOfBlobber(rm.AllocationID, rm.BlobberID)
+	if err != nil {
+		return nil, err
+	}
+
+	key := rm.BlobberID + ":" + rm.ClientID
+	var prevCounter int64
+	if prev, ok := c.storage.readMarkers[key]; ok {
+		prevCounter = prev.ReadCounter
+	}
+	if rm.ReadCounter <= prevCounter {
+		return nil, common.NewErrorf("redeem_failed",
+			"read counter %v is not above the redeemed %v",
+			rm.ReadCounter, prevCounter)
+	}
+	if err := c.verify(rm.ClientPublicKey, rm.Signature, encryption.Hash(rm.hashData())); err != nil {
+		return nil, err
+	}
+
+	numBlocks := rm.ReadCounter - prevCounter
+	value := int64(float64(numBlocks*chunkSize) / gb * float64(details.Terms.ReadPrice))
+	payerID := rm.PayerID
+	if payerID == "" {
+		payerID = rm.ClientID
+	}
+	redeems, err := c.storage.debit(c.storage.readPools,
+		poolKey{payerID, rm.AllocationID, rm.BlobberID}, value)
+	if err != nil {
+		return nil, err
+	}
+
+	c.storage.readMarkers[key] = rm
+	c.balances[rm.BlobberID] += value
+	return redeems, nil
+}
+
+// debit the value from the unexpired pools of the key, in their order.
+func (s *storageState) debit(pools map[poolKey][]*PoolStat, key poolKey, value int64) ([]*PoolStat, error) {
+	now := common.Now()
+	var available int64
+	for _, ps := range pools[key] {
+		if ps.ExpireAt > now {
+			available += ps.Balance
+		}
+	}
+	if available < value {
+		return nil, common.NewErrorf("not_enough_tokens",
+			"pools hold %v, %v required", available, value)
+	}
+
+	redeems := make([]*PoolStat, 0)
+	for _, ps := range pools[key] {
+		if value == 0 {
+			break
+		}
+		if ps.ExpireAt <= now || ps.Balance == 0 {
+			continue
+		}
+		move := ps.Balance
+		if move > value {
+			move = value
+		}
+		ps.Balance -= move
+		value -= move
+		redeems = append(redeems, &PoolStat{PoolID: ps.PoolID, Balance: move})
+	}
+	return redeems, nil
+}
+
+func (c *Chain) challengeResponse(txn *transaction.Transaction, input []byte) (string, error) {
+	var cr struct {
+		ChallengeID       string              `json:"challenge_id"`
+		ValidationTickets []*ValidationTicket `json:"validation_tickets"`
+	}
+	if err := json.Unmarshal(input, &cr); err != nil {
+		return "", common.NewErrorf("challenge_response_failed", "decoding input: %v", err)
+	}
+	ch, ok := c.storage.challenges[cr.ChallengeID]
+	if !ok || ch.BlobberID != txn.ClientID {
+		return "", common.NewError("challenge_response_failed",
+			"challenge not found: "+cr.ChallengeID)
+	}
+	if ch.Responded {
+		return "", common.NewError("challenge_response_failed",
+			"challenge already responded")
+	}
+
+	var success, failure int
+	seen := make(map[string]bool)
+	for _, vt := range cr.ValidationTickets {
+		if vt == nil || vt.ChallengeID != ch.ID || vt.BlobberID != ch.BlobberID ||
+			seen[vt.ValidatorID] {
+			continue
+		}
+		validator, ok := c.storage.validators[vt.ValidatorID]
+		if !ok || !ch.hasValidator(vt.ValidatorID) {
+			continue
+		}
+		if c.verify(validator.PublicKey, vt.Signature, encryption.Hash(vt.hashData())) != nil {
+			continue
+		}
+		seen[vt.ValidatorID] = true
+		if vt.Result {
+			success++
+		} else {
+			failure++
+		}
+	}
+
+	threshold := len(ch.Validators)/2 + 1
+	switch {
+	case success >= threshold:
+		ch.Passed = true
+	case failure >= threshold:
+		ch.Passed = false
+	default:
+		return "", common.NewErrorf("challenge_response_failed",
+			"not enough validation tickets, %v of %v required",
+			success+failure, threshold)
+	}
+	ch.Responded = true
+	ch.ResponseTxn = txn.Hash
+	if ch.Passed {
+		return "challenge passed by blobber", nil
+	}
+	return "challenge failed by blobber", nil
+}
+
+func (ch *Challenge) hasValidator(id string) bool {
+	for _, v := range ch.Validators {
+		if v.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Chain) finalizeAllocation(txn *transaction.Transaction, input []byte) (string, error) {
+	var fr struct {
+		AllocationID string `json:"allocation_id"`
+	}
+	if err := json.Unmarshal(input, &fr); err != nil {
+		return "", common.NewErrorf("finalize_allocation_failed", "decoding input: %v", err)
+	}
+	sa, ok := c.storage.allocations[fr.AllocationID]
+	if !ok {
+		return "", common.NewError("finalize_allocation_failed", "allocation not found")
+	}
+	if sa.Finalized {
+		return "", common.NewError("finalize_allocation_failed", "allocation already finalized")
+	}
+	if common.Now() < sa.Until() {
+		return "", common.NewError("finalize_allocation_failed", "allocation is not expired yet")
+	}
+	sa.Finalized = true
+	return "allocation finalized", nil
+}
+
+// NewAllocation creates an allocation through a transaction of its owner,
+// so that the allocation ID verifies as a transaction hash. The allocation
+// is on all registered blobbers when it names none.
+func (c *Chain) NewAllocation(req *transaction.StorageAllocation) (*transaction.StorageAllocation, error) {
+	if req.OwnerID == "" || req.OwnerPublicKey == "" || req.Size <= 0 {
+		return nil, common.NewError("new_allocation_failed",
+			"owner, owner public key and size are required")
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	s := c.storage
+
+	sa := *req
+	sa.Blobbers = make([]*transaction.StorageNode, 0, len(req.Blobbers))
+	sa.BlobberDetails = make([]*transaction.BlobberAllocation, 0, len(req.Blobbers))
+	ids := make([]string, 0, len(req.Blobbers))
+	for _, b := range req.Blobbers {
+		ids = append(ids, b.ID)
+	}
+	if len(ids) == 0 {
+		ids = s.blobberIDs
+	}
+	if len(ids) == 0 {
+		return nil, common.NewError("new_allocation_failed", "no blobbers registered")
+	}
+	for _, id := range ids {
+		sn, ok := s.blobbers[id]
+		if !ok {
+			return nil, common.NewError("new_allocation_failed", "unknown blobber "+id)
+		}
+		sa.Blobbers = append(sa.Blobbers, sn)
+		sa.BlobberDetails = append(sa.BlobberDetails, &transaction.BlobberAllocation{
+			BlobberID: sn.ID,
+			Terms:     sn.Terms,
+		})
+	}
+	if sa.Expiration == 0 {
+		sa.Expiration = common.Now() + common.Timestamp(defaultAllocationDuration/time.Second)
+	}
+	if sa.TimeUnit == 0 {
+		sa.TimeUnit = defaultTimeUnit
+	}
+	if sa.CCT == 0 {
+		sa.CCT = defaultCCT
+	}
+	sa.UsedSize = 0
+	sa.Finalized = false
+
+	data, err := json.Marshal(&transaction.SmartContractTxnData{
+		Name:      NEW_ALLOCATION_SC_NAME,
+		InputArgs: req,
+	})
+	if err != nil {
+		return nil, common.NewErrorf("new_allocation_failed", "encoding request: %v", err)
+	}
+	txn := c.newTransaction(sa.OwnerID, transaction.STORAGE_CONTRACT_ADDRESS, string(data))
+	sa.ID, sa.Tx = txn.Hash, txn.Hash
+	out, err := json.Marshal(&sa)
+	if err != nil {
+		return nil, common.NewErrorf("new_allocation_failed", "encoding allocation: %v", err)
+	}
+	txn.TransactionOutput = string(out)
+	c.commitTransaction(txn)
+
+	s.allocations[sa.ID] = &sa
+	c.clients[sa.OwnerID] = sa.OwnerPublicKey
+	cp := sa
+	return &cp, nil
+}
+
+// GetAllocation returns the current state of the allocation.
+func (c *Chain) GetAllocation(id string) (*transaction.StorageAllocation, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	sa, ok := c.storage.allocations[id]
+	if !ok {
+		return nil, common.NewError("allocation_not_found", "allocation not found: "+id)
+	}
+	cp := *sa
+	return &cp, nil
+}
+
+// AllocationRoot returns the root of the last write marker the blobber
+// committed for the allocation.
+func (c *Chain) AllocationRoot(allocationID, blobberID string) string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.storage.roots[allocationID][blobberID]
+}
+
+// GetBlobber returns the registered blobber, or nil.
+func (c *Chain) GetBlobber(id string) *transaction.StorageNode {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if sn, ok := c.storage.blobbers[id]; ok {
+		cp := *sn
+		return &cp
+	}
+	return nil
+}
+
+// GetBlobbers returns the registered blobbers, in registration order.
+func (c *Chain) GetBlobbers() []*transaction.StorageNode {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	nodes := make([]*transaction.StorageNode, 0, len(c.storage.blobberIDs))
+	for _, id := range c.storage.blobberIDs {
+		cp := *c.storage.blobbers[id]
+		nodes = append(nodes, &cp)
+	}
+	return nodes
+}
+
+// GetValidator returns the registered validator, or nil.
+func (c *Chain) GetValidator(id string) *transaction.StorageNode {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if sn, ok := c.storage.validators[id]; ok {
+		cp := *sn
+		return &cp
+	}
+	return nil
+}
+
+// AddReadPool adds a read pool of the client for the allocation and blobber.
+func (c *Chain) AddReadPool(clientID, allocationID, blobberID string, balance int64, expireAt common.Timestamp) *PoolStat {
+	return c.addPool(c.storage.readPools, clientID, allocationID, blobberID, balance, expireAt)
+}
+
+// AddWritePool adds a write pool of the client for the allocation and blobber.
+func (c *Chain) AddWritePool(clientID, allocationID, blobberID string, balance int64, expireAt common.Timestamp) *PoolStat {
+	return c.addPool(c.storage.writePools, clientID, allocationID, blobberID, balance, expireAt)
+}
+
+func (c *Chain) addPool(pools map[poolKey][]*PoolStat, clientID, allocationID, blobberID string, balance int64, expireAt common.Timestamp) *PoolStat {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.storage.poolSequence++
+	ps := &PoolStat{
+		PoolID:   encryption.Hash(fmt.Sprintf("%v:%v:%v:%v", clientID, allocationID, blobberID, c.storage.poolSequence)),
+		Balance:  balance,
+		ExpireAt: expireAt,
+	}
+	key := poolKey{clientID, allocationID, blobberID}
+	pools[key] = append(pools[key], ps)
+	cp := *ps
+	return &cp
+}
+
+// GetReadPools returns the read pools of the client for the allocation and blobber.
+func (c *Chain) GetReadPools(clientID, allocationID, blobberID string) []*PoolStat {
+	return c.getPools(c.storage.readPools, clientID, allocationID, blobberID)
+}
+
+// GetWritePools returns the write pools of the client for the allocation and blobber.
+func (c *Chain) GetWritePools(clientID, allocationID, blobberID string) []*PoolStat {
+	return c.getPools(c.storage.writePools, clientID, allocationID, blobberID)
+}
+
+func (c *Chain) getPools(pools map[poolKey][]*PoolStat, clientID, allocationID, blobberID string) []*PoolStat {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	stats := make([]*PoolStat, 0)
+	for _, ps := range pools[poolKey{clientID, allocationID, blobberID}] {
+		cp := *ps
+		stats = append(stats, &cp)
+	}
+	return stats
+}
+
+// GetLatestReadMarker returns the last read marker redeemed by the blobber
+// for the client, or nil.
+func (c *Chain) GetLatestReadMarker(blobberID, clientID string) *ReadMarker {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if rm, ok := c.storage.readMarkers[blobberID+":"+clientID]; ok {
+		cp := *rm
+		return &cp
+	}
+	return nil
+}
+
+// NewChallenge challenges the blobber for the allocation, with all the
+// registered validators.
+func (c *Chain) NewChallenge(allocationID, blobberID string) (*Challenge, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	s := c.storage
+
+	if _, _, err := c.allocationOfBlobber(allocationID, blobberID); err != nil {
+		return nil, err
+	}
+	root := s.roots[allocationID][blobberID]
+	if root == "" {
+		return nil, common.NewError("new_challenge_failed",
+			"nothing was committed to the allocation by the blobber")
+	}
+	if len(s.validatorIDs) == 0 {
+		return nil, common.NewError("new_challenge_failed", "no validators registered")
+	}
+
+	blobber := s.blobbers[blobberID]
+	s.challengeCount++
+	ch := &Challenge{
+		RandomNumber:   c.rand.Int63(),
+		AllocationID:   allocationID,
+		AllocationRoot: root,
+		BlobberID:      blobberID,
+		Blobber:        &Node{ID: blobber.ID, BaseURL: blobber.BaseURL},
+		Created:        common.Now(),
+	}
+	ch.ID = encryption.Hash(fmt.Sprintf("%v:%v:%v:%v", allocationID, blobberID,
+		s.challengeCount, ch.RandomNumber))
+	for _, id := range s.validatorIDs {
+		v := s.validators[id]
+		ch.Validators = append(ch.Validators, &Node{ID: v.ID, BaseURL: v.BaseURL})
+	}
+	if ids := s.challengeIDs[blobberID]; len(ids) > 0 {
+		ch.PrevID = ids[len(ids)-1]
+	}
+	s.challenges[ch.ID] = ch
+	s.challengeIDs[blobberID] = append(s.challengeIDs[blobberID], ch.ID)
+	cp := *ch
+	return &cp, nil
+}
+
+// GetChallenge returns the challenge, or nil.
+func (c *Chain) GetChallenge(id string) *Challenge {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if ch, ok := c.storage.challenges[id]; ok {
+		cp := *ch
+		return &cp
+	}
+	return nil
+}
+
+// GetOpenChallenges returns the challenges of the blobber not responded to
+// yet, in order.
+func (c *Chain) GetOpenChallenges(blobberID string) []*Challenge {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	open := make([]*Challenge, 0)
+	for _, id := range c.storage.challengeIDs[blobberID] {
+		if ch := c.storage.challenges[id]; !ch.Responded {
+			cp := *ch
+			open = append(open, &cp)
+		}
+	}
+	return open
+}
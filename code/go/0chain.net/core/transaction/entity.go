@@ -120,6 +120,7 @@ const (
 	ADD_VALIDATOR_SC_NAME    = "add_validator"
 	CLOSE_CONNECTION_SC_NAME = "commit_connection"
 	READ_REDEEM              = "read_redeem"
+	READ_REDEEM_BATCH        = "read_redeem_batch" // not in the 0chain releases, see readmarker_redeem.batch
 	CHALLENGE_RESPONSE       = "challenge_response"
 	BLOBBER_HEALTH_CHECK     = "blobber_health_check"
 	FINALIZE_ALLOCATION      = "finalize_allocation"
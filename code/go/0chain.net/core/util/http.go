@@ -20,15 +20,56 @@ const MAX_RETRIES = 5
 const SLEEP_BETWEEN_RETRIES = 5
 
 func NewHTTPRequest(method string, url string, data []byte) (*http.Request, context.Context, context.CancelFunc, error) {
-	requestHash := encryption.Hash(data)
 	req, err := http.NewRequest(method, url, bytes.NewBuffer(data))
+	setRequestHeaders(req, data)
+	ctx, cncl := context.WithTimeout(context.Background(), time.Second*10)
+	return req, ctx, cncl, err
+}
+
+func setRequestHeaders(req *http.Request, data []byte) {
+	requestHash := encryption.Hash(data)
 	req.Header.Set("Content-Type", "application/json; charset=utf-8")
 	req.Header.Set("Access-Control-Allow-Origin", "*")
 	req.Header.Set("X-App-Client-ID", node.Self.ID)
 	req.Header.Set("X-App-Client-Key", node.Self.PublicKey)
 	req.Header.Set("X-App-Request-Hash", requestHash)
-	ctx, cncl := context.WithTimeout(context.Background(), time.Second*10)
-	return req, ctx, cncl, err
+}
+
+// HTTPNotFound is the code of the error of PostRequest to a URL not found.
+const HTTPNotFound = "http_not_found"
+
+// PostRequest sends the data once, bounded by the context, and returns the
+// body of the response. Unlike SendPostRequest it doesn't retry, the caller
+// decides.
+func PostRequest(ctx context.Context, url string, data []byte) (
+	[]byte, error) {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url,
+		bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	setRequestHeaders(req, data)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, common.NewError(HTTPNotFound,
+			"Error from HTTP call. "+string(body))
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, common.NewError("http_error",
+			"Error from HTTP call. "+string(body))
+	}
+	return body, nil
 }
 
 func SendMultiPostRequest(urls []string, data []byte) {
@@ -12,6 +12,12 @@ type MerkleTree struct {
 }
 
 func VerifyMerklePath(hash string, path *MTPath, root string) bool {
+	return MerklePathRoot(hash, path) == root
+}
+
+// MerklePathRoot returns the root of the merkle path from the leaf of the
+// hash given.
+func MerklePathRoot(hash string, path *MTPath) string {
 	mthash := hash
 	pathNodes := path.Nodes
 	pl := len(pathNodes)
@@ -24,7 +30,7 @@ func VerifyMerklePath(hash string, path *MTPath, root string) bool {
 		}
 		idx = (idx - idx&1) / 2
 	}
-	return mthash == root
+	return mthash
 }
 
 func (mt *MerkleTree) computeSize(leaves int) (int, int) {
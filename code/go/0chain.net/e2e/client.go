@@ -0,0 +1,250 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"0chain.net/blobbercore/readmarker"
+	"0chain.net/blobbercore/reference"
+	"0chain.net/blobbercore/writemarker"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"0chain.net/core/transaction"
+
+	"github.com/0chain/gosdk/core/zcncrypto"
+)
+
+// poolBalance is the balance of the read and write pools of the
+// allocations of a client, 1 token.
+const poolBalance = 1e10
+
+// Client is a client of the blobber, the owner of its allocations. It signs
+// its requests and markers with keys of its own.
+type Client struct {
+	ID        string
+	PublicKey string
+
+	h      *Harness
+	scheme zcncrypto.SignatureScheme
+}
+
+// UploadResult is the response of the blobber to an upload.
+type UploadResult struct {
+	Filename   string `json:"filename"`
+	Size       int64  `json:"size"`
+	Hash       string `json:"content_hash"`
+	MerkleRoot string `json:"merkle_root"`
+}
+
+// CommitResult is the response of the blobber to a commit.
+type CommitResult struct {
+	AllocationRoot string                   `json:"allocation_root"`
+	WriteMarker    *writemarker.WriteMarker `json:"write_marker"`
+	Success        bool                     `json:"success"`
+	ErrorMessage   string                   `json:"error_msg"`
+}
+
+// NewClient generates the keys of a client.
+func (h *Harness) NewClient() *Client {
+	scheme := zcncrypto.NewSignatureScheme("bls0chain")
+	if _, err := scheme.GenerateKeys(); err != nil {
+		h.fatalf("generating client keys: %v", err)
+	}
+	keyBytes, err := hex.DecodeString(scheme.GetPublicKey())
+	if err != nil {
+		h.fatalf("decoding client key: %v", err)
+	}
+	return &Client{
+		ID:        encryption.Hash(keyBytes),
+		PublicKey: scheme.GetPublicKey(),
+		h:         h,
+		scheme:    scheme,
+	}
+}
+
+func (c *Client) sign(hash string) string {
+	sign, err := c.scheme.Sign(hash)
+	if err != nil {
+		c.h.fatalf("signing: %v", err)
+	}
+	return sign
+}
+
+// NewAllocation creates an allocation of the client on the blobber, with
+// read and write pools to pay for it.
+func (c *Client) NewAllocation(size int64) *transaction.StorageAllocation {
+	sa, err := c.h.Chain.NewAllocation(&transaction.StorageAllocation{
+		OwnerID: c.ID, OwnerPublicKey: c.PublicKey, Size: size,
+	})
+	if err != nil {
+		c.h.fatalf("creating allocation: %v", err)
+	}
+	expireAt := sa.Expiration + common.Timestamp(time.Hour/time.Second)
+	c.h.Chain.AddReadPool(c.ID, sa.ID, c.h.Blobber.ID, poolBalance, expireAt)
+	c.h.Chain.AddWritePool(c.ID, sa.ID, c.h.Blobber.ID, poolBalance, expireAt)
+	return sa
+}
+
+// post sends the form, with the file part if any, to the blobber endpoint
+// of the allocation and decodes the JSON response into resp, or returns
+// the body if resp is nil.
+func (c *Client) post(endpoint string, sa *transaction.StorageAllocation,
+	form map[string]string, file []byte, resp interface{}) []byte {
+
+	c.h.t.Helper()
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for key, value := range form {
+		if err := w.WriteField(key, value); err != nil {
+			c.h.fatalf("writing form: %v", err)
+		}
+	}
+	if file != nil {
+		part, err := w.CreateFormFile("uploadFile", "file")
+		if err != nil {
+			c.h.fatalf("writing form file: %v", err)
+		}
+		if _, err = part.Write(file); err != nil {
+			c.h.fatalf("writing form file: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		c.h.fatalf("closing form: %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.h.Blobber.URL+endpoint+sa.Tx, &body)
+	if err != nil {
+		c.h.fatalf("creating request: %v", err)
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	req.Header.Set(common.ClientHeader, c.ID)
+	req.Header.Set(common.ClientKeyHeader, c.PublicKey)
+	req.Header.Set(common.ClientSignatureHeader, c.sign(encryption.Hash(sa.Tx)))
+
+	r, err := http.DefaultClient.Do(req)
+	if err != nil {
+		c.h.fatalf("POST %v: %v", endpoint, err)
+	}
+	defer r.Body.Close()
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		c.h.fatalf("reading the response of %v: %v", endpoint, err)
+	}
+	if r.StatusCode != http.StatusOK {
+		c.h.fatalf("POST %v: %v %s", endpoint, r.Status, data)
+	}
+	if resp != nil {
+		if err := json.Unmarshal(data, resp); err != nil {
+			c.h.fatalf("decoding the response of %v: %v", endpoint, err)
+		}
+	}
+	return data
+}
+
+// Upload the data as a new file of the path, in the connection.
+func (c *Client) Upload(sa *transaction.StorageAllocation, connectionID, path string, data []byte) *UploadResult {
+	c.h.t.Helper()
+	meta, err := json.Marshal(map[string]interface{}{
+		"connection_id": connectionID,
+		"filename":      filepath.Base(path),
+		"filepath":      path,
+		"actual_hash":   encryption.Hash(data),
+		"actual_size":   len(data),
+	})
+	if err != nil {
+		c.h.fatalf("encoding upload meta: %v", err)
+	}
+	result := new(UploadResult)
+	c.post("/v1/file/upload/", sa, map[string]string{
+		"connection_id": connectionID,
+		"uploadMeta":    string(meta),
+	}, data, result)
+	return result
+}
+
+// Commit the connection of the upload of the data, the only file of the
+// allocation, with a write marker following the root given.
+func (c *Client) Commit(sa *transaction.StorageAllocation, connectionID, path string,
+	data []byte, upload *UploadResult, prevRoot string) *CommitResult {
+
+	c.h.t.Helper()
+	// the root as the blobber computes it, of the root directory with the
+	// file as its only child
+	file := reference.NewFileRef()
+	file.AllocationID = sa.ID
+	file.Name = filepath.Base(path)
+	file.Path = path
+	file.ParentPath = filepath.Dir(path)
+	file.LookupHash = reference.GetReferenceLookup(sa.ID, path)
+	file.Size = upload.Size
+	file.ContentHash = upload.Hash
+	file.MerkleRoot = upload.MerkleRoot
+	file.ActualFileSize = int64(len(data))
+	file.ActualFileHash = encryption.Hash(data)
+	root := reference.NewDirectoryRef()
+	root.AllocationID = sa.ID
+	root.Path = "/"
+	root.AddChild(file)
+	if _, err := root.CalculateHash(context.Background(), false); err != nil {
+		c.h.fatalf("calculating the root hash: %v", err)
+	}
+
+	wm := &writemarker.WriteMarker{
+		PreviousAllocationRoot: prevRoot,
+		AllocationID:           sa.ID,
+		Size:                   upload.Size,
+		BlobberID:              c.h.Blobber.ID,
+		Timestamp:              common.Now(),
+		ClientID:               c.ID,
+	}
+	wm.AllocationRoot = encryption.Hash(root.Hash + ":" + strconv.FormatInt(int64(wm.Timestamp), 10))
+	wm.Signature = c.sign(encryption.Hash(wm.GetHashData()))
+	marker, err := json.Marshal(wm)
+	if err != nil {
+		c.h.fatalf("encoding write marker: %v", err)
+	}
+
+	result := new(CommitResult)
+	c.post("/v1/connection/commit/", sa, map[string]string{
+		"connection_id": connectionID,
+		"write_marker":  string(marker),
+	}, nil, result)
+	if !result.Success {
+		c.h.fatalf("commit failed: %v", result.ErrorMessage)
+	}
+	return result
+}
+
+// Download the block of the file of the path, paying with a read marker of
+// the counter given.
+func (c *Client) Download(sa *transaction.StorageAllocation, path string, blockNum, counter int64) []byte {
+	c.h.t.Helper()
+	rm := &readmarker.ReadMarker{
+		ClientID:        c.ID,
+		ClientPublicKey: c.PublicKey,
+		BlobberID:       c.h.Blobber.ID,
+		AllocationID:    sa.ID,
+		OwnerID:         sa.OwnerID,
+		Timestamp:       common.Now(),
+		ReadCounter:     counter,
+	}
+	rm.Signature = c.sign(encryption.Hash(rm.GetHashData()))
+	marker, err := json.Marshal(rm)
+	if err != nil {
+		c.h.fatalf("encoding read marker: %v", err)
+	}
+	return c.post("/v1/file/download/", sa, map[string]string{
+		"path":        path,
+		"block_num":   strconv.FormatInt(blockNum, 10),
+		"num_blocks":  "1",
+		"read_marker": string(marker),
+	}, nil, nil)
+}
@@ -0,0 +1,125 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"0chain.net/core/mockchain"
+	"0chain.net/core/transaction"
+
+	"github.com/stretchr/testify/require"
+)
+
+// flowTimeout bounds the wait for the workers of the blobber to submit a
+// transaction, their rounds being of a second in the harness.
+const flowTimeout = time.Minute
+
+func TestBlobberAllocation(t *testing.T) {
+	h := Start(t)
+
+	blobber := h.Chain.GetBlobber(h.Blobber.ID)
+	require.Equal(t, h.Blobber.URL, blobber.BaseURL)
+	require.NotNil(t, h.Chain.GetValidator(h.Validator.ID))
+
+	sa, err := h.Chain.NewAllocation(&transaction.StorageAllocation{
+		OwnerID: "owner", OwnerPublicKey: "owner_key", Size: 1 << 30,
+	})
+	require.NoError(t, err)
+	require.Len(t, sa.Blobbers, 1)
+
+	// the blobber verifies the allocation transaction on the chain
+	resp, err := http.Get(h.Blobber.URL + "/allocation?id=" + sa.ID)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var alloc struct {
+		ID, Tx, OwnerID string
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&alloc))
+	require.Equal(t, sa.ID, alloc.Tx)
+	require.Equal(t, "owner", alloc.OwnerID)
+}
+
+// commitFile uploads and commits the data as the only file of the
+// allocation, and waits for the write marker to be redeemed on the chain.
+func commitFile(t *testing.T, h *Harness, c *Client, sa *transaction.StorageAllocation,
+	path string, data []byte) *CommitResult {
+
+	upload := c.Upload(sa, "connection", path, data)
+	require.EqualValues(t, len(data), upload.Size)
+	commit := c.Commit(sa, "connection", path, data, upload, "")
+	require.Eventually(t, func() bool {
+		return h.Chain.AllocationRoot(sa.ID, h.Blobber.ID) == commit.WriteMarker.AllocationRoot
+	}, flowTimeout, time.Second, "write marker not redeemed")
+	return commit
+}
+
+// requireTransaction checks the only transaction of the function was sent
+// by the blobber and succeeded.
+func requireTransaction(t *testing.T, h *Harness, name string) *mockchain.Block {
+	blocks := h.Chain.Transactions(name)
+	require.Len(t, blocks, 1, name)
+	require.Equal(t, h.Blobber.ID, blocks[0].Txn.ClientID)
+	require.Equal(t, mockchain.TxnSuccess, blocks[0].TxnStatus, blocks[0].Txn.TransactionOutput)
+	return blocks[0]
+}
+
+func TestUploadCommitRedeem(t *testing.T) {
+	h := Start(t)
+	c := h.NewClient()
+	sa := c.NewAllocation(1 << 30)
+
+	data := bytes.Repeat([]byte("e2e "), 1024)
+	commit := commitFile(t, h, c, sa, "/file.txt", data)
+
+	b := requireTransaction(t, h, transaction.CLOSE_CONNECTION_SC_NAME)
+	require.Contains(t, b.Txn.TransactionOutput, commit.WriteMarker.AllocationRoot)
+	onChain, err := h.Chain.GetAllocation(sa.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, len(data), onChain.UsedSize)
+}
+
+func TestReadRedeem(t *testing.T) {
+	h := Start(t)
+	c := h.NewClient()
+	sa := c.NewAllocation(1 << 30)
+
+	data := bytes.Repeat([]byte("e2e "), 1024)
+	commitFile(t, h, c, sa, "/file.txt", data)
+	require.Equal(t, data, c.Download(sa, "/file.txt", 1, 1))
+
+	require.Eventually(t, func() bool {
+		rm := h.Chain.GetLatestReadMarker(h.Blobber.ID, c.ID)
+		return rm != nil && rm.ReadCounter == 1
+	}, flowTimeout, time.Second, "read marker not redeemed")
+	requireTransaction(t, h, transaction.READ_REDEEM)
+	pools := h.Chain.GetReadPools(c.ID, sa.ID, h.Blobber.ID)
+	require.Len(t, pools, 1)
+	require.Less(t, pools[0].Balance, int64(poolBalance), "the read is paid")
+}
+
+func TestChallengeResponse(t *testing.T) {
+	h := Start(t)
+	c := h.NewClient()
+	sa := c.NewAllocation(1 << 30)
+
+	data := bytes.Repeat([]byte("e2e "), 32*1024) // two blocks
+	commitFile(t, h, c, sa, "/file.txt", data)
+
+	ch, err := h.Chain.NewChallenge(sa.ID, h.Blobber.ID)
+	require.NoError(t, err)
+	require.Equal(t, h.Validator.ID, ch.Validators[0].ID)
+	require.Eventually(t, func() bool {
+		return h.Chain.GetChallenge(ch.ID).Responded
+	}, flowTimeout, time.Second, "challenge not answered")
+
+	ch = h.Chain.GetChallenge(ch.ID)
+	require.True(t, ch.Passed, "the validator passed the blobber")
+	b := requireTransaction(t, h, transaction.CHALLENGE_RESPONSE)
+	require.Equal(t, ch.ResponseTxn, b.Txn.Hash)
+	require.Contains(t, b.Txn.TransactionData, h.Validator.ID, "the ticket of the validator")
+}
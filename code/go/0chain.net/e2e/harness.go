@@ -0,0 +1,374 @@
+// Package e2e boots a blobber and a validator against the mock chain of
+// core/mockchain and a Postgres database, for tests exercising the nodes
+// end to end without a 0chain network.
+//
+// The tests are skipped unless E2E_DB_HOST is set. The database user
+// (E2E_DB_USER, default postgres, with E2E_DB_PASSWORD) must be allowed to
+// create databases; every harness runs on a database of its own, created
+// from the scripts of the sql directory and dropped on cleanup. The schema
+// relies on Postgres (ltree, plpgsql), so there is no embedded database
+// option.
+package e2e
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"0chain.net/core/config"
+	"0chain.net/core/logging"
+	"0chain.net/core/mockchain"
+
+	"github.com/0chain/gosdk/core/zcncrypto"
+	"github.com/spf13/viper"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// bootTimeout bounds the wait for the nodes to register on the chain. The
+// blobber waits for the database and gosdk for the confirmations, both in
+// steps of seconds.
+const bootTimeout = 2 * time.Minute
+
+// Node is a blobber or a validator process.
+type Node struct {
+	ID         string
+	PublicKey  string
+	PrivateKey string
+	URL        string
+	Dir        string
+
+	cmd    *exec.Cmd
+	exited chan struct{}
+}
+
+// Harness is a running mock chain with a blobber and a validator.
+type Harness struct {
+	Chain     *mockchain.Chain
+	ChainURL  string
+	Blobber   *Node
+	Validator *Node
+	DBName    string
+
+	t       testing.TB
+	dir     string
+	srcDir  string
+	db      dbConfig
+	servers []*httptest.Server
+}
+
+type dbConfig struct {
+	Host, Port, User, Password string
+}
+
+func (c dbConfig) dsn(name string) string {
+	return fmt.Sprintf("host=%v port=%v user=%v dbname=%v password=%v sslmode=disable",
+		c.Host, c.Port, c.User, name, c.Password)
+}
+
+func getenv(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+// Start boots the harness, skipping the test if no database is configured.
+// Everything is torn down when the test ends.
+func Start(t testing.TB) *Harness {
+	host := os.Getenv("E2E_DB_HOST")
+	if host == "" {
+		t.Skip("E2E_DB_HOST is not set")
+	}
+
+	_, file, _, _ := runtime.Caller(0)
+	h := &Harness{
+		t:      t,
+		dir:    t.TempDir(),
+		srcDir: filepath.Dir(filepath.Dir(file)),
+		db: dbConfig{
+			Host:     host,
+			Port:     getenv("E2E_DB_PORT", "5432"),
+			User:     getenv("E2E_DB_USER", "postgres"),
+			Password: os.Getenv("E2E_DB_PASSWORD"),
+		},
+		DBName: "blobber_e2e_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+	}
+	t.Cleanup(h.stop)
+
+	h.createDatabase()
+
+	logging.InitLogging("development", h.dir, "mockchain.log")
+	config.Configuration.SignatureScheme = "bls0chain"
+	h.Chain = mockchain.New(mockchain.Config{
+		ChainID:          "0afc093ffb509f059c55478bc1a60351cef7b4e9c008a53a6cc8241ca8617dfe",
+		VerifySignatures: true,
+	})
+	server := httptest.NewServer(h.Chain.Handler())
+	h.servers = append(h.servers, server)
+	h.ChainURL = server.URL
+
+	h.Blobber = h.startBlobber()
+	h.Validator = h.startValidator()
+	h.waitRegistered()
+	return h
+}
+
+func (h *Harness) fatalf(format string, args ...interface{}) {
+	h.t.Helper()
+	h.t.Fatalf(format, args...)
+}
+
+// createDatabase and apply the schema migrations to it.
+func (h *Harness) createDatabase() {
+	admin, err := gorm.Open(postgres.Open(h.db.dsn("postgres")), &gorm.Config{})
+	if err != nil {
+		h.fatalf("connecting to postgres: %v", err)
+	}
+	defer closeDB(admin)
+	if err := admin.Exec("CREATE DATABASE " + h.DBName).Error; err != nil {
+		h.fatalf("creating database: %v", err)
+	}
+	// the scripts grant the privileges to the blobber user
+	err = admin.Exec(`DO $$ BEGIN
+		IF NOT EXISTS (SELECT FROM pg_roles WHERE rolname = 'blobber_user') THEN
+			CREATE ROLE blobber_user LOGIN;
+		END IF; END $$`).Error
+	if err != nil {
+		h.fatalf("creating blobber_user: %v", err)
+	}
+
+	db, err := gorm.Open(postgres.Open(h.db.dsn(h.DBName)), &gorm.Config{})
+	if err != nil {
+		h.fatalf("connecting to %v: %v", h.DBName, err)
+	}
+	defer closeDB(db)
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS ltree").Error; err != nil {
+		h.fatalf("creating ltree extension: %v", err)
+	}
+
+	scripts, err := filepath.Glob(filepath.Join(h.srcDir, "..", "..", "..", "sql", "*.sql"))
+	if err != nil || len(scripts) == 0 {
+		h.fatalf("no sql scripts found: %v", err)
+	}
+	sort.Strings(scripts)
+	for _, script := range scripts {
+		if strings.HasPrefix(filepath.Base(script), "00-") {
+			continue // creates the production database and user
+		}
+		data, err := ioutil.ReadFile(script)
+		if err != nil {
+			h.fatalf("reading %v: %v", script, err)
+		}
+		var lines []string
+		for _, line := range strings.Split(string(data), "\n") {
+			if !strings.HasPrefix(strings.TrimSpace(line), `\`) {
+				lines = append(lines, line)
+			}
+		}
+		if err := db.Exec(strings.Join(lines, "\n")).Error; err != nil {
+			h.fatalf("applying %v: %v", filepath.Base(script), err)
+		}
+	}
+}
+
+func closeDB(db *gorm.DB) {
+	if sqldb, err := db.DB(); err == nil {
+		sqldb.Close()
+	}
+}
+
+func (h *Harness) dropDatabase() {
+	admin, err := gorm.Open(postgres.Open(h.db.dsn("postgres")), &gorm.Config{})
+	if err != nil {
+		h.t.Logf("connecting to postgres: %v", err)
+		return
+	}
+	defer closeDB(admin)
+	if err := admin.Exec("DROP DATABASE IF EXISTS " + h.DBName).Error; err != nil {
+		h.t.Logf("dropping database %v: %v", h.DBName, err)
+	}
+}
+
+func (h *Harness) stop() {
+	for _, n := range []*Node{h.Blobber, h.Validator} {
+		if n != nil && n.exited != nil {
+			n.cmd.Process.Kill() //nolint:errcheck
+			<-n.exited
+		}
+	}
+	for _, s := range h.servers {
+		s.Close()
+	}
+	h.dropDatabase()
+}
+
+func freePort(t testing.TB) int {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("finding a free port: %v", err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// build the command of the package into the harness directory.
+func (h *Harness) build(pkg string) string {
+	bin := filepath.Join(h.dir, "bin", pkg)
+	cmd := exec.Command("go", "build", "-o", bin, "./"+pkg)
+	cmd.Dir = h.srcDir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		h.fatalf("building %v: %v\n%s", pkg, err, out)
+	}
+	return bin
+}
+
+// newNode generates the keys of a node and prepares its working directory,
+// with the keys file and the configuration file from the config directory
+// of the repository, overridden by the settings given.
+func (h *Harness) newNode(name, configName string, port int, settings map[string]interface{}) *Node {
+	wallet, err := zcncrypto.NewSignatureScheme("bls0chain").GenerateKeys()
+	if err != nil {
+		h.fatalf("generating %v keys: %v", name, err)
+	}
+	n := &Node{
+		ID:         wallet.ClientID,
+		PublicKey:  wallet.Keys[0].PublicKey,
+		PrivateKey: wallet.Keys[0].PrivateKey,
+		URL:        fmt.Sprintf("http://localhost:%v", port),
+		Dir:        filepath.Join(h.dir, name),
+	}
+	for _, dir := range []string{"config", "log", "files", "data"} {
+		if err := os.MkdirAll(filepath.Join(n.Dir, dir), 0700); err != nil {
+			h.fatalf("creating %v directories: %v", name, err)
+		}
+	}
+
+	keys := fmt.Sprintf("%v\n%v\nlocalhost\n%v\n", n.PublicKey, n.PrivateKey, port)
+	if err := ioutil.WriteFile(filepath.Join(n.Dir, "keys.txt"), []byte(keys), 0600); err != nil {
+		h.fatalf("writing %v keys: %v", name, err)
+	}
+
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(h.srcDir, "..", "..", "..", "config", configName+".yaml"))
+	if err := v.ReadInConfig(); err != nil {
+		h.fatalf("reading %v config: %v", name, err)
+	}
+	v.Set("block_worker", h.ChainURL)
+	for key, value := range settings {
+		v.Set(key, value)
+	}
+	if err := v.WriteConfigAs(filepath.Join(n.Dir, "config", configName+".yaml")); err != nil {
+		h.fatalf("writing %v config: %v", name, err)
+	}
+	return n
+}
+
+// launch the node binary in its working directory, where it finds its
+// configuration.
+func (h *Harness) launch(n *Node, bin string, args ...string) {
+	logFile, err := os.Create(filepath.Join(n.Dir, "log", "stdout.log"))
+	if err != nil {
+		h.fatalf("creating log file: %v", err)
+	}
+	n.cmd = exec.Command(bin, args...)
+	n.cmd.Dir = n.Dir
+	n.cmd.Stdout = logFile
+	n.cmd.Stderr = logFile
+	if err := n.cmd.Start(); err != nil {
+		h.fatalf("starting %v: %v", bin, err)
+	}
+	n.exited = make(chan struct{})
+	go func() {
+		n.cmd.Wait() //nolint:errcheck
+		logFile.Close()
+		close(n.exited)
+	}()
+}
+
+func (h *Harness) startBlobber() *Node {
+	bin := h.build("blobber")
+	port, grpcPort := freePort(h.t), freePort(h.t)
+	n := h.newNode("blobber", "0chain_blobber", port, map[string]interface{}{
+		"db.host":     h.db.Host,
+		"db.port":     h.db.Port,
+		"db.name":     h.DBName,
+		"db.user":     h.db.User,
+		"db.password": h.db.Password,
+
+		"writemarker_redeem.frequency": 1,
+		"readmarker_redeem.frequency":  1,
+		"challenge_response.frequency": 1,
+	})
+	// minio is not started, its settings only have to be readable
+	minio := "http://localhost:9000\naccess\nsecret\nbucket\nus-east-1\n"
+	minioFile := filepath.Join(n.Dir, "minio.txt")
+	if err := ioutil.WriteFile(minioFile, []byte(minio), 0600); err != nil {
+		h.fatalf("writing minio config: %v", err)
+	}
+	h.launch(n, bin,
+		"--port", strconv.Itoa(port),
+		"--grpc_port", strconv.Itoa(grpcPort),
+		"--hostname", "localhost",
+		"--deployment_mode", "0",
+		"--keys_file", filepath.Join(n.Dir, "keys.txt"),
+		"--minio_file", minioFile,
+		"--files_dir", filepath.Join(n.Dir, "files"),
+		"--db_dir", filepath.Join(n.Dir, "data"),
+		"--log_dir", filepath.Join(n.Dir, "log"))
+	return n
+}
+
+func (h *Harness) startValidator() *Node {
+	bin := h.build("validator")
+	port := freePort(h.t)
+	n := h.newNode("validator", "0chain_validator", port, nil)
+	h.launch(n, bin,
+		"--port", strconv.Itoa(port),
+		"--hostname", "localhost",
+		"--deployment_mode", "0",
+		"--keys_file", filepath.Join(n.Dir, "keys.txt"),
+		"--log_dir", filepath.Join(n.Dir, "log"))
+	return n
+}
+
+// waitRegistered waits for both nodes to be up and registered on the chain.
+func (h *Harness) waitRegistered() {
+	deadline := time.Now().Add(bootTimeout)
+	for time.Now().Before(deadline) {
+		if h.Chain.GetBlobber(h.Blobber.ID) != nil &&
+			h.Chain.GetValidator(h.Validator.ID) != nil &&
+			h.ping(h.Blobber) && h.ping(h.Validator) {
+			return
+		}
+		for _, n := range []*Node{h.Blobber, h.Validator} {
+			select {
+			case <-n.exited:
+				h.fatalf("%v exited, see the logs in %v", n.Dir, filepath.Join(n.Dir, "log"))
+			default:
+			}
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	h.fatalf("nodes not registered after %v, see the logs in %v", bootTimeout, h.dir)
+}
+
+func (h *Harness) ping(n *Node) bool {
+	resp, err := http.Get(n.URL + "/")
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return true
+}
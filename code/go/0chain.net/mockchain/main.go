@@ -0,0 +1,47 @@
+// The mockchain command serves an in-memory stand-in of a 0chain network for
+// running blobbers and validators locally. Point their block_worker config at
+// it, e.g. block_worker: http://localhost:9091.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"0chain.net/core/config"
+	"0chain.net/core/logging"
+	. "0chain.net/core/logging"
+	"0chain.net/core/mockchain"
+
+	"go.uber.org/zap"
+)
+
+func main() {
+	portString := flag.String("port", "9091", "port")
+	logDir := flag.String("log_dir", ".", "log_dir")
+	chainID := flag.String("chain_id", "0afc093ffb509f059c55478bc1a60351cef7b4e9c008a53a6cc8241ca8617dfe", "chain_id")
+	signatureScheme := flag.String("signature_scheme", "bls0chain", "signature_scheme")
+	verifySignatures := flag.Bool("verify_signatures", true, "verify_signatures")
+
+	flag.Parse()
+
+	logging.InitLogging("development", *logDir, "mockchain.log")
+	config.Configuration.ChainID = *chainID
+	config.Configuration.SignatureScheme = *signatureScheme
+
+	mc := mockchain.New(mockchain.Config{
+		ChainID:          *chainID,
+		VerifySignatures: *verifySignatures,
+	})
+
+	server := &http.Server{
+		Addr:              ":" + *portString,
+		ReadHeaderTimeout: 30 * time.Second,
+		MaxHeaderBytes:    1 << 20,
+		Handler:           mc.Handler(),
+	}
+	Logger.Info("Starting the mock chain", zap.String("port", *portString),
+		zap.String("chain_id", *chainID))
+	log.Fatal(server.ListenAndServe())
+}
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"runtime"
@@ -17,6 +18,7 @@ import (
 	"0chain.net/core/encryption"
 	"0chain.net/core/logging"
 	. "0chain.net/core/logging"
+	"0chain.net/core/metrics"
 	"0chain.net/core/node"
 	"0chain.net/core/transaction"
 	"0chain.net/core/util"
@@ -34,15 +36,23 @@ var startTime time.Time
 var serverChain *chain.Chain
 
 func initHandlers(r *mux.Router) {
+	r.Use(metrics.Middleware)
 	r.HandleFunc("/", HomePageHandler)
+	r.Handle("/metrics", metrics.Handler())
 	storage.SetupHandlers(r)
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == verifyChallengeCmd {
+		verifyChallenge(os.Args[2:])
+		return
+	}
+
 	deploymentMode := flag.Int("deployment_mode", 2, "deployment_mode")
 	keysFile := flag.String("keys_file", "", "keys_file")
 	logDir := flag.String("log_dir", "", "log_dir")
 	portString := flag.String("port", "", "port")
+	grpcPortString := flag.String("grpc_port", "", "grpc_port")
 	hostname := flag.String("hostname", "", "hostname")
 
 	flag.Parse()
@@ -72,6 +82,13 @@ func main() {
 	config.Configuration.NumDelegates = viper.GetInt("num_delegates")
 	config.Configuration.ServiceCharge = viper.GetFloat64("service_charge")
 
+	config.Configuration.BatchMaxSize = viper.GetInt("batch.max_size")
+	config.Configuration.BatchNumWorkers = viper.GetInt("batch.num_workers")
+	config.Configuration.BatchCacheTTL = viper.GetInt64("batch.cache_ttl")
+	config.Configuration.TicketStorePath = viper.GetString("ticket_store.path")
+	config.Configuration.TicketStoreMaxAge = viper.GetInt64("ticket_store.max_age")
+	config.Configuration.TicketStoreMaxTickets = viper.GetInt("ticket_store.max_tickets")
+
 	if *hostname == "" {
 		panic("Please specify --hostname which is the public hostname")
 	}
@@ -95,6 +112,16 @@ func main() {
 		return
 	}
 
+	// the gRPC service is optional, the blobbers use it if advertised
+	if *grpcPortString != "" {
+		grpcPort, err := strconv.Atoi(*grpcPortString)
+		if err != nil {
+			Logger.Panic("gRPC port specified is not Int " + *grpcPortString)
+			return
+		}
+		config.Configuration.GRPCPort = grpcPort
+	}
+
 	node.Self.SetHostURL(*hostname, port)
 	Logger.Info(" Base URL" + node.Self.GetURLBase())
 
@@ -115,6 +142,15 @@ func main() {
 
 	chain.SetServerChain(serverChain)
 
+	if path := config.Configuration.TicketStorePath; path != "" {
+		if err := storage.SetupTicketStore(path); err != nil {
+			Logger.Panic("Error opening the ticket store", zap.String("path", path), zap.Error(err))
+		}
+		go storage.PruneTickets(common.GetRootContext())
+	} else {
+		Logger.Warn("The validation tickets issued are not kept")
+	}
+
 	if err := SetupValidatorOnBC(*logDir); err != nil {
 		Logger.Info("error setting up validator on blockchain", zap.Any("err", err))
 	}
@@ -152,11 +188,23 @@ func main() {
 	}
 	common.HandleShutdown(server)
 
-	common.ConfigRateLimits()
+	rl := common.ConfigRateLimits()
 	initHandlers(r)
 
+	grpcServer := storage.NewServerWithMiddlewares(rl)
+	storage.RegisterGRPCServices(r, grpcServer)
+
 	Logger.Info("Ready to listen to the requests")
 	startTime = time.Now().UTC()
+	if config.Configuration.GRPCPort > 0 {
+		go func(grpcPort int) {
+			lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
+			if err != nil {
+				log.Fatalf("failed to listen: %v", err)
+			}
+			log.Fatal(grpcServer.Serve(lis))
+		}(config.Configuration.GRPCPort)
+	}
 	log.Fatal(server.ListenAndServe())
 }
 
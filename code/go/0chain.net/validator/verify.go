@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	coreconfig "0chain.net/core/config"
+	"0chain.net/core/logging"
+	"0chain.net/validatorcore/storage"
+)
+
+const verifyChallengeCmd = "verify-challenge"
+
+// verifyChallenge verifies a challenge request captured from a blobber
+// against the challenge and the allocation of the chain, read from JSON
+// files, and prints the checks done up to the one failed. It exits with 1
+// if the challenge fails and 2 if it can't be verified.
+//
+//	validator verify-challenge --request file --challenge file --allocation file [--json] [--output file]
+func verifyChallenge(args []string) {
+	fs := flag.NewFlagSet(verifyChallengeCmd, flag.ExitOnError)
+	requestFile := fs.String("request", "", "challenge request sent by the blobber")
+	challengeFile := fs.String("challenge", "", "challenge of the chain, as /getchallenge returns it")
+	allocationFile := fs.String("allocation", "", "allocation of the chain, as the output of its transaction")
+	scheme := fs.String("signature_scheme", "bls0chain", "signature scheme of the write markers")
+	asJSON := fs.Bool("json", false, "write the trace as JSON")
+	output := fs.String("output", "", "trace file, stdout if empty")
+	logDir := fs.String("log_dir", os.TempDir(), "log_dir")
+	fs.Parse(args) //nolint:errcheck // exits on error
+
+	fail := func(err error) {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", verifyChallengeCmd, err)
+		os.Exit(2)
+	}
+	if *requestFile == "" || *challengeFile == "" || *allocationFile == "" {
+		fail(fmt.Errorf("--request, --challenge and --allocation are required"))
+	}
+
+	logging.InitLogging("production", *logDir, "validatorVerify.log")
+	coreconfig.Configuration.SignatureScheme = *scheme
+
+	var (
+		request    storage.ChallengeRequest
+		challenge  storage.Challenge
+		allocation storage.Allocation
+	)
+	for _, in := range []struct {
+		file string
+		v    interface{}
+	}{{*requestFile, &request}, {*challengeFile, &challenge},
+		{*allocationFile, &allocation}} {
+
+		data, err := ioutil.ReadFile(in.file)
+		if err != nil {
+			fail(err)
+		}
+		if err = json.Unmarshal(data, in.v); err != nil {
+			fail(fmt.Errorf("decoding %s: %v", in.file, err))
+		}
+	}
+
+	trace := request.TraceChallenge(&challenge, &allocation)
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			fail(err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if *asJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(trace); err != nil {
+			fail(err)
+		}
+	} else {
+		trace.Print(w)
+	}
+
+	if !trace.OK {
+		os.Exit(1)
+	}
+}
@@ -14,6 +14,12 @@ func SetupDefaultConfig() {
 	viper.SetDefault("min_stake", 1.0)
 	viper.SetDefault("max_stake", 100.0)
 	viper.SetDefault("num_delegates", 100)
+	viper.SetDefault("batch.max_size", 20)
+	viper.SetDefault("batch.num_workers", 4)
+	viper.SetDefault("batch.cache_ttl", 60)
+	viper.SetDefault("ticket_store.path", "data/validator_tickets.db")
+	viper.SetDefault("ticket_store.max_age", 720)
+	viper.SetDefault("ticket_store.max_tickets", 1000000)
 }
 
 /*SetupConfig - setup the configuration system */
@@ -45,6 +51,24 @@ type Config struct {
 	NumDelegates int `json:"num_delegates"`
 	// ServiceCharge of related blobber.
 	ServiceCharge float64 `json:"service_charge"`
+	// BatchMaxSize is the number of challenges a batch request can hold.
+	BatchMaxSize int `json:"batch_max_size"`
+	// BatchNumWorkers is the number of challenges of a batch verified at
+	// the same time.
+	BatchNumWorkers int `json:"batch_num_workers"`
+	// BatchCacheTTL is how long the challenges and allocations of the chain
+	// are kept for the batches, in seconds.
+	BatchCacheTTL int64 `json:"batch_cache_ttl"`
+	// TicketStorePath is the file of the tickets issued, none kept if empty.
+	TicketStorePath string `json:"ticket_store_path"`
+	// TicketStoreMaxAge is how long the tickets issued are kept, in hours,
+	// all kept if 0.
+	TicketStoreMaxAge int64 `json:"ticket_store_max_age"`
+	// TicketStoreMaxTickets is the most tickets kept, the oldest pruned
+	// first, no limit if 0.
+	TicketStoreMaxTickets int `json:"ticket_store_max_tickets"`
+	// GRPCPort is the port of the Validator gRPC service, 0 if not served.
+	GRPCPort int `json:"grpc_port"`
 }
 
 /*Configuration of the system */
@@ -0,0 +1,3 @@
+#!/usr/bin/env bash
+
+protoc -I ./validatorgrpc/proto --go-grpc_out=. --go_out=. --grpc-gateway_out=. --openapiv2_out=./openapi ./validatorgrpc/proto/validator.proto
\ No newline at end of file
@@ -0,0 +1,191 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"net/http"
+
+	. "0chain.net/core/logging"
+
+	"go.uber.org/zap"
+)
+
+var funcMap = template.FuncMap{
+	"percent": func(rate float64) string {
+		return fmt.Sprintf("%.1f%%", rate*100)
+	},
+}
+
+const tpl = `<!DOCTYPE html>
+<html>
+  <head>
+    <title>Validator Diagnostics</title>
+  </head>
+  <body>
+    <h1>
+      Validator Stats
+    </h1>
+    <table border="1">
+      <tr>
+        <td>ID</td>
+        <td>{{ .ID }}</td>
+      </tr>
+      <tr>
+        <td>PublicKey</td>
+        <td>{{ .PublicKey }}</td>
+      </tr>
+      <tr>
+        <td>Started</td>
+        <td>{{ .Started }}</td>
+      </tr>
+      <tr>
+        <td>Tickets issued</td>
+        <td>{{ .Tickets }}</td>
+      </tr>
+      <tr>
+        <td>Passed Challenges</td>
+        <td>{{ .Passed }}</td>
+      </tr>
+      <tr>
+        <td>Failed Challenges</td>
+        <td>{{ .Failed }}</td>
+      </tr>
+      <tr>
+        <td>Requests answered with an error</td>
+        <td>{{ .Errors }}</td>
+      </tr>
+      <tr>
+        <table>
+          <tr><th colspan="2">Failures by message code</th></tr>
+          {{ range $code, $n := .FailuresByCode }}
+          <tr><td>{{ $code }}</td><td>{{ $n }}</td></tr>
+          {{ else }}
+          <tr><td colspan="2">No challenge failed yet.</td></tr>
+          {{ end }}
+        </table>
+      </tr>
+      <tr>
+        <table>
+          <tr><th colspan="2">Errors by code</th></tr>
+          {{ range $code, $n := .ErrorsByCode }}
+          <tr><td>{{ $code }}</td><td>{{ $n }}</td></tr>
+          {{ else }}
+          <tr><td colspan="2">No error yet.</td></tr>
+          {{ end }}
+        </table>
+      </tr>
+      <tr>
+        <table>
+          <tr><th colspan="5">Chain lookups</th></tr>
+          <tr>
+            <td>Kind</td>
+            <td>Lookups</td>
+            <td>Failures</td>
+            <td>Avg latency</td>
+            <td>Max latency</td>
+          </tr>
+          {{ range .ChainLookups }}
+          <tr>
+            <td>{{ .Kind }}</td>
+            <td>{{ .Lookups }}</td>
+            <td>{{ .Failures }}</td>
+            <td>{{ .AvgLatency }}</td>
+            <td>{{ .MaxLatency }}</td>
+          </tr>
+          {{ else }}
+          <tr><td colspan="5">No chain lookup yet.</td></tr>
+          {{ end }}
+        </table>
+      </tr>
+      <tr>
+        <table>
+          <tr><th colspan="4">Caches</th></tr>
+          <tr>
+            <td>Name</td>
+            <td>Hits</td>
+            <td>Misses</td>
+            <td>Hit rate</td>
+          </tr>
+          {{ range .Caches }}
+          <tr>
+            <td>{{ .Name }}</td>
+            <td>{{ .Hits }}</td>
+            <td>{{ .Misses }}</td>
+            <td>{{ percent .HitRate }}</td>
+          </tr>
+          {{ else }}
+          <tr><td colspan="4">No cache lookup yet.</td></tr>
+          {{ end }}
+        </table>
+      </tr>
+    </table>
+
+    <h1>
+      Blobber Stats
+    </h1>
+    <table border="1">
+      <tr>
+        <td>ID</td>
+        <td>Tickets</td>
+        <td>Passed</td>
+        <td>Failed</td>
+        <td>Failure rate</td>
+        <td>Last failure</td>
+      </tr>
+      {{ range .Blobbers }}
+      <tr>
+        <td>{{ .ID }}</td>
+        <td>{{ .Tickets }}</td>
+        <td>{{ .Passed }}</td>
+        <td>{{ .Failed }}</td>
+        <td>{{ percent .FailureRate }}</td>
+        <td>{{ .LastFailure }}</td>
+      </tr>
+      {{ else }}
+      <tr><td colspan="6">No ticket issued yet.</td></tr>
+      {{ end }}
+    </table>
+
+    <h1>
+      Recent Failures
+    </h1>
+    <table border="1">
+      <tr>
+        <td>Time</td>
+        <td>Blobber</td>
+        <td>Challenge</td>
+        <td>Ticket</td>
+        <td>Code</td>
+        <td>Message</td>
+      </tr>
+      {{ range .RecentFailures }}
+      <tr>
+        <td>{{ .Time }}</td>
+        <td>{{ .BlobberID }}</td>
+        <td>{{ .ChallengeID }}</td>
+        <td>{{ .Ticket }}</td>
+        <td>{{ .Code }}</td>
+        <td>{{ .Message }}</td>
+      </tr>
+      {{ else }}
+      <tr><td colspan="6">No failure yet.</td></tr>
+      {{ end }}
+    </table>
+  </body>
+</html>
+`
+
+var statsTemplate = template.Must(template.New("diagnostics").Funcs(funcMap).Parse(tpl))
+
+// StatsHandler serves the stats of the validator as a page.
+func StatsHandler(w http.ResponseWriter, r *http.Request) {
+	if err := statsTemplate.Execute(w, GetValidatorStats()); err != nil {
+		Logger.Error("Error in executing the template", zap.Error(err))
+	}
+}
+
+// StatsJSONHandler serves the stats of the validator.
+func StatsJSONHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	return GetValidatorStats(), nil
+}
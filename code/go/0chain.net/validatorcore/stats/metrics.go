@@ -0,0 +1,30 @@
+package stats
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	ticketsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_tickets_total",
+		Help: "Validation tickets issued, by outcome (passed or failed) and message code of the failures.",
+	}, []string{"outcome", "code"})
+	errorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_request_errors_total",
+		Help: "Requests answered with an error rather than a ticket, by error code.",
+	}, []string{"code"})
+	chainLookupDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "validator_chain_lookup_duration_seconds",
+		Help:    "Time looking objects up on the chain, by kind, the failed lookups included.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"kind"})
+	chainLookupFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_chain_lookup_failures_total",
+		Help: "Failed lookups of objects on the chain, by kind.",
+	}, []string{"kind"})
+	cacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_cache_lookups_total",
+		Help: "Lookups in the caches of the validator, by cache and result (hit or miss).",
+	}, []string{"cache", "result"})
+)
@@ -0,0 +1,259 @@
+package stats
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"0chain.net/core/common"
+	"0chain.net/core/node"
+)
+
+// maxRecentFailures is the number of the latest failures kept
+const maxRecentFailures = 50
+
+// the chain lookups of a challenge
+const (
+	ChallengeLookup  = "challenge"
+	AllocationLookup = "allocation"
+)
+
+// BlobberStats are the tickets issued to a blobber.
+type BlobberStats struct {
+	ID          string           `json:"id"`
+	Tickets     int64            `json:"tickets"`
+	Passed      int64            `json:"passed"`
+	Failed      int64            `json:"failed"`
+	FailureRate float64          `json:"failure_rate"`
+	LastFailure common.Timestamp `json:"last_failure,omitempty"`
+}
+
+// LookupStats are the lookups of a kind of object on the chain.
+type LookupStats struct {
+	Kind     string `json:"kind"`
+	Lookups  int64  `json:"lookups"`
+	Failures int64  `json:"failures"`
+	// AvgLatency is of all the lookups, the failed ones included
+	AvgLatency time.Duration `json:"avg_latency"`
+	MaxLatency time.Duration `json:"max_latency"`
+
+	totalLatency time.Duration
+}
+
+// CacheStats are the hits of a cache of the validator.
+type CacheStats struct {
+	Name    string  `json:"name"`
+	Hits    int64   `json:"hits"`
+	Misses  int64   `json:"misses"`
+	HitRate float64 `json:"hit_rate"`
+}
+
+// Failure is a challenge failed, or a request answered with an error rather
+// than a ticket.
+type Failure struct {
+	BlobberID   string           `json:"blobber_id,omitempty"`
+	ChallengeID string           `json:"challenge_id,omitempty"`
+	Ticket      bool             `json:"ticket"`
+	Code        string           `json:"code"`
+	Message     string           `json:"message"`
+	Time        common.Timestamp `json:"time"`
+}
+
+// ValidatorStats are the outcomes of the challenges validated since the
+// validator started.
+type ValidatorStats struct {
+	ID        string           `json:"id"`
+	PublicKey string           `json:"public_key"`
+	Started   common.Timestamp `json:"started"`
+	// Tickets is the number of the tickets issued, passed or failed
+	Tickets int64 `json:"tickets"`
+	Passed  int64 `json:"passed"`
+	Failed  int64 `json:"failed"`
+	// Errors is the number of the requests answered without a ticket
+	Errors         int64            `json:"errors"`
+	FailuresByCode map[string]int64 `json:"failures_by_code"`
+	ErrorsByCode   map[string]int64 `json:"errors_by_code"`
+	Blobbers       []*BlobberStats  `json:"blobbers"`
+	ChainLookups   []*LookupStats   `json:"chain_lookups"`
+	Caches         []*CacheStats    `json:"caches"`
+	RecentFailures []*Failure       `json:"recent_failures"`
+}
+
+var validatorStats = struct {
+	sync.Mutex
+	started  common.Timestamp
+	stats    ValidatorStats
+	blobbers map[string]*BlobberStats
+	lookups  map[string]*LookupStats
+	caches   map[string]*CacheStats
+	failures []*Failure
+}{
+	started: common.Now(),
+	stats: ValidatorStats{
+		FailuresByCode: make(map[string]int64),
+		ErrorsByCode:   make(map[string]int64),
+	},
+	blobbers: make(map[string]*BlobberStats),
+	lookups:  make(map[string]*LookupStats),
+	caches:   make(map[string]*CacheStats),
+}
+
+func addFailure(f *Failure) {
+	vs := &validatorStats
+	vs.failures = append(vs.failures, f)
+	if over := len(vs.failures) - maxRecentFailures; over > 0 {
+		vs.failures = vs.failures[over:]
+	}
+}
+
+// RecordTicket accounts a ticket issued to the blobber for the challenge,
+// of the result and message code given.
+func RecordTicket(blobberID, challengeID string, passed bool, code,
+	message string) {
+
+	validatorStats.Lock()
+	defer validatorStats.Unlock()
+
+	s := &validatorStats.stats
+	bs, ok := validatorStats.blobbers[blobberID]
+	if !ok {
+		bs = &BlobberStats{ID: blobberID}
+		validatorStats.blobbers[blobberID] = bs
+	}
+	s.Tickets++
+	bs.Tickets++
+	if passed {
+		s.Passed++
+		bs.Passed++
+		ticketsTotal.WithLabelValues("passed", "").Inc()
+	} else {
+		ticketsTotal.WithLabelValues("failed", code).Inc()
+		s.Failed++
+		bs.Failed++
+		bs.LastFailure = common.Now()
+		s.FailuresByCode[code]++
+		addFailure(&Failure{BlobberID: blobberID, ChallengeID: challengeID,
+			Ticket: true, Code: code, Message: message, Time: bs.LastFailure})
+	}
+	bs.FailureRate = float64(bs.Failed) / float64(bs.Tickets)
+}
+
+// RecordError accounts a request of the blobber answered with the error
+// given instead of a ticket. The challenge is empty if not known.
+func RecordError(blobberID, challengeID string, err error) {
+	code := "internal_error"
+	if cerr, ok := err.(*common.Error); ok {
+		code = cerr.Code
+	}
+
+	validatorStats.Lock()
+	defer validatorStats.Unlock()
+
+	validatorStats.stats.Errors++
+	errorsTotal.WithLabelValues(code).Inc()
+	validatorStats.stats.ErrorsByCode[code]++
+	addFailure(&Failure{BlobberID: blobberID, ChallengeID: challengeID,
+		Code: code, Message: err.Error(), Time: common.Now()})
+}
+
+// RecordChainLookup accounts a lookup of the kind given on the chain, of the
+// latency given, failed if the error isn't nil.
+func RecordChainLookup(kind string, latency time.Duration, err error) {
+	validatorStats.Lock()
+	defer validatorStats.Unlock()
+
+	ls, ok := validatorStats.lookups[kind]
+	if !ok {
+		ls = &LookupStats{Kind: kind}
+		validatorStats.lookups[kind] = ls
+	}
+	ls.Lookups++
+	ls.totalLatency += latency
+	ls.AvgLatency = ls.totalLatency / time.Duration(ls.Lookups)
+	if latency > ls.MaxLatency {
+		ls.MaxLatency = latency
+	}
+	if err != nil {
+		ls.Failures++
+		chainLookupFailures.WithLabelValues(kind).Inc()
+	}
+	chainLookupDuration.WithLabelValues(kind).Observe(latency.Seconds())
+}
+
+// RecordCacheLookup accounts a lookup in the cache of the name given.
+func RecordCacheLookup(name string, hit bool) {
+	validatorStats.Lock()
+	defer validatorStats.Unlock()
+
+	cs, ok := validatorStats.caches[name]
+	if !ok {
+		cs = &CacheStats{Name: name}
+		validatorStats.caches[name] = cs
+	}
+	if hit {
+		cs.Hits++
+		cacheLookups.WithLabelValues(name, "hit").Inc()
+	} else {
+		cs.Misses++
+		cacheLookups.WithLabelValues(name, "miss").Inc()
+	}
+	cs.HitRate = float64(cs.Hits) / float64(cs.Hits+cs.Misses)
+}
+
+// Started returns when the validator started.
+func Started() common.Timestamp {
+	return validatorStats.started
+}
+
+// GetValidatorStats returns a copy of the stats of the validator, the
+// latest failure first.
+func GetValidatorStats() *ValidatorStats {
+	validatorStats.Lock()
+	defer validatorStats.Unlock()
+
+	s := validatorStats.stats
+	s.ID = node.Self.ID
+	s.PublicKey = node.Self.PublicKey
+	s.Started = Started()
+
+	s.FailuresByCode = make(map[string]int64, len(validatorStats.stats.FailuresByCode))
+	for code, n := range validatorStats.stats.FailuresByCode {
+		s.FailuresByCode[code] = n
+	}
+	s.ErrorsByCode = make(map[string]int64, len(validatorStats.stats.ErrorsByCode))
+	for code, n := range validatorStats.stats.ErrorsByCode {
+		s.ErrorsByCode[code] = n
+	}
+
+	s.Blobbers = make([]*BlobberStats, 0, len(validatorStats.blobbers))
+	for _, bs := range validatorStats.blobbers {
+		c := *bs
+		s.Blobbers = append(s.Blobbers, &c)
+	}
+	sort.Slice(s.Blobbers, func(i, j int) bool {
+		return s.Blobbers[i].ID < s.Blobbers[j].ID
+	})
+	s.ChainLookups = make([]*LookupStats, 0, len(validatorStats.lookups))
+	for _, ls := range validatorStats.lookups {
+		c := *ls
+		s.ChainLookups = append(s.ChainLookups, &c)
+	}
+	sort.Slice(s.ChainLookups, func(i, j int) bool {
+		return s.ChainLookups[i].Kind < s.ChainLookups[j].Kind
+	})
+	s.Caches = make([]*CacheStats, 0, len(validatorStats.caches))
+	for _, cs := range validatorStats.caches {
+		c := *cs
+		s.Caches = append(s.Caches, &c)
+	}
+	sort.Slice(s.Caches, func(i, j int) bool {
+		return s.Caches[i].Name < s.Caches[j].Name
+	})
+
+	s.RecentFailures = make([]*Failure, 0, len(validatorStats.failures))
+	for i := len(validatorStats.failures) - 1; i >= 0; i-- {
+		c := *validatorStats.failures[i]
+		s.RecentFailures = append(s.RecentFailures, &c)
+	}
+	return &s
+}
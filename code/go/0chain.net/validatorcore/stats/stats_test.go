@@ -0,0 +1,55 @@
+package stats
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"0chain.net/core/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorStats(t *testing.T) {
+	RecordTicket("blobber1", "c1", true, "success", "Challenge passed")
+	RecordTicket("blobber1", "c2", false, "invalid_write_marker", "bad marker")
+	RecordTicket("blobber2", "c3", true, "success", "Challenge passed")
+	RecordTicket("blobber1", "c4", true, "success", "Challenge passed")
+	RecordError("blobber2", "", common.NewError("invalid_parameters", "bad hash"))
+	RecordChainLookup(ChallengeLookup, 10*time.Millisecond, nil)
+	RecordChainLookup(ChallengeLookup, 30*time.Millisecond, common.NewError("invalid_challenge", "not found"))
+	RecordCacheLookup("tickets", false)
+	RecordCacheLookup("tickets", true)
+	RecordCacheLookup("tickets", true)
+	RecordCacheLookup("tickets", true)
+
+	s := GetValidatorStats()
+	require.EqualValues(t, 4, s.Tickets)
+	require.EqualValues(t, 3, s.Passed)
+	require.EqualValues(t, 1, s.Failed)
+	require.EqualValues(t, 1, s.Errors)
+	require.Equal(t, map[string]int64{"invalid_write_marker": 1}, s.FailuresByCode)
+	require.Equal(t, map[string]int64{"invalid_parameters": 1}, s.ErrorsByCode)
+
+	require.Len(t, s.Blobbers, 2)
+	require.Equal(t, "blobber1", s.Blobbers[0].ID)
+	require.InDelta(t, 1.0/3, s.Blobbers[0].FailureRate, 1e-9)
+	require.Zero(t, s.Blobbers[1].FailureRate)
+
+	require.Len(t, s.ChainLookups, 1)
+	require.Equal(t, 20*time.Millisecond, s.ChainLookups[0].AvgLatency)
+	require.Equal(t, 30*time.Millisecond, s.ChainLookups[0].MaxLatency)
+	require.EqualValues(t, 1, s.ChainLookups[0].Failures)
+	require.Len(t, s.Caches, 1)
+	require.Equal(t, 0.75, s.Caches[0].HitRate)
+
+	require.Len(t, s.RecentFailures, 2)
+	require.Equal(t, "invalid_parameters", s.RecentFailures[0].Code, "the latest first")
+	require.False(t, s.RecentFailures[0].Ticket)
+	require.Equal(t, "c2", s.RecentFailures[1].ChallengeID)
+
+	w := httptest.NewRecorder()
+	StatsHandler(w, httptest.NewRequest("GET", "/_stats", nil))
+	require.Contains(t, w.Body.String(), "invalid_write_marker")
+	require.Contains(t, w.Body.String(), "33.3%")
+}
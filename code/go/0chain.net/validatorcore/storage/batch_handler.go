@@ -0,0 +1,161 @@
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"0chain.net/core/common"
+	. "0chain.net/core/logging"
+	"0chain.net/validatorcore/config"
+	"0chain.net/validatorcore/stats"
+
+	"github.com/remeh/sizedwaitgroup"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/sha3"
+)
+
+// BatchChallengeRequest is the challenges of a blobber to verify at once,
+// each the body of a single challenge request.
+type BatchChallengeRequest struct {
+	Challenges []json.RawMessage `json:"challenges"`
+}
+
+// BatchChallengeResult is the ticket of a challenge of a batch, or the error
+// verifying it.
+type BatchChallengeResult struct {
+	ChallengeID string            `json:"challenge_id"`
+	Ticket      *ValidationTicket `json:"ticket,omitempty"`
+	Error       *common.Error     `json:"error,omitempty"`
+}
+
+// BatchChallengeResponse is the results of a batch, in the order of its
+// challenges.
+type BatchChallengeResponse struct {
+	Results []*BatchChallengeResult `json:"results"`
+}
+
+// batchChain is the chain of the batches, replaced by the tests.
+var batchChain chainLookup = new(cachedLookup)
+
+// checkBatchSize returns an error if a batch of n challenges can't be
+// verified.
+func checkBatchSize(n int) error {
+	if n == 0 {
+		return common.NewError("invalid_parameters", "No challenge in the batch")
+	}
+	if max := config.Configuration.BatchMaxSize; max > 0 && n > max {
+		return common.NewErrorf("invalid_parameters",
+			"Too many challenges in the batch, at most %d", max)
+	}
+	return nil
+}
+
+// validateBatch calls validate for each of the n challenges of a batch,
+// batch_num_workers at the same time.
+func validateBatch(n int, validate func(i int)) {
+	Logger.Info("Processing validation batch.", zap.Int("challenges", n))
+	numWorkers := config.Configuration.BatchNumWorkers
+	if numWorkers < 1 {
+		numWorkers = 1
+	}
+	swg := sizedwaitgroup.New(numWorkers)
+	for i := 0; i < n; i++ {
+		swg.Add()
+		go func(i int) {
+			defer swg.Done()
+			validate(i)
+		}(i)
+	}
+	swg.Wait()
+}
+
+// BatchChallengeHandler verifies the challenges of the batch concurrently,
+// the challenges and the allocations looked up on the chain once for all.
+func BatchChallengeHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	if r.Method == "GET" {
+		return nil, common.NewError("invalid_method", "Invalid method used for the batch URL. Use POST instead")
+	}
+	requestHash := r.Header.Get("X-App-Request-Hash")
+	h := sha3.New256()
+	tReader := io.TeeReader(r.Body, h)
+	var batch BatchChallengeRequest
+	if err := json.NewDecoder(tReader).Decode(&batch); err != nil {
+		return nil, common.NewError("input_decode_error", "Error in decoding the input."+err.Error())
+	}
+	if requestHash != hex.EncodeToString(h.Sum(nil)) {
+		return nil, common.NewError("invalid_parameters", "Header hash and request hash do not match")
+	}
+	if err := checkBatchSize(len(batch.Challenges)); err != nil {
+		return nil, err
+	}
+
+	resp := &BatchChallengeResponse{
+		Results: make([]*BatchChallengeResult, len(batch.Challenges)),
+	}
+	validateBatch(len(batch.Challenges), func(i int) {
+		resp.Results[i] = validateBatchItem(ctx, batch.Challenges[i])
+	})
+	return resp, nil
+}
+
+// validateBatchItem verifies a challenge of a batch the way a single
+// challenge request is, without waiting.
+func validateBatchItem(ctx context.Context, body json.RawMessage) *BatchChallengeResult {
+	result := new(BatchChallengeResult)
+	var challengeRequest ChallengeRequest
+	err := json.Unmarshal(body, &challengeRequest)
+	if err != nil {
+		err = common.NewError("input_decode_error", "Error in decoding the input."+err.Error())
+	} else {
+		result.ChallengeID = challengeRequest.ChallengeID
+		// the hash of the challenge is the one of its single request
+		h := sha3.New256()
+		h.Write(body) //nolint:errcheck // never returns an error anyway
+		result.Ticket, err = validateChallenge(ctx, &challengeRequest,
+			hex.EncodeToString(h.Sum(nil)))
+	}
+	if err != nil {
+		blobberID, _ := ctx.Value(CLIENT_CONTEXT_KEY).(string)
+		stats.RecordError(blobberID, result.ChallengeID, err)
+		if commError, ok := err.(*common.Error); ok {
+			result.Error = commError
+		} else {
+			result.Error = common.NewError("invalid_parameters", err.Error())
+		}
+	}
+	return result
+}
+
+// validateChallenge verifies the challenge request of the hash given and
+// returns its ticket, the one issued before for the same request if any.
+// The challenges and the allocations are looked up on the chain once for
+// the requests of batch_cache_ttl seconds.
+func validateChallenge(ctx context.Context, challengeRequest *ChallengeRequest,
+	challengeHash string) (*ValidationTicket, error) {
+
+	if challengeRequest.ObjPath == nil {
+		return nil, common.NewError("invalid_parameters", "Empty object path or merkle path")
+	}
+	if retVT, err := issuedTicket(challengeHash); err != nil {
+		Logger.Error("Error getting the ticket issued before", zap.Error(err))
+	} else if retVT != nil {
+		return retVT, nil
+	}
+
+	challengeObj, err := batchChain.VerifyChallengeTransaction(ctx, challengeRequest)
+	if err != nil {
+		Logger.Error("Error verifying the challenge from BC",
+			zap.Any("challenge_id", challengeRequest.ChallengeID),
+			zap.Error(err))
+		return nil, common.NewError("invalid_parameters", "Challenge could not be verified. "+err.Error())
+	}
+	allocationObj, err := batchChain.VerifyAllocationTransaction(ctx, challengeObj.AllocationID)
+	if err != nil {
+		Logger.Error("Error verifying the allocation from BC", zap.Any("allocation_id", challengeObj.AllocationID), zap.Error(err))
+		return nil, common.NewError("invalid_parameters", "Allocation could not be verified. "+err.Error())
+	}
+	return issueTicket(challengeRequest, challengeObj, allocationObj, challengeHash)
+}
@@ -0,0 +1,136 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"0chain.net/core/common"
+	coreconfig "0chain.net/core/config"
+	"0chain.net/core/encryption"
+	"0chain.net/core/logging"
+	"0chain.net/core/node"
+	"0chain.net/validatorcore/config"
+
+	"github.com/0chain/gosdk/core/zcncrypto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type countingChain struct {
+	sync.Mutex
+	challenges  int
+	allocations int
+}
+
+func (cc *countingChain) VerifyChallengeTransaction(ctx context.Context,
+	challengeRequest *ChallengeRequest) (*Challenge, error) {
+
+	cc.Lock()
+	cc.challenges++
+	cc.Unlock()
+	if challengeRequest.ChallengeID == "missing" {
+		return nil, common.NewError("invalid_challenge", "not found")
+	}
+	return &Challenge{ID: challengeRequest.ChallengeID, AllocationID: "alloc",
+		Blobber: &StorageNode{ID: "blobber"}}, nil
+}
+
+func (cc *countingChain) VerifyAllocationTransaction(ctx context.Context,
+	allocationID string) (*Allocation, error) {
+
+	cc.Lock()
+	cc.allocations++
+	cc.Unlock()
+	time.Sleep(10 * time.Millisecond) // the concurrent lookups wait for it
+	return &Allocation{ID: allocationID}, nil
+}
+
+func TestBatchChallengeHandler(t *testing.T) {
+	logging.Logger = zap.NewNop()
+	coreconfig.Configuration.SignatureScheme = "bls0chain"
+	config.Configuration.BatchMaxSize = 5
+	config.Configuration.BatchNumWorkers = 4
+	config.Configuration.BatchCacheTTL = 60
+	wallet, err := zcncrypto.NewSignatureScheme("bls0chain").GenerateKeys()
+	require.NoError(t, err)
+	node.Self.SetKeys(wallet.Keys[0].PublicKey, wallet.Keys[0].PrivateKey)
+
+	cc := new(countingChain)
+	defer func(chain chainLookup) { batchChain = chain }(batchChain)
+	batchChain = &cachedLookup{chain: cc}
+	challengeLookups, allocationLookups = newLookupCache("challenge"), newLookupCache("allocation")
+
+	post := func(challenges ...string) (interface{}, error) {
+		batch := BatchChallengeRequest{}
+		for _, c := range challenges {
+			batch.Challenges = append(batch.Challenges, json.RawMessage(c))
+		}
+		body, err := json.Marshal(batch)
+		require.NoError(t, err)
+		req, err := http.NewRequest("POST", "url", bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("X-App-Request-Hash", encryption.Hash(body))
+		ctx := context.WithValue(context.Background(), CLIENT_CONTEXT_KEY, "blobber")
+		return BatchChallengeHandler(ctx, req)
+	}
+	item := func(id string) string {
+		return `{"challenge_id":"` + id + `","object_path":{"root_hash":"x","path":{}}}`
+	}
+
+	resp, err := post(item("c1"), item("c2"), item("missing"), `{"challenge_id":"c3"}`)
+	require.NoError(t, err)
+	results := resp.(*BatchChallengeResponse).Results
+	require.Len(t, results, 4)
+
+	for _, r := range results[:2] {
+		require.Nil(t, r.Error)
+		require.NotNil(t, r.Ticket)
+		require.False(t, r.Ticket.Result)
+		require.Equal(t, "challenge_validation_failed", r.Ticket.MessageCode)
+		require.NotEmpty(t, r.Ticket.Signature)
+	}
+	require.Equal(t, "c1", results[0].Ticket.ChallengeID)
+	require.Equal(t, "missing", results[2].ChallengeID)
+	require.Nil(t, results[2].Ticket)
+	require.Equal(t, "invalid_parameters", results[2].Error.Code)
+	require.Equal(t, "c3", results[3].ChallengeID)
+	require.Contains(t, results[3].Error.Msg, "Empty object path")
+
+	require.Equal(t, 3, cc.challenges)
+	require.Equal(t, 1, cc.allocations, "the allocation looked up once")
+
+	// the challenges are kept, not the failed lookups
+	_, err = post(item("c1"), item("missing"))
+	require.NoError(t, err)
+	require.Equal(t, 4, cc.challenges)
+	require.Equal(t, 1, cc.allocations)
+
+	_, err = post(item("1"), item("2"), item("3"), item("4"), item("5"), item("6"))
+	require.Error(t, err)
+}
+
+func TestLookupCacheExpiry(t *testing.T) {
+	now := time.Now()
+	c := newLookupCache("test")
+	c.now = func() time.Time { return now }
+
+	calls := 0
+	fetch := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+	v, err := c.get("k", time.Minute, fetch)
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+	v, _ = c.get("k", time.Minute, fetch)
+	require.Equal(t, 1, v)
+
+	now = now.Add(time.Minute)
+	v, _ = c.get("k", time.Minute, fetch)
+	require.Equal(t, 2, v, "looked up again once expired")
+}
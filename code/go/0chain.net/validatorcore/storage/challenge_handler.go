@@ -12,6 +12,7 @@ import (
 	"0chain.net/core/common"
 	. "0chain.net/core/logging"
 	"0chain.net/core/node"
+	"0chain.net/validatorcore/stats"
 
 	"go.uber.org/zap"
 	"golang.org/x/crypto/sha3"
@@ -47,13 +48,12 @@ func ChallengeHandler(ctx context.Context, r *http.Request) (interface{}, error)
 	}
 
 	Logger.Info("Processing validation.", zap.Any("challenge_id", challengeRequest.ChallengeID))
-	vt, err := lru.Get(challengeHash)
-	retVT, ok := vt.(*ValidationTicket)
-	if vt != nil && err == nil && ok {
+	if retVT, err := issuedTicket(challengeHash); err != nil {
+		Logger.Error("Error getting the ticket issued before", zap.Error(err))
+	} else if retVT != nil {
 		return retVT, nil
 	}
 
-	var validationTicket ValidationTicket
 	challengeObj, err := GetProtocolImpl().VerifyChallengeTransaction(ctx, &challengeRequest)
 	if err != nil {
 		Logger.Error("Error verifying the challenge from BC",
@@ -70,7 +70,17 @@ func ChallengeHandler(ctx context.Context, r *http.Request) (interface{}, error)
 		return nil, common.NewError("invalid_parameters", "Allocation could not be verified. "+err.Error())
 	}
 
-	err = challengeRequest.VerifyChallenge(challengeObj, allocationObj)
+	return issueTicket(&challengeRequest, challengeObj, allocationObj, challengeHash)
+}
+
+// issueTicket verifies the challenge request and returns the signed ticket
+// of the outcome, recorded in the ticket store. The tickets of the passed
+// challenges are also kept in memory by the hash of their request.
+func issueTicket(challengeRequest *ChallengeRequest, challengeObj *Challenge,
+	allocationObj *Allocation, challengeHash string) (*ValidationTicket, error) {
+
+	var validationTicket ValidationTicket
+	err := challengeRequest.VerifyChallenge(challengeObj, allocationObj)
 	if err != nil {
 		errCode := err.Error()
 		commError, ok := err.(*common.Error)
@@ -90,7 +100,9 @@ func ChallengeHandler(ctx context.Context, r *http.Request) (interface{}, error)
 		if err := validationTicket.Sign(); err != nil {
 			return nil, common.NewError("invalid_parameters", err.Error())
 		}
-		return &validationTicket, nil
+		stats.RecordTicket(validationTicket.BlobberID, validationTicket.ChallengeID,
+			false, errCode, validationTicket.Message)
+		return recordTicket(challengeHash, &validationTicket)
 	}
 
 	validationTicket.BlobberID = challengeObj.Blobber.ID
@@ -105,8 +117,13 @@ func ChallengeHandler(ctx context.Context, r *http.Request) (interface{}, error)
 		return nil, common.NewError("invalid_parameters", err.Error())
 	}
 	Logger.Info("Validation passed.", zap.Any("challenge_id", challengeRequest.ChallengeID))
+	stats.RecordTicket(validationTicket.BlobberID, validationTicket.ChallengeID,
+		true, validationTicket.MessageCode, validationTicket.Message)
 
-	lru.Add(challengeHash, &validationTicket) //nolint:errcheck // never returns an error anyway
-	return &validationTicket, nil
-
+	retVT, err := recordTicket(challengeHash, &validationTicket)
+	if err != nil {
+		return nil, err
+	}
+	lru.Add(challengeHash, retVT) //nolint:errcheck // never returns an error anyway
+	return retVT, nil
 }
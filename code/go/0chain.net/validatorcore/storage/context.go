@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"0chain.net/core/common"
+	"0chain.net/validatorcore/stats"
 )
 
 func SetupContext(handler common.JSONResponderF) common.JSONResponderF {
@@ -16,3 +17,16 @@ func SetupContext(handler common.JSONResponderF) common.JSONResponderF {
 		return res, err
 	}
 }
+
+// WithErrorStats accounts the requests answered with an error in the stats
+// of the validator.
+func WithErrorStats(handler common.JSONResponderF) common.JSONResponderF {
+	return func(ctx context.Context, r *http.Request) (interface{}, error) {
+		res, err := handler(ctx, r)
+		if err != nil {
+			blobberID, _ := ctx.Value(CLIENT_CONTEXT_KEY).(string)
+			stats.RecordError(blobberID, "", err)
+		}
+		return res, err
+	}
+}
@@ -0,0 +1,257 @@
+package storage
+
+import (
+	"0chain.net/core/common"
+	"0chain.net/core/util"
+	"0chain.net/validatorcore/storage/writemarker"
+	"0chain.net/validatorcore/validatorgrpc"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// pathNodeFromMap returns the node of the object path map given, of the
+// fields its hash is made of, the ones Parse decodes.
+func pathNodeFromMap(m map[string]interface{}) (*validatorgrpc.PathNode, error) {
+	var fields FileMetaData
+	if err := mapstructure.Decode(m, &fields); err != nil {
+		return nil, err
+	}
+	node := fileMetaToPathNode(&fields)
+	// not decoded by Parse, but part of the hash of the files
+	node.AllocationId, _ = m["allocation_id"].(string)
+	list, ok := m[LIST_TAG]
+	if !ok {
+		return node, nil
+	}
+	node.Listed = true
+	var children []map[string]interface{}
+	switch l := list.(type) {
+	case []map[string]interface{}:
+		children = l
+	case []interface{}:
+		for _, child := range l {
+			c, ok := child.(map[string]interface{})
+			if !ok {
+				return nil, common.NewError("invalid_object_path", "Invalid object path. List should be of objects")
+			}
+			children = append(children, c)
+		}
+	default:
+		return nil, common.NewError("invalid_object_path", "Invalid object path. List should be an array")
+	}
+	for _, child := range children {
+		c, err := pathNodeFromMap(child)
+		if err != nil {
+			return nil, err
+		}
+		node.List = append(node.List, c)
+	}
+	return node, nil
+}
+
+// pathNodeToMap returns the object path map of the node, as decoded from
+// the JSON of a challenge request.
+func pathNodeToMap(node *validatorgrpc.PathNode) map[string]interface{} {
+	m := map[string]interface{}{
+		"type":             node.Type,
+		"name":             node.Name,
+		"path":             node.Path,
+		"hash":             node.Hash,
+		"path_hash":        node.PathHash,
+		"num_of_blocks":    node.NumBlocks,
+		"creation_date":    node.CreationDate,
+		"custom_meta":      node.CustomMeta,
+		"content_hash":     node.ContentHash,
+		"size":             node.Size,
+		"merkle_root":      node.MerkleRoot,
+		"actual_file_size": node.ActualFileSize,
+		"actual_file_hash": node.ActualFileHash,
+	}
+	if node.AllocationId != "" {
+		m["allocation_id"] = node.AllocationId
+	}
+	if node.Attributes != nil {
+		m["attributes"] = map[string]interface{}{
+			"who_pays_for_reads": int(node.Attributes.WhoPaysForReads),
+		}
+	}
+	if node.Listed {
+		list := make([]interface{}, 0, len(node.List))
+		for _, child := range node.List {
+			list = append(list, pathNodeToMap(child))
+		}
+		m[LIST_TAG] = list
+	}
+	return m
+}
+
+func fileMetaToPathNode(fm *FileMetaData) *validatorgrpc.PathNode {
+	node := &validatorgrpc.PathNode{
+		Type:           fm.Type,
+		Name:           fm.Name,
+		Path:           fm.Path,
+		Hash:           fm.Hash,
+		PathHash:       fm.PathHash,
+		NumBlocks:      fm.NumBlocks,
+		CreationDate:   int64(fm.CreationDate),
+		CustomMeta:     fm.CustomMeta,
+		ContentHash:    fm.ContentHash,
+		Size:           fm.Size,
+		MerkleRoot:     fm.MerkleRoot,
+		ActualFileSize: fm.ActualFileSize,
+		ActualFileHash: fm.ActualFileHash,
+		AllocationId:   fm.AllocationID,
+	}
+	if fm.Attributes != (Attributes{}) {
+		node.Attributes = &validatorgrpc.Attributes{
+			WhoPaysForReads: int32(fm.Attributes.WhoPaysForReads),
+		}
+	}
+	return node
+}
+
+func pathNodeToFileMeta(node *validatorgrpc.PathNode) *FileMetaData {
+	fm := &FileMetaData{
+		DirMetaData: DirMetaData{
+			CreationDate: common.Timestamp(node.CreationDate),
+			Type:         node.Type,
+			Name:         node.Name,
+			Path:         node.Path,
+			Hash:         node.Hash,
+			PathHash:     node.PathHash,
+			NumBlocks:    node.NumBlocks,
+			AllocationID: node.AllocationId,
+		},
+		CustomMeta:     node.CustomMeta,
+		ContentHash:    node.ContentHash,
+		Size:           node.Size,
+		MerkleRoot:     node.MerkleRoot,
+		ActualFileSize: node.ActualFileSize,
+		ActualFileHash: node.ActualFileHash,
+	}
+	if node.Attributes != nil {
+		fm.Attributes.WhoPaysForReads = common.WhoPays(node.Attributes.WhoPaysForReads)
+	}
+	return fm
+}
+
+// ChallengeRequestToGRPC returns the message of the challenge request.
+func ChallengeRequestToGRPC(cr *ChallengeRequest) (*validatorgrpc.ChallengeRequest, error) {
+	r := &validatorgrpc.ChallengeRequest{
+		ChallengeId: cr.ChallengeID,
+		Data:        cr.DataBlock,
+	}
+	if op := cr.ObjPath; op != nil {
+		r.ObjectPath = &validatorgrpc.ObjectPath{
+			RootHash:     op.RootHash,
+			FileBlockNum: op.FileBlockNum,
+		}
+		if op.Meta != nil {
+			r.ObjectPath.Meta = fileMetaToPathNode(op.Meta)
+		}
+		if op.Path != nil {
+			path, err := pathNodeFromMap(op.Path)
+			if err != nil {
+				return nil, common.NewError("invalid_object_path", "Error converting the object path. "+err.Error())
+			}
+			r.ObjectPath.Path = path
+		}
+	}
+	for _, wme := range cr.WriteMarkers {
+		e := &validatorgrpc.WriteMarkerEntity{ClientKey: wme.ClientPublicKey}
+		if wm := wme.WM; wm != nil {
+			e.WriteMarker = &validatorgrpc.WriteMarker{
+				AllocationRoot:     wm.AllocationRoot,
+				PrevAllocationRoot: wm.PreviousAllocationRoot,
+				AllocationId:       wm.AllocationID,
+				Size:               wm.Size,
+				BlobberId:          wm.BlobberID,
+				Timestamp:          int64(wm.Timestamp),
+				ClientId:           wm.ClientID,
+				Signature:          wm.Signature,
+			}
+		}
+		r.WriteMarkers = append(r.WriteMarkers, e)
+	}
+	if mp := cr.MerklePath; mp != nil {
+		r.MerklePath = &validatorgrpc.MerklePath{
+			Nodes:     mp.Nodes,
+			LeafIndex: int64(mp.LeafIndex),
+		}
+	}
+	return r, nil
+}
+
+// ChallengeRequestFromGRPC returns the challenge request of the message, as
+// decoded from the JSON of the same request.
+func ChallengeRequestFromGRPC(r *validatorgrpc.ChallengeRequest) *ChallengeRequest {
+	cr := &ChallengeRequest{
+		ChallengeID: r.ChallengeId,
+		DataBlock:   r.Data,
+	}
+	if op := r.ObjectPath; op != nil {
+		cr.ObjPath = &ObjectPath{
+			RootHash:     op.RootHash,
+			FileBlockNum: op.FileBlockNum,
+		}
+		if op.Meta != nil {
+			cr.ObjPath.Meta = pathNodeToFileMeta(op.Meta)
+		}
+		if op.Path != nil {
+			cr.ObjPath.Path = pathNodeToMap(op.Path)
+		}
+	}
+	for _, e := range r.WriteMarkers {
+		wme := &writemarker.WriteMarkerEntity{ClientPublicKey: e.ClientKey}
+		if wm := e.WriteMarker; wm != nil {
+			wme.WM = &writemarker.WriteMarker{
+				AllocationRoot:         wm.AllocationRoot,
+				PreviousAllocationRoot: wm.PrevAllocationRoot,
+				AllocationID:           wm.AllocationId,
+				Size:                   wm.Size,
+				BlobberID:              wm.BlobberId,
+				Timestamp:              common.Timestamp(wm.Timestamp),
+				ClientID:               wm.ClientId,
+				Signature:              wm.Signature,
+			}
+		}
+		cr.WriteMarkers = append(cr.WriteMarkers, wme)
+	}
+	if mp := r.MerklePath; mp != nil {
+		cr.MerklePath = &util.MTPath{
+			Nodes:     mp.Nodes,
+			LeafIndex: int(mp.LeafIndex),
+		}
+	}
+	return cr
+}
+
+// ValidationTicketToGRPC returns the message of the ticket.
+func ValidationTicketToGRPC(vt *ValidationTicket) *validatorgrpc.ValidationTicket {
+	return &validatorgrpc.ValidationTicket{
+		ChallengeId:  vt.ChallengeID,
+		BlobberId:    vt.BlobberID,
+		ValidatorId:  vt.ValidatorID,
+		ValidatorKey: vt.ValidatorKey,
+		Success:      vt.Result,
+		Message:      vt.Message,
+		MessageCode:  vt.MessageCode,
+		Timestamp:    int64(vt.Timestamp),
+		Signature:    vt.Signature,
+	}
+}
+
+// ValidationTicketFromGRPC returns the ticket of the message.
+func ValidationTicketFromGRPC(t *validatorgrpc.ValidationTicket) *ValidationTicket {
+	return &ValidationTicket{
+		ChallengeID:  t.ChallengeId,
+		BlobberID:    t.BlobberId,
+		ValidatorID:  t.ValidatorId,
+		ValidatorKey: t.ValidatorKey,
+		Result:       t.Success,
+		Message:      t.Message,
+		MessageCode:  t.MessageCode,
+		Timestamp:    common.Timestamp(t.Timestamp),
+		Signature:    t.Signature,
+	}
+}
@@ -0,0 +1,42 @@
+package storage_test
+
+import (
+	"testing"
+
+	"0chain.net/core/logging"
+	"0chain.net/validatorcore/storage"
+	"0chain.net/validatorcore/validatorgrpc"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestChallengeRequestGRPC(t *testing.T) {
+	logging.Logger = zap.NewNop()
+
+	cr, ch, alloc := newTraceRequest(t)
+	r, err := storage.ChallengeRequestToGRPC(cr)
+	require.NoError(t, err)
+	require.True(t, r.ObjectPath.Path.Listed)
+	require.Len(t, r.ObjectPath.Path.List, 1)
+
+	data, err := proto.Marshal(r)
+	require.NoError(t, err)
+	received := new(validatorgrpc.ChallengeRequest)
+	require.NoError(t, proto.Unmarshal(data, received))
+
+	// verified as the request decoded from JSON
+	got := storage.ChallengeRequestFromGRPC(received)
+	require.NoError(t, got.VerifyChallenge(ch, alloc))
+	require.Equal(t, cr.WriteMarkers, got.WriteMarkers)
+	require.Equal(t, cr.MerklePath, got.MerklePath)
+	require.Equal(t, cr.ObjPath.Meta.CalculateHash(), got.ObjPath.Meta.CalculateHash())
+
+	got.DataBlock = []byte("other data")
+	require.Error(t, got.VerifyChallenge(ch, alloc))
+
+	vt := &storage.ValidationTicket{ChallengeID: "challenge", BlobberID: "blobber",
+		Result: true, MessageCode: "success", Timestamp: 1000, Signature: "sig"}
+	require.Equal(t, vt, storage.ValidationTicketFromGRPC(storage.ValidationTicketToGRPC(vt)))
+}
@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+
+	"0chain.net/core/build"
+	"0chain.net/core/common"
+	"0chain.net/core/node"
+	"0chain.net/validatorcore/config"
+	"0chain.net/validatorcore/stats"
+	"0chain.net/validatorcore/validatorgrpc"
+
+	"golang.org/x/crypto/sha3"
+	"google.golang.org/protobuf/proto"
+)
+
+type validatorGRPCService struct {
+	validatorgrpc.UnimplementedValidatorServer
+}
+
+func newGRPCValidatorService() *validatorGRPCService {
+	return &validatorGRPCService{}
+}
+
+// setupGRPCContext sets the blobber of the request in the context, as
+// SetupContext does for the HTTP requests.
+func setupGRPCContext(ctx context.Context, rc *validatorgrpc.RequestContext) context.Context {
+	ctx = context.WithValue(ctx, CLIENT_CONTEXT_KEY, rc.GetClient())
+	return context.WithValue(ctx, CLIENT_KEY_CONTEXT_KEY, rc.GetClientKey())
+}
+
+// grpcRequestHash is the hash the ticket of the challenge request message is
+// kept by. It's not the one of the JSON of the same request.
+func grpcRequestHash(r *validatorgrpc.ChallengeRequest) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(r)
+	if err != nil {
+		return "", common.NewError("input_decode_error", "Error in encoding the input."+err.Error())
+	}
+	h := sha3.Sum256(data)
+	return hex.EncodeToString(h[:]), nil
+}
+
+func validateGRPCChallenge(ctx context.Context, r *validatorgrpc.ChallengeRequest) (
+	*validatorgrpc.ValidationTicket, error) {
+
+	if r == nil {
+		return nil, common.NewError("invalid_parameters", "Empty challenge request")
+	}
+	challengeHash, err := grpcRequestHash(r)
+	if err != nil {
+		return nil, err
+	}
+	vt, err := validateChallenge(ctx, ChallengeRequestFromGRPC(r), challengeHash)
+	if err != nil {
+		return nil, err
+	}
+	return ValidationTicketToGRPC(vt), nil
+}
+
+func (s *validatorGRPCService) ValidateChallenge(ctx context.Context,
+	req *validatorgrpc.ValidateChallengeRequest) (*validatorgrpc.ValidateChallengeResponse, error) {
+
+	ctx = setupGRPCContext(ctx, req.Context)
+	ticket, err := validateGRPCChallenge(ctx, req.Challenge)
+	if err != nil {
+		stats.RecordError(req.Context.GetClient(), req.Challenge.GetChallengeId(), err)
+		return nil, err
+	}
+	return &validatorgrpc.ValidateChallengeResponse{Ticket: ticket}, nil
+}
+
+func (s *validatorGRPCService) ValidateChallenges(ctx context.Context,
+	req *validatorgrpc.ValidateChallengesRequest) (*validatorgrpc.ValidateChallengesResponse, error) {
+
+	ctx = setupGRPCContext(ctx, req.Context)
+	if err := checkBatchSize(len(req.Challenges)); err != nil {
+		stats.RecordError(req.Context.GetClient(), "", err)
+		return nil, err
+	}
+
+	resp := &validatorgrpc.ValidateChallengesResponse{
+		Results: make([]*validatorgrpc.ChallengeResult, len(req.Challenges)),
+	}
+	validateBatch(len(req.Challenges), func(i int) {
+		r := req.Challenges[i]
+		result := &validatorgrpc.ChallengeResult{ChallengeId: r.GetChallengeId()}
+		ticket, err := validateGRPCChallenge(ctx, r)
+		if err != nil {
+			stats.RecordError(req.Context.GetClient(), r.GetChallengeId(), err)
+			commError, ok := err.(*common.Error)
+			if !ok {
+				commError = common.NewError("invalid_parameters", err.Error())
+			}
+			result.Error = &validatorgrpc.Error{Code: commError.Code, Message: commError.Msg}
+		}
+		result.Ticket = ticket
+		resp.Results[i] = result
+	})
+	return resp, nil
+}
+
+func (s *validatorGRPCService) GetStatus(ctx context.Context,
+	req *validatorgrpc.GetStatusRequest) (*validatorgrpc.GetStatusResponse, error) {
+
+	return &validatorgrpc.GetStatusResponse{
+		Id:           node.Self.ID,
+		PublicKey:    node.Self.PublicKey,
+		BuildTag:     build.BuildTag,
+		Started:      int64(stats.Started()),
+		GrpcPort:     int32(config.Configuration.GRPCPort),
+		BatchMaxSize: int32(config.Configuration.BatchMaxSize),
+	}, nil
+}
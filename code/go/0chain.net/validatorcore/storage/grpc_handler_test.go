@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"0chain.net/core/common"
+	coreconfig "0chain.net/core/config"
+	"0chain.net/core/logging"
+	"0chain.net/core/node"
+	"0chain.net/validatorcore/config"
+	"0chain.net/validatorcore/validatorgrpc"
+
+	"github.com/0chain/gosdk/core/zcncrypto"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	rl "go.uber.org/ratelimit"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func startGRPCValidator(t *testing.T) validatorgrpc.ValidatorClient {
+	lis := bufconn.Listen(1024 * 1024)
+	server := NewServerWithMiddlewares(&common.GRPCRateLimiter{Limiter: rl.New(1000)})
+	RegisterGRPCServices(mux.NewRouter(), server)
+	go server.Serve(lis) //nolint:errcheck // stopped by the test
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return validatorgrpc.NewValidatorClient(conn)
+}
+
+func TestGRPCValidateChallenges(t *testing.T) {
+	logging.Logger = zap.NewNop()
+	coreconfig.Configuration.SignatureScheme = "bls0chain"
+	config.Configuration.BatchMaxSize = 5
+	config.Configuration.BatchNumWorkers = 4
+	config.Configuration.BatchCacheTTL = 60
+	config.Configuration.GRPCPort = 7041
+	wallet, err := zcncrypto.NewSignatureScheme("bls0chain").GenerateKeys()
+	require.NoError(t, err)
+	node.Self.SetKeys(wallet.Keys[0].PublicKey, wallet.Keys[0].PrivateKey)
+
+	cc := new(countingChain)
+	defer func(chain chainLookup) { batchChain = chain }(batchChain)
+	batchChain = &cachedLookup{chain: cc}
+	challengeLookups, allocationLookups = newLookupCache("challenge"), newLookupCache("allocation")
+
+	client := startGRPCValidator(t)
+	ctx := context.Background()
+	rc := &validatorgrpc.RequestContext{Client: "blobber"}
+	item := func(id string) *validatorgrpc.ChallengeRequest {
+		return &validatorgrpc.ChallengeRequest{ChallengeId: id,
+			ObjectPath: &validatorgrpc.ObjectPath{RootHash: "x",
+				Path: &validatorgrpc.PathNode{}}}
+	}
+
+	st, err := client.GetStatus(ctx, &validatorgrpc.GetStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, node.Self.ID, st.Id)
+	require.EqualValues(t, 7041, st.GrpcPort)
+	require.EqualValues(t, 5, st.BatchMaxSize)
+
+	one, err := client.ValidateChallenge(ctx, &validatorgrpc.ValidateChallengeRequest{
+		Context: rc, Challenge: item("g1")})
+	require.NoError(t, err)
+	require.Equal(t, "g1", one.Ticket.ChallengeId)
+	require.False(t, one.Ticket.Success)
+	require.Equal(t, "challenge_validation_failed", one.Ticket.MessageCode)
+	require.NoError(t, ValidationTicketFromGRPC(one.Ticket).Sign())
+
+	_, err = client.ValidateChallenge(ctx, &validatorgrpc.ValidateChallengeRequest{
+		Context: rc, Challenge: &validatorgrpc.ChallengeRequest{ChallengeId: "g0"}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Empty object path")
+
+	resp, err := client.ValidateChallenges(ctx, &validatorgrpc.ValidateChallengesRequest{
+		Context: rc, Challenges: []*validatorgrpc.ChallengeRequest{
+			item("g1"), item("g2"), item("missing")}})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+	require.Equal(t, one.Ticket.Signature, resp.Results[0].Ticket.Signature,
+		"the ticket issued for the same request")
+	require.Equal(t, "g2", resp.Results[1].Ticket.ChallengeId)
+	require.Nil(t, resp.Results[2].Ticket)
+	require.Equal(t, "invalid_parameters", resp.Results[2].Error.Code)
+
+	require.Equal(t, 3, cc.challenges, "g1 and g2 once, the failed lookup")
+	require.Equal(t, 1, cc.allocations)
+
+	_, err = client.ValidateChallenges(ctx, &validatorgrpc.ValidateChallengesRequest{
+		Context: rc, Challenges: []*validatorgrpc.ChallengeRequest{
+			item("1"), item("2"), item("3"), item("4"), item("5"), item("6")}})
+	require.Error(t, err)
+}
@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"0chain.net/core/logging"
+	"0chain.net/core/metrics"
+	"0chain.net/validatorcore/validatorgrpc"
+
+	"github.com/gorilla/mux"
+	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
+	grpc_ratelimit "github.com/grpc-ecosystem/go-grpc-middleware/ratelimit"
+	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+)
+
+const (
+	// GRPC_TIMEOUT_SECONDS is the deadline of a request, as the write
+	// timeout of the HTTP server
+	GRPC_TIMEOUT_SECONDS = 30
+)
+
+func unaryTimeoutInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, canceler := context.WithTimeout(ctx, GRPC_TIMEOUT_SECONDS*time.Second)
+		defer canceler()
+
+		return handler(ctx, req)
+	}
+}
+
+// NewServerWithMiddlewares returns the gRPC server of the validator.
+func NewServerWithMiddlewares(limiter grpc_ratelimit.Limiter) *grpc.Server {
+	return grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			grpc_zap.UnaryServerInterceptor(logging.Logger),
+			metrics.UnaryServerInterceptor(),
+			grpc_recovery.UnaryServerInterceptor(),
+			grpc_ratelimit.UnaryServerInterceptor(limiter),
+			unaryTimeoutInterceptor(), // should always be the lastest, to be "innermost"
+		),
+	)
+}
+
+// RegisterGRPCServices registers the validator service on the gRPC server
+// and serves it over HTTP at /v2 as well.
+func RegisterGRPCServices(r *mux.Router, server *grpc.Server) {
+	validatorService := newGRPCValidatorService()
+	mux := runtime.NewServeMux()
+	validatorgrpc.RegisterValidatorServer(server, validatorService)
+	_ = validatorgrpc.RegisterValidatorHandlerServer(context.Background(), mux, validatorService)
+	r.PathPrefix("/v2/").Handler(mux)
+}
@@ -7,13 +7,18 @@ import (
 	"runtime/pprof"
 
 	"0chain.net/core/common"
+	"0chain.net/validatorcore/stats"
 
 	"github.com/gorilla/mux"
 )
 
 /*SetupHandlers sets up the necessary API end points */
 func SetupHandlers(r *mux.Router) {
-	r.HandleFunc("/v1/storage/challenge/new", common.UserRateLimit(common.ToJSONResponse(SetupContext(ChallengeHandler))))
+	r.HandleFunc("/v1/storage/challenge/new", common.UserRateLimit(common.ToJSONResponse(SetupContext(WithErrorStats(ChallengeHandler)))))
+	r.HandleFunc("/v1/storage/challenge/batch", common.UserRateLimit(common.ToJSONResponse(SetupContext(WithErrorStats(BatchChallengeHandler)))))
+	r.HandleFunc("/v1/storage/tickets", common.UserRateLimit(common.ToJSONResponse(TicketsHandler)))
+	r.HandleFunc("/_stats", common.UserRateLimit(stats.StatsHandler))
+	r.HandleFunc("/_statsJSON", common.UserRateLimit(common.ToJSONResponse(stats.StatsJSONHandler)))
 	r.HandleFunc("/debug", common.UserRateLimit(common.ToJSONResponse(DumpGoRoutines)))
 }
 
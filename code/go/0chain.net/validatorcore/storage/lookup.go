@@ -0,0 +1,137 @@
+package storage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"0chain.net/validatorcore/config"
+	"0chain.net/validatorcore/stats"
+)
+
+// lookupCache keeps the objects looked up on the chain for a while, the
+// concurrent lookups of the same key sharing the one request to the chain.
+// The failed lookups are not kept.
+type lookupCache struct {
+	name    string
+	mu      sync.Mutex
+	entries map[string]*lookup
+	now     func() time.Time
+}
+
+type lookup struct {
+	done    chan struct{}
+	value   interface{}
+	err     error
+	expires time.Time
+}
+
+func newLookupCache(name string) *lookupCache {
+	return &lookupCache{name: name, entries: make(map[string]*lookup),
+		now: time.Now}
+}
+
+// get returns the object of the key, looked up with the function given if
+// not kept or expired.
+func (c *lookupCache) get(key string, ttl time.Duration,
+	fetch func() (interface{}, error)) (interface{}, error) {
+
+	c.mu.Lock()
+	now := c.now()
+	l, ok := c.entries[key]
+	if ok && !l.expired(now) {
+		c.mu.Unlock()
+		stats.RecordCacheLookup(c.name, true)
+		<-l.done
+		return l.value, l.err
+	}
+	for k, e := range c.entries {
+		if e.expired(now) {
+			delete(c.entries, k)
+		}
+	}
+	l = &lookup{done: make(chan struct{})}
+	c.entries[key] = l
+	c.mu.Unlock()
+	stats.RecordCacheLookup(c.name, false)
+
+	l.value, l.err = fetch()
+
+	c.mu.Lock()
+	if l.err != nil {
+		delete(c.entries, key)
+	} else {
+		l.expires = c.now().Add(ttl)
+	}
+	close(l.done)
+	c.mu.Unlock()
+	return l.value, l.err
+}
+
+// expired returns true if the lookup is done and too old to be used, the
+// ones in progress are waited for.
+func (l *lookup) expired(now time.Time) bool {
+	select {
+	case <-l.done:
+		return !now.Before(l.expires)
+	default:
+		return false
+	}
+}
+
+var (
+	challengeLookups  = newLookupCache(stats.ChallengeLookup)
+	allocationLookups = newLookupCache(stats.AllocationLookup)
+)
+
+// chainLookup is the chain, as the validator looks the challenges and the
+// allocations up.
+type chainLookup interface {
+	VerifyChallengeTransaction(ctx context.Context, challengeRequest *ChallengeRequest) (*Challenge, error)
+	VerifyAllocationTransaction(ctx context.Context, allocationID string) (*Allocation, error)
+}
+
+// cachedLookup looks the challenges and the allocations up once for all
+// the requests of batch_cache_ttl seconds, on the chain given or the server
+// chain if nil.
+type cachedLookup struct {
+	chain chainLookup
+}
+
+func (cl *cachedLookup) impl() chainLookup {
+	if cl.chain != nil {
+		return cl.chain
+	}
+	return GetProtocolImpl()
+}
+
+func (cl *cachedLookup) ttl() time.Duration {
+	return time.Duration(config.Configuration.BatchCacheTTL) * time.Second
+}
+
+func (cl *cachedLookup) VerifyChallengeTransaction(ctx context.Context,
+	challengeRequest *ChallengeRequest) (*Challenge, error) {
+
+	// the challenge is of the blobber asking
+	blobberID, _ := ctx.Value(CLIENT_CONTEXT_KEY).(string)
+	key := blobberID + ":" + challengeRequest.ChallengeID
+	obj, err := challengeLookups.get(key, cl.ttl(), func() (interface{}, error) {
+		return cl.impl().VerifyChallengeTransaction(ctx, challengeRequest)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*Challenge), nil
+}
+
+func (cl *cachedLookup) VerifyAllocationTransaction(ctx context.Context,
+	allocationID string) (*Allocation, error) {
+
+	obj, err := allocationLookups.get(allocationID, cl.ttl(), func() (interface{}, error) {
+		return cl.impl().VerifyAllocationTransaction(ctx, allocationID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*Allocation), nil
+}
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/rand"
@@ -130,6 +131,20 @@ type ObjectPath struct {
 	Path         map[string]interface{} `json:"path"`
 	FileBlockNum int64                  `json:"file_block_num"`
 	RootObject   *DirMetaData           `json:"-"`
+
+	trace *Trace
+}
+
+// traceHash records the check of the hash of an object of the path.
+func (op *ObjectPath) traceHash(check, path, hashData, given, calculated string, err error) {
+	op.trace.add(check, err, func() map[string]interface{} {
+		details := map[string]interface{}{"path": path, "given": given,
+			"calculated": calculated}
+		if hashData != "" {
+			details["hash_data"] = hashData
+		}
+		return details
+	})
 }
 
 func (op *ObjectPath) Parse(input map[string]interface{}, allocationID string) (*DirMetaData, error) {
@@ -157,8 +172,11 @@ func (op *ObjectPath) Parse(input map[string]interface{}, allocationID string) (
 					newHash := fileObj.CalculateHash()
 					if newHash != fileObj.GetHash() {
 						Logger.Error("Hash mismatch for file.", zap.Any("hashdata", fileObj.GetHashData()), zap.Any("newhash", newHash), zap.Any("given_hash", fileObj.GetHash()))
-						return nil, common.NewError("hash_mismatch", "Object path error since there is a mismatch in the file hashes. "+fileObj.Path)
+						err := common.NewError("hash_mismatch", "Object path error since there is a mismatch in the file hashes. "+fileObj.Path)
+						op.traceHash("file_hash", fileObj.Path, fileObj.GetHashData(), fileObj.GetHash(), newHash, err)
+						return nil, err
 					}
+					op.traceHash("file_hash", fileObj.Path, fileObj.GetHashData(), fileObj.GetHash(), newHash, nil)
 					rootDir.Children[i] = &fileObj
 				} else {
 					dirObj := &DirMetaData{}
@@ -171,8 +189,11 @@ func (op *ObjectPath) Parse(input map[string]interface{}, allocationID string) (
 						newHash := dirObj.CalculateHash()
 						if newHash != dirObj.GetHash() {
 							Logger.Error("Hash mismatch for directory.", zap.Any("newhash", newHash), zap.Any("given_hash", dirObj.GetHash()), zap.Any("dirObj", dirObj))
-							return nil, common.NewError("hash_mismatch", "Object path error since there is a mismatch in the dir hashes. "+dirObj.Path)
+							err := common.NewError("hash_mismatch", "Object path error since there is a mismatch in the dir hashes. "+dirObj.Path)
+							op.traceHash("dir_hash", dirObj.Path, "", dirObj.GetHash(), newHash, err)
+							return nil, err
 						}
+						op.traceHash("dir_hash", dirObj.Path, "", dirObj.GetHash(), newHash, nil)
 					} else {
 						err = mapstructure.Decode(object, dirObj)
 						if err != nil {
@@ -191,19 +212,45 @@ func (op *ObjectPath) Parse(input map[string]interface{}, allocationID string) (
 
 	newHash := rootDir.CalculateHash()
 	if newHash != rootDir.GetHash() {
-		return nil, common.NewError("hash_mismatch", "Object path error since there is a mismatch in the dir hashes. "+rootDir.Path)
+		err := common.NewError("hash_mismatch", "Object path error since there is a mismatch in the dir hashes. "+rootDir.Path)
+		op.traceHash("dir_hash", rootDir.Path, "", rootDir.GetHash(), newHash, err)
+		return nil, err
 	}
+	op.traceHash("dir_hash", rootDir.Path, "", rootDir.GetHash(), newHash, nil)
 	return &rootDir, nil
 }
 
-func (op *ObjectPath) VerifyBlockNum(challengeRand int64) error {
+func (op *ObjectPath) VerifyBlockNum(challengeRand int64) (err error) {
+	var (
+		blockNum int64
+		curRef   ObjectEntity
+	)
+	defer func() {
+		op.trace.add("block_num", err, func() map[string]interface{} {
+			details := map[string]interface{}{"seed": challengeRand,
+				"root_num_blocks": op.RootObject.NumBlocks}
+			if blockNum > 0 {
+				details["block_num"] = blockNum
+			}
+			if file, ok := curRef.(*FileMetaData); ok {
+				details["block_file_path"] = file.Path
+				details["block_file_hash"] = file.GetHash()
+			}
+			if op.Meta != nil {
+				details["challenged_file_path"] = op.Meta.Path
+				details["challenged_file_hash"] = op.Meta.GetHash()
+			}
+			return details
+		})
+	}()
+
 	if op.RootObject.NumBlocks == 0 {
 		Logger.Info("Challenge is on a empty allocation")
 		return nil
 	}
 	r := rand.New(rand.NewSource(challengeRand))
 	//rand.Seed(challengeRand)
-	blockNum := r.Int63n(op.RootObject.NumBlocks)
+	blockNum = r.Int63n(op.RootObject.NumBlocks)
 	blockNum = blockNum + 1
 
 	if op.RootObject.NumBlocks < blockNum {
@@ -211,7 +258,6 @@ func (op *ObjectPath) VerifyBlockNum(challengeRand int64) error {
 	}
 
 	found := false
-	var curRef ObjectEntity
 	curRef = op.RootObject
 	remainingBlocks := blockNum
 
@@ -252,12 +298,15 @@ func (op *ObjectPath) VerifyPath(allocationID string) error {
 
 	if err != nil {
 		Logger.Error("Error parsing the object path", zap.Any("object_path", op))
-		return common.NewError("invalid_object_path", "Error parsing the object path. "+err.Error())
+		err = common.NewError("invalid_object_path", "Error parsing the object path. "+err.Error())
+		op.trace.add("object_path", err, nil)
+		return err
 	}
 	if op.RootHash != rootDir.Hash {
-		return common.NewError("invalid_object_path", "Root Hash does not match with object path")
+		err = common.NewError("invalid_object_path", "Root Hash does not match with object path")
 	}
-	return nil
+	op.traceHash("root_hash", rootDir.Path, "", op.RootHash, rootDir.Hash, err)
+	return err
 }
 
 func (op *ObjectPath) Verify(allocationID string, challengeRand int64) error {
@@ -289,28 +338,59 @@ type ChallengeRequest struct {
 }
 
 func (cr *ChallengeRequest) VerifyChallenge(challengeObj *Challenge, allocationObj *Allocation) error {
+	return cr.verifyChallenge(challengeObj, allocationObj, nil)
+}
+
+// verifyChallenge verifies the challenge request, recording the checks done
+// in the trace if not nil.
+func (cr *ChallengeRequest) verifyChallenge(challengeObj *Challenge, allocationObj *Allocation, trace *Trace) error {
 	Logger.Info("Verifying object path", zap.Any("challenge_id", challengeObj.ID), zap.Any("seed", challengeObj.RandomNumber))
+	cr.ObjPath.trace = trace
+	defer func() { cr.ObjPath.trace = nil }()
 	err := cr.ObjPath.Verify(challengeObj.AllocationID, challengeObj.RandomNumber)
 	if err != nil {
 		return common.NewError("challenge_validation_failed", "Failed to verify the object path."+err.Error())
 	}
 
 	if cr.WriteMarkers == nil || len(cr.WriteMarkers) == 0 {
-		return common.NewError("challenge_validation_failed", "Invalid write marker")
+		err = common.NewError("challenge_validation_failed", "Invalid write marker")
+		trace.add("write_markers", err, nil)
+		return err
 	}
 
 	Logger.Info("Verifying write marker", zap.Any("challenge_id", challengeObj.ID))
-	err = cr.WriteMarkers[0].WM.Verify(allocationObj.ID, challengeObj.AllocationRoot, cr.WriteMarkers[0].ClientPublicKey)
-	if err != nil {
-		return err
-	}
-	for i := 1; i < len(cr.WriteMarkers); i++ {
-		err = cr.WriteMarkers[i].WM.Verify(allocationObj.ID, cr.WriteMarkers[i].WM.AllocationRoot, cr.WriteMarkers[i].ClientPublicKey)
+	for i, wme := range cr.WriteMarkers {
+		// the first write marker is of the root challenged, the others chain
+		// from it
+		allocationRoot := wme.WM.AllocationRoot
+		if i == 0 {
+			allocationRoot = challengeObj.AllocationRoot
+		}
+		err = wme.WM.Verify(allocationObj.ID, allocationRoot, wme.ClientPublicKey)
+		trace.add("write_marker", err, func() map[string]interface{} {
+			clientKeyBytes, _ := hex.DecodeString(wme.ClientPublicKey)
+			return map[string]interface{}{"index": i,
+				"allocation_id": wme.WM.AllocationID, "expected_allocation_id": allocationObj.ID,
+				"allocation_root": wme.WM.AllocationRoot, "expected_allocation_root": allocationRoot,
+				"client_id": wme.WM.ClientID, "client_key_id": encryption.Hash(clientKeyBytes),
+				"timestamp": wme.WM.Timestamp}
+		})
 		if err != nil {
 			return err
 		}
-		if cr.WriteMarkers[i].WM.PreviousAllocationRoot != cr.WriteMarkers[i-1].WM.AllocationRoot {
-			return common.NewError("write_marker_validation_failed", "Write markers chain is invalid")
+		if i == 0 {
+			continue
+		}
+		if wme.WM.PreviousAllocationRoot != cr.WriteMarkers[i-1].WM.AllocationRoot {
+			err = common.NewError("write_marker_validation_failed", "Write markers chain is invalid")
+		}
+		trace.add("write_marker_chain", err, func() map[string]interface{} {
+			return map[string]interface{}{"index": i,
+				"prev_allocation_root":            wme.WM.PreviousAllocationRoot,
+				"previous_marker_allocation_root": cr.WriteMarkers[i-1].WM.AllocationRoot}
+		})
+		if err != nil {
+			return err
 		}
 	}
 	latestWM := cr.WriteMarkers[len(cr.WriteMarkers)-1].WM
@@ -318,7 +398,15 @@ func (cr *ChallengeRequest) VerifyChallenge(challengeObj *Challenge, allocationO
 	allocationRootCalculated := encryption.Hash(rootRef.Hash + ":" + strconv.FormatInt(int64(latestWM.Timestamp), 10))
 
 	if latestWM.AllocationRoot != allocationRootCalculated {
-		return common.NewError("challenge_validation_failed", "Allocation root does not match")
+		err = common.NewError("challenge_validation_failed", "Allocation root does not match")
+	}
+	trace.add("allocation_root", err, func() map[string]interface{} {
+		return map[string]interface{}{"root_hash": rootRef.Hash,
+			"timestamp": latestWM.Timestamp, "calculated": allocationRootCalculated,
+			"latest_write_marker": latestWM.AllocationRoot}
+	})
+	if err != nil {
+		return err
 	}
 
 	if rootRef.NumBlocks == 0 {
@@ -329,9 +417,16 @@ func (cr *ChallengeRequest) VerifyChallenge(challengeObj *Challenge, allocationO
 	contentHash := encryption.Hash(cr.DataBlock)
 	merkleVerify := util.VerifyMerklePath(contentHash, cr.MerklePath, cr.ObjPath.Meta.MerkleRoot)
 	if !merkleVerify {
-		return common.NewError("challenge_validation_failed", "Failed to verify the merkle path for the data block")
+		err = common.NewError("challenge_validation_failed", "Failed to verify the merkle path for the data block")
 	}
-	return nil
+	trace.add("merkle_path", err, func() map[string]interface{} {
+		return map[string]interface{}{"block_hash": contentHash,
+			"block_size": len(cr.DataBlock), "leaf_index": cr.MerklePath.LeafIndex,
+			"nodes":           len(cr.MerklePath.Nodes),
+			"calculated_root": util.MerklePathRoot(contentHash, cr.MerklePath),
+			"merkle_root":     cr.ObjPath.Meta.MerkleRoot}
+	})
+	return err
 }
 
 type Challenge struct {
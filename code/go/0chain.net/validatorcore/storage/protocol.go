@@ -12,6 +12,7 @@ import (
 	"0chain.net/core/node"
 	"0chain.net/core/transaction"
 	"0chain.net/validatorcore/config"
+	"0chain.net/validatorcore/stats"
 
 	"github.com/0chain/gosdk/zcncore"
 	"go.uber.org/zap"
@@ -71,7 +72,9 @@ func GetProtocolImpl() *ValidatorProtocolImpl {
 // }
 
 func (sp *ValidatorProtocolImpl) VerifyAllocationTransaction(ctx context.Context, allocationID string) (*Allocation, error) {
+	start := time.Now()
 	t, err := transaction.VerifyTransaction(allocationID, sp.ServerChain)
+	stats.RecordChainLookup(stats.AllocationLookup, time.Since(start), err)
 	if err != nil {
 		return nil, common.NewError("invalid_allocation", "Invalid Allocation id. Allocation not found in blockchain. "+err.Error())
 	}
@@ -91,7 +94,9 @@ func (sp *ValidatorProtocolImpl) VerifyChallengeTransaction(ctx context.Context,
 	params := make(map[string]string)
 	params["blobber"] = blobberID
 	params["challenge"] = challengeRequest.ChallengeID
+	start := time.Now()
 	challengeBytes, err := transaction.MakeSCRestAPICall(transaction.STORAGE_CONTRACT_ADDRESS, "/getchallenge", params, chain.GetServerChain(), nil)
+	stats.RecordChainLookup(stats.ChallengeLookup, time.Since(start), err)
 
 	if err != nil {
 		return nil, common.NewError("invalid_challenge", "Invalid challenge id. Challenge not found in blockchain. "+err.Error())
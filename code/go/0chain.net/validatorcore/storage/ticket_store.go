@@ -0,0 +1,429 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"0chain.net/core/common"
+	. "0chain.net/core/logging"
+	"0chain.net/validatorcore/config"
+	"0chain.net/validatorcore/stats"
+
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultTicketsLimit is the number of tickets of a blobber listed if
+	// none is asked
+	DefaultTicketsLimit = 50
+	// MaxTicketsLimit is the most tickets of a blobber listed at once
+	MaxTicketsLimit = 500
+)
+
+var (
+	// ticketsBucket is the tickets by the hash of their request
+	ticketsBucket = []byte("tickets")
+	// challengeTicketsBucket is the hashes of the requests of a challenge,
+	// keyed by the challenge ID and the request hash
+	challengeTicketsBucket = []byte("challenge_tickets")
+	// blobberTicketsBucket is the hashes of the requests of a blobber, keyed
+	// by the blobber ID and the order they were issued in
+	blobberTicketsBucket = []byte("blobber_tickets")
+	// issuedTicketsBucket is the hashes of the requests, keyed by the order
+	// they were issued in, for the oldest tickets to be pruned first
+	issuedTicketsBucket = []byte("issued_tickets")
+)
+
+// IssuedTicket is a validation ticket the validator signed, passed or
+// failed, with the hash of the request it answered.
+type IssuedTicket struct {
+	RequestHash string            `json:"request_hash"`
+	ChallengeID string            `json:"challenge_id"`
+	BlobberID   string            `json:"blobber_id"`
+	Ticket      *ValidationTicket `json:"ticket"`
+}
+
+// TicketStore is the log of the tickets issued by the validator, kept on
+// disk across the restarts.
+type TicketStore struct {
+	db *bolt.DB
+}
+
+// OpenTicketStore opens the ticket store of the file given, created if
+// missing.
+func OpenTicketStore(path string) (*TicketStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{ticketsBucket, challengeTicketsBucket,
+			blobberTicketsBucket, issuedTicketsBucket} {
+
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &TicketStore{db: db}, nil
+}
+
+// Close closes the file of the store.
+func (ts *TicketStore) Close() error {
+	return ts.db.Close()
+}
+
+// Get returns the ticket issued for the request of the hash given, nil if
+// none.
+func (ts *TicketStore) Get(requestHash string) (it *IssuedTicket, err error) {
+	err = ts.db.View(func(tx *bolt.Tx) error {
+		it, err = getIssuedTicket(tx, []byte(requestHash))
+		return err
+	})
+	return
+}
+
+func getIssuedTicket(tx *bolt.Tx, requestHash []byte) (*IssuedTicket, error) {
+	data := tx.Bucket(ticketsBucket).Get(requestHash)
+	if data == nil {
+		return nil, nil
+	}
+	it := new(IssuedTicket)
+	if err := json.Unmarshal(data, it); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// Add records the ticket and returns it, or the one recorded before for the
+// same request if any, the validator answering a request always the same.
+func (ts *TicketStore) Add(it *IssuedTicket) (recorded *IssuedTicket, err error) {
+	err = ts.db.Update(func(tx *bolt.Tx) error {
+		hash := []byte(it.RequestHash)
+		if recorded, err = getIssuedTicket(tx, hash); err != nil || recorded != nil {
+			return err
+		}
+		data, err := json.Marshal(it)
+		if err != nil {
+			return err
+		}
+		if err = tx.Bucket(ticketsBucket).Put(hash, data); err != nil {
+			return err
+		}
+
+		err = tx.Bucket(challengeTicketsBucket).Put(indexKey(it.ChallengeID, hash), nil)
+		if err != nil {
+			return err
+		}
+		issued := tx.Bucket(issuedTicketsBucket)
+		seq, err := issued.NextSequence()
+		if err != nil {
+			return err
+		}
+		var order [8]byte
+		binary.BigEndian.PutUint64(order[:], seq)
+		if err = issued.Put(order[:], hash); err != nil {
+			return err
+		}
+		err = tx.Bucket(blobberTicketsBucket).Put(indexKey(it.BlobberID, order[:]), hash)
+		if err != nil {
+			return err
+		}
+		recorded = it
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recorded, nil
+}
+
+// Prune removes the tickets issued before the time given, if not zero, and
+// the oldest ones above the most kept, if not zero. It returns the number of
+// the tickets removed.
+func (ts *TicketStore) Prune(before common.Timestamp, maxTickets int) (
+	pruned int, err error) {
+
+	err = ts.db.Update(func(tx *bolt.Tx) error {
+		issued := tx.Bucket(issuedTicketsBucket)
+		excess := 0
+		if maxTickets > 0 {
+			excess = issued.Stats().KeyN - maxTickets
+		}
+		// the keys are deleted once the cursor is done with them
+		var orders [][]byte
+		c := issued.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			it, err := getIssuedTicket(tx, v)
+			if err != nil {
+				return err
+			}
+			if excess <= 0 && it != nil && it.Ticket != nil &&
+				it.Ticket.Timestamp >= before {
+				break // the tickets after it are more recent
+			}
+			orders = append(orders, append([]byte(nil), k...))
+			if it != nil {
+				if err = deleteIssuedTicket(tx, it, k); err != nil {
+					return err
+				}
+			}
+			excess--
+		}
+		for _, k := range orders {
+			if err := issued.Delete(k); err != nil {
+				return err
+			}
+		}
+		pruned = len(orders)
+		return nil
+	})
+	return
+}
+
+// deleteIssuedTicket removes the ticket, of the order given, from the tickets
+// and their indexes.
+func deleteIssuedTicket(tx *bolt.Tx, it *IssuedTicket, order []byte) error {
+	hash := []byte(it.RequestHash)
+	if err := tx.Bucket(ticketsBucket).Delete(hash); err != nil {
+		return err
+	}
+	err := tx.Bucket(challengeTicketsBucket).Delete(indexKey(it.ChallengeID, hash))
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(blobberTicketsBucket).Delete(indexKey(it.BlobberID, order))
+}
+
+// indexKey is the key of an index of the tickets, the ID separated from
+// the rest so that no ID is the prefix of another.
+func indexKey(id string, rest []byte) []byte {
+	key := make([]byte, 0, len(id)+1+len(rest))
+	key = append(key, id...)
+	key = append(key, 0)
+	return append(key, rest...)
+}
+
+// ByChallenge returns the tickets issued for the challenge.
+func (ts *TicketStore) ByChallenge(challengeID string) ([]*IssuedTicket, error) {
+	tickets := make([]*IssuedTicket, 0)
+	err := ts.db.View(func(tx *bolt.Tx) error {
+		prefix := indexKey(challengeID, nil)
+		c := tx.Bucket(challengeTicketsBucket).Cursor()
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			it, err := getIssuedTicket(tx, k[len(prefix):])
+			if err != nil {
+				return err
+			}
+			if it != nil {
+				tickets = append(tickets, it)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+// ByBlobber returns the tickets issued to the blobber, the latest first,
+// skipping the offset given.
+func (ts *TicketStore) ByBlobber(blobberID string, offset, limit int) (
+	[]*IssuedTicket, error) {
+
+	tickets := make([]*IssuedTicket, 0)
+	err := ts.db.View(func(tx *bolt.Tx) error {
+		prefix := indexKey(blobberID, nil)
+		c := tx.Bucket(blobberTicketsBucket).Cursor()
+		// the cursor is moved past the keys of the blobber to go backwards
+		k, v := c.Seek(indexKey(blobberID, []byte{0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff}))
+		if k == nil {
+			k, v = c.Last()
+		} else {
+			k, v = c.Prev()
+		}
+		for ; k != nil && bytes.HasPrefix(k, prefix) && len(tickets) < limit; k, v = c.Prev() {
+			if offset > 0 {
+				offset--
+				continue
+			}
+			it, err := getIssuedTicket(tx, v)
+			if err != nil {
+				return err
+			}
+			if it != nil {
+				tickets = append(tickets, it)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+// ticketsCache is the name in the stats of the tickets issued looked up
+const ticketsCache = "tickets"
+
+// tickets is the ticket store of the validator, nil if the tickets are not
+// kept.
+var tickets *TicketStore
+
+// SetupTicketStore opens the ticket store of the validator.
+func SetupTicketStore(path string) (err error) {
+	tickets, err = OpenTicketStore(path)
+	return
+}
+
+// ticketsPruneInterval is how often the tickets above the limits of the
+// configuration are pruned.
+const ticketsPruneInterval = 10 * time.Minute
+
+// PruneTickets prunes the ticket store of the validator, of the tickets
+// older than ticket_store.max_age and the oldest above
+// ticket_store.max_tickets, until the context is done.
+func PruneTickets(ctx context.Context) {
+	maxAge := time.Duration(config.Configuration.TicketStoreMaxAge) * time.Hour
+	maxTickets := config.Configuration.TicketStoreMaxTickets
+	if tickets == nil || (maxAge <= 0 && maxTickets <= 0) {
+		return
+	}
+	ticker := time.NewTicker(ticketsPruneInterval)
+	defer ticker.Stop()
+	for {
+		var before common.Timestamp
+		if maxAge > 0 {
+			before = common.Timestamp(time.Now().Add(-maxAge).Unix())
+		}
+		pruned, err := tickets.Prune(before, maxTickets)
+		if err != nil {
+			Logger.Error("Error pruning the ticket store", zap.Error(err))
+		} else if pruned > 0 {
+			Logger.Info("Pruned the ticket store", zap.Int("tickets", pruned))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// issuedTicket returns the ticket issued before for the request of the hash
+// given, nil if none.
+func issuedTicket(requestHash string) (*ValidationTicket, error) {
+	if vt, err := lru.Get(requestHash); err == nil {
+		if retVT, ok := vt.(*ValidationTicket); ok {
+			stats.RecordCacheLookup(ticketsCache, true)
+			return retVT, nil
+		}
+	}
+	if tickets == nil {
+		stats.RecordCacheLookup(ticketsCache, false)
+		return nil, nil
+	}
+	it, err := tickets.Get(requestHash)
+	if err != nil || it == nil {
+		stats.RecordCacheLookup(ticketsCache, false)
+		return nil, err
+	}
+	stats.RecordCacheLookup(ticketsCache, true)
+	return it.Ticket, nil
+}
+
+// recordTicket records the ticket issued for the request of the hash given
+// and returns the one to answer with, the recorded one if it was issued
+// before.
+func recordTicket(requestHash string, vt *ValidationTicket) (*ValidationTicket, error) {
+	if tickets == nil {
+		return vt, nil
+	}
+	it, err := tickets.Add(&IssuedTicket{
+		RequestHash: requestHash,
+		ChallengeID: vt.ChallengeID,
+		BlobberID:   vt.BlobberID,
+		Ticket:      vt,
+	})
+	if err != nil {
+		return nil, common.NewError("ticket_store_error",
+			"Error recording the validation ticket. "+err.Error())
+	}
+	return it.Ticket, nil
+}
+
+// TicketsHandler serves the tickets issued by the validator, of the request
+// of the hash "request_hash", of the challenge "challenge_id", or of the
+// blobber "blobber_id" the latest first, paged by "offset" and "limit".
+// It needs no authentication: it is read-only and the tickets are public
+// data, signed by the validator for the blobbers to submit on chain.
+func TicketsHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	if tickets == nil {
+		return nil, common.NewError("ticket_store_disabled",
+			"The validator doesn't keep the tickets issued")
+	}
+	if hash := r.FormValue("request_hash"); hash != "" {
+		it, err := tickets.Get(hash)
+		if err != nil {
+			return nil, common.NewError("ticket_store_error", err.Error())
+		}
+		if it == nil {
+			return nil, common.NewError("ticket_not_found",
+				"No ticket issued for the request")
+		}
+		return it, nil
+	}
+	if challengeID := r.FormValue("challenge_id"); challengeID != "" {
+		its, err := tickets.ByChallenge(challengeID)
+		if err != nil {
+			return nil, common.NewError("ticket_store_error", err.Error())
+		}
+		return its, nil
+	}
+	blobberID := r.FormValue("blobber_id")
+	if blobberID == "" {
+		return nil, common.NewError("invalid_parameters",
+			"Missing request_hash, challenge_id or blobber_id")
+	}
+	offset, limit := 0, DefaultTicketsLimit
+	for name, dest := range map[string]*int{"offset": &offset, "limit": &limit} {
+		if s := r.FormValue(name); s != "" {
+			n, err := strconv.Atoi(s)
+			if err != nil || n < 0 {
+				return nil, common.NewErrorf("invalid_parameters",
+					"invalid %s %q", name, s)
+			}
+			*dest = n
+		}
+	}
+	if limit == 0 {
+		limit = DefaultTicketsLimit
+	}
+	if limit > MaxTicketsLimit {
+		limit = MaxTicketsLimit
+	}
+	its, err := tickets.ByBlobber(blobberID, offset, limit)
+	if err != nil {
+		return nil, common.NewError("ticket_store_error", err.Error())
+	}
+	return its, nil
+}
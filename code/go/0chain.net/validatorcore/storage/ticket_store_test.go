@@ -0,0 +1,127 @@
+package storage
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"0chain.net/core/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTicketStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "tickets.db")
+	ts, err := OpenTicketStore(path)
+	require.NoError(t, err)
+
+	issue := func(hash, challengeID, blobberID string, result bool) *IssuedTicket {
+		return &IssuedTicket{RequestHash: hash, ChallengeID: challengeID,
+			BlobberID: blobberID, Ticket: &ValidationTicket{
+				ChallengeID: challengeID, BlobberID: blobberID,
+				Result: result, Signature: "sig-" + hash}}
+	}
+	for i := 0; i < 5; i++ {
+		it, err := ts.Add(issue(fmt.Sprint("hash", i), fmt.Sprint("challenge", i%2),
+			"blobber", i%2 == 0))
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprint("sig-hash", i), it.Ticket.Signature)
+	}
+	_, err = ts.Add(issue("other", "challenge0", "blobber1", true))
+	require.NoError(t, err)
+
+	// the same request is answered with the ticket issued first
+	it, err := ts.Add(issue("hash1", "challenge1", "blobber", true))
+	require.NoError(t, err)
+	require.False(t, it.Ticket.Result)
+	require.NoError(t, ts.Close())
+
+	// the tickets are kept across restarts
+	ts, err = OpenTicketStore(path)
+	require.NoError(t, err)
+	defer ts.Close()
+
+	it, err = ts.Get("hash3")
+	require.NoError(t, err)
+	require.Equal(t, "challenge1", it.ChallengeID)
+	require.Equal(t, "sig-hash3", it.Ticket.Signature)
+	it, err = ts.Get("missing")
+	require.NoError(t, err)
+	require.Nil(t, it)
+
+	its, err := ts.ByChallenge("challenge0")
+	require.NoError(t, err)
+	var hashes []string
+	for _, it := range its {
+		hashes = append(hashes, it.RequestHash)
+	}
+	require.ElementsMatch(t, []string{"hash0", "hash2", "hash4", "other"}, hashes)
+	its, err = ts.ByChallenge("challenge")
+	require.NoError(t, err)
+	require.Empty(t, its, "no challenge ID is the prefix of another")
+
+	its, err = ts.ByBlobber("blobber", 1, 3)
+	require.NoError(t, err)
+	hashes = nil
+	for _, it := range its {
+		hashes = append(hashes, it.RequestHash)
+	}
+	require.Equal(t, []string{"hash3", "hash2", "hash1"}, hashes, "the latest first")
+	its, err = ts.ByBlobber("blobber1", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, its, 1)
+	require.Equal(t, "other", its[0].RequestHash)
+}
+
+func TestTicketStore_Prune(t *testing.T) {
+	ts, err := OpenTicketStore(filepath.Join(t.TempDir(), "tickets.db"))
+	require.NoError(t, err)
+	defer ts.Close()
+
+	for i := 0; i < 6; i++ {
+		_, err := ts.Add(&IssuedTicket{RequestHash: fmt.Sprint("hash", i),
+			ChallengeID: fmt.Sprint("challenge", i%2), BlobberID: "blobber",
+			Ticket: &ValidationTicket{Timestamp: common.Timestamp(100 + i)}})
+		require.NoError(t, err)
+	}
+	hashesOf := func(its []*IssuedTicket) (hashes []string) {
+		for _, it := range its {
+			hashes = append(hashes, it.RequestHash)
+		}
+		return
+	}
+
+	// the tickets issued before the time given
+	pruned, err := ts.Prune(102, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, pruned)
+	it, err := ts.Get("hash1")
+	require.NoError(t, err)
+	require.Nil(t, it)
+
+	// the oldest ones above the most kept
+	pruned, err = ts.Prune(0, 3)
+	require.NoError(t, err)
+	require.Equal(t, 1, pruned)
+	pruned, err = ts.Prune(0, 3)
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+
+	its, err := ts.ByBlobber("blobber", 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{"hash5", "hash4", "hash3"}, hashesOf(its))
+	its, err = ts.ByChallenge("challenge0")
+	require.NoError(t, err)
+	require.Equal(t, []string{"hash4"}, hashesOf(its))
+
+	// the ones issued after the pruning are kept
+	_, err = ts.Add(&IssuedTicket{RequestHash: "hash6", ChallengeID: "challenge0",
+		BlobberID: "blobber", Ticket: &ValidationTicket{Timestamp: 106}})
+	require.NoError(t, err)
+	pruned, err = ts.Prune(104, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, pruned)
+	its, err = ts.ByBlobber("blobber", 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{"hash6", "hash5", "hash4"}, hashesOf(its))
+}
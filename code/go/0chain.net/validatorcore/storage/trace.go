@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// TraceStep is a check done verifying a challenge, with the values it
+// compared.
+type TraceStep struct {
+	Check   string                 `json:"check"`
+	OK      bool                   `json:"ok"`
+	Details map[string]interface{} `json:"details,omitempty"`
+	Error   string                 `json:"error,omitempty"`
+}
+
+// Trace is the checks done verifying a challenge, in order, up to the
+// first one failed.
+type Trace struct {
+	ChallengeID string       `json:"challenge_id"`
+	OK          bool         `json:"ok"`
+	Error       string       `json:"error,omitempty"`
+	Steps       []*TraceStep `json:"steps"`
+}
+
+// add records the check of the outcome given. The details are only worked
+// out if tracing.
+func (t *Trace) add(check string, err error, details func() map[string]interface{}) {
+	if t == nil {
+		return
+	}
+	step := &TraceStep{Check: check, OK: err == nil}
+	if details != nil {
+		step.Details = details()
+	}
+	if err != nil {
+		step.Error = err.Error()
+	}
+	t.Steps = append(t.Steps, step)
+}
+
+// TraceChallenge verifies the challenge request the way ChallengeHandler
+// does, the chain objects given, and returns the checks done.
+func (cr *ChallengeRequest) TraceChallenge(challengeObj *Challenge,
+	allocationObj *Allocation) *Trace {
+
+	t := &Trace{ChallengeID: challengeObj.ID, Steps: make([]*TraceStep, 0)}
+	if cr.ObjPath == nil {
+		t.Error = "Empty object path or merkle path"
+		return t
+	}
+	if err := cr.verifyChallenge(challengeObj, allocationObj, t); err != nil {
+		t.Error = err.Error()
+		return t
+	}
+	t.OK = true
+	return t
+}
+
+// Print writes the checks of the trace one per line, followed by the
+// values they compared.
+func (t *Trace) Print(w io.Writer) {
+	for i, step := range t.Steps {
+		status := "ok"
+		if !step.OK {
+			status = "FAILED"
+		}
+		fmt.Fprintf(w, "%3d. [%s] %s\n", i+1, status, step.Check)
+		keys := make([]string, 0, len(step.Details))
+		for k := range step.Details {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(w, "       %s: %v\n", k, step.Details[k])
+		}
+		if step.Error != "" {
+			fmt.Fprintf(w, "       error: %s\n", step.Error)
+		}
+	}
+	if t.OK {
+		fmt.Fprintf(w, "challenge %s passed\n", t.ChallengeID)
+	} else {
+		fmt.Fprintf(w, "challenge %s failed: %s\n", t.ChallengeID, t.Error)
+	}
+}
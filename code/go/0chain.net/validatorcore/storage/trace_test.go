@@ -0,0 +1,133 @@
+package storage_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"0chain.net/core/common"
+	"0chain.net/core/config"
+	"0chain.net/core/encryption"
+	"0chain.net/core/logging"
+	"0chain.net/core/util"
+	"0chain.net/validatorcore/storage"
+	"0chain.net/validatorcore/storage/writemarker"
+
+	"github.com/0chain/gosdk/core/zcncrypto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newTraceRequest returns the request of a challenge on an allocation of a
+// file of a block, as a blobber would send it.
+func newTraceRequest(t *testing.T) (*storage.ChallengeRequest, *storage.Challenge,
+	*storage.Allocation) {
+
+	config.Configuration = config.Config{SignatureScheme: "bls0chain"}
+	sigSch := zcncrypto.NewSignatureScheme("bls0chain")
+	wallet, err := sigSch.GenerateKeys()
+	require.NoError(t, err)
+
+	const allocationID = "alloc"
+	block := []byte("the data of the block")
+	file := &storage.FileMetaData{
+		DirMetaData: storage.DirMetaData{Type: storage.FILE, Name: "a.txt",
+			Path: "/a.txt", NumBlocks: 1, AllocationID: allocationID},
+		Size:        int64(len(block)),
+		ContentHash: encryption.Hash(block),
+		MerkleRoot:  encryption.Hash(block),
+	}
+	file.Hash = file.CalculateHash()
+	rootHash := encryption.Hash(file.Hash)
+
+	wm := &writemarker.WriteMarker{
+		AllocationRoot: encryption.Hash(rootHash + ":" + strconv.FormatInt(1000, 10)),
+		AllocationID:   allocationID,
+		BlobberID:      "blobber",
+		ClientID:       wallet.ClientID,
+		Size:           file.Size,
+		Timestamp:      common.Timestamp(1000),
+	}
+	wm.Signature, err = sigSch.Sign(encryption.Hash(wm.GetHashData()))
+	require.NoError(t, err)
+
+	// decoded from JSON as the handler does
+	body, err := json.Marshal(map[string]interface{}{
+		"challenge_id": "challenge",
+		"object_path": map[string]interface{}{
+			"root_hash": rootHash,
+			"meta_data": file,
+			"path": map[string]interface{}{"type": storage.DIRECTORY,
+				"path": "/", "hash": rootHash, "num_of_blocks": 1,
+				"list": []interface{}{file}},
+			"file_block_num": 1,
+		},
+		"write_markers": []*writemarker.WriteMarkerEntity{{
+			ClientPublicKey: wallet.Keys[0].PublicKey, WM: wm}},
+		"data":        block,
+		"merkle_path": &util.MTPath{},
+	})
+	require.NoError(t, err)
+	cr := new(storage.ChallengeRequest)
+	require.NoError(t, json.Unmarshal(body, cr))
+
+	return cr, &storage.Challenge{ID: "challenge", RandomNumber: 42,
+			AllocationID: allocationID, AllocationRoot: wm.AllocationRoot},
+		&storage.Allocation{ID: allocationID}
+}
+
+func traceChecks(trace *storage.Trace) (checks []string) {
+	for _, step := range trace.Steps {
+		check := step.Check
+		if !step.OK {
+			check += " FAILED"
+		}
+		checks = append(checks, check)
+	}
+	return
+}
+
+func TestChallengeRequest_TraceChallenge(t *testing.T) {
+	logging.Logger = zap.NewNop()
+
+	cr, ch, alloc := newTraceRequest(t)
+	require.NoError(t, cr.VerifyChallenge(ch, alloc))
+	trace := cr.TraceChallenge(ch, alloc)
+	require.True(t, trace.OK, trace.Error)
+	require.Equal(t, []string{"file_hash", "dir_hash", "root_hash", "block_num",
+		"write_marker", "allocation_root", "merkle_path"}, traceChecks(trace))
+	require.EqualValues(t, 1, trace.Steps[3].Details["block_num"])
+	require.Equal(t, "/a.txt", trace.Steps[3].Details["block_file_path"])
+
+	var out bytes.Buffer
+	trace.Print(&out)
+	require.True(t, strings.HasSuffix(out.String(), "challenge challenge passed\n"))
+
+	// the checks stop at the one failed, as the verification does
+	cr, ch, alloc = newTraceRequest(t)
+	cr.DataBlock = []byte("other data")
+	require.Error(t, cr.VerifyChallenge(ch, alloc))
+	trace = cr.TraceChallenge(ch, alloc)
+	require.False(t, trace.OK)
+	require.Contains(t, trace.Error, "merkle path")
+	steps := traceChecks(trace)
+	require.Equal(t, "merkle_path FAILED", steps[len(steps)-1])
+
+	cr, ch, alloc = newTraceRequest(t)
+	ch.AllocationRoot = "other root"
+	trace = cr.TraceChallenge(ch, alloc)
+	require.False(t, trace.OK)
+	require.Equal(t, []string{"file_hash", "dir_hash", "root_hash", "block_num",
+		"write_marker FAILED"}, traceChecks(trace))
+	require.Equal(t, "other root", trace.Steps[4].Details["expected_allocation_root"])
+
+	cr, ch, alloc = newTraceRequest(t)
+	cr.ObjPath.Meta.Size++
+	cr.ObjPath.Path["list"].([]interface{})[0].(map[string]interface{})["size"] = 1
+	trace = cr.TraceChallenge(ch, alloc)
+	require.False(t, trace.OK)
+	require.Equal(t, []string{"file_hash FAILED", "object_path FAILED"}, traceChecks(trace))
+	require.Contains(t, trace.Steps[0].Details["hash_data"], ":1:")
+}
@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package validatorgrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// ValidatorClient is the client API for Validator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ValidatorClient interface {
+	ValidateChallenge(ctx context.Context, in *ValidateChallengeRequest, opts ...grpc.CallOption) (*ValidateChallengeResponse, error)
+	ValidateChallenges(ctx context.Context, in *ValidateChallengesRequest, opts ...grpc.CallOption) (*ValidateChallengesResponse, error)
+	GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error)
+}
+
+type validatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewValidatorClient(cc grpc.ClientConnInterface) ValidatorClient {
+	return &validatorClient{cc}
+}
+
+func (c *validatorClient) ValidateChallenge(ctx context.Context, in *ValidateChallengeRequest, opts ...grpc.CallOption) (*ValidateChallengeResponse, error) {
+	out := new(ValidateChallengeResponse)
+	err := c.cc.Invoke(ctx, "/validator.service.v1.Validator/ValidateChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorClient) ValidateChallenges(ctx context.Context, in *ValidateChallengesRequest, opts ...grpc.CallOption) (*ValidateChallengesResponse, error) {
+	out := new(ValidateChallengesResponse)
+	err := c.cc.Invoke(ctx, "/validator.service.v1.Validator/ValidateChallenges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorClient) GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error) {
+	out := new(GetStatusResponse)
+	err := c.cc.Invoke(ctx, "/validator.service.v1.Validator/GetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidatorServer is the server API for Validator service.
+// All implementations must embed UnimplementedValidatorServer
+// for forward compatibility
+type ValidatorServer interface {
+	ValidateChallenge(context.Context, *ValidateChallengeRequest) (*ValidateChallengeResponse, error)
+	ValidateChallenges(context.Context, *ValidateChallengesRequest) (*ValidateChallengesResponse, error)
+	GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error)
+	mustEmbedUnimplementedValidatorServer()
+}
+
+// UnimplementedValidatorServer must be embedded to have forward compatible implementations.
+type UnimplementedValidatorServer struct {
+}
+
+func (UnimplementedValidatorServer) ValidateChallenge(context.Context, *ValidateChallengeRequest) (*ValidateChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateChallenge not implemented")
+}
+func (UnimplementedValidatorServer) ValidateChallenges(context.Context, *ValidateChallengesRequest) (*ValidateChallengesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateChallenges not implemented")
+}
+func (UnimplementedValidatorServer) GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedValidatorServer) mustEmbedUnimplementedValidatorServer() {}
+
+// UnsafeValidatorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ValidatorServer will
+// result in compilation errors.
+type UnsafeValidatorServer interface {
+	mustEmbedUnimplementedValidatorServer()
+}
+
+func RegisterValidatorServer(s *grpc.Server, srv ValidatorServer) {
+	s.RegisterService(&_Validator_serviceDesc, srv)
+}
+
+func _Validator_ValidateChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServer).ValidateChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/validator.service.v1.Validator/ValidateChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServer).ValidateChallenge(ctx, req.(*ValidateChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Validator_ValidateChallenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateChallengesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServer).ValidateChallenges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/validator.service.v1.Validator/ValidateChallenges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServer).ValidateChallenges(ctx, req.(*ValidateChallengesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Validator_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/validator.service.v1.Validator/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServer).GetStatus(ctx, req.(*GetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Validator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "validator.service.v1.Validator",
+	HandlerType: (*ValidatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidateChallenge",
+			Handler:    _Validator_ValidateChallenge_Handler,
+		},
+		{
+			MethodName: "ValidateChallenges",
+			Handler:    _Validator_ValidateChallenges_Handler,
+		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _Validator_GetStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "validator.proto",
+}
@@ -0,0 +1,6 @@
+\connect blobber_meta;
+
+BEGIN;
+    ALTER TABLE collaborators ADD COLUMN role VARCHAR(16) NOT NULL DEFAULT 'writer';
+    CREATE INDEX idx_collaborators_ref_client ON collaborators (ref_id, client_id);
+COMMIT;
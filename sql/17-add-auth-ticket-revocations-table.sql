@@ -0,0 +1,17 @@
+\connect blobber_meta;
+
+
+CREATE TABLE auth_ticket_revocations (
+    id BIGSERIAL PRIMARY KEY,
+    allocation_id VARCHAR(64) NOT NULL,
+    signature_hash VARCHAR(64) NOT NULL DEFAULT '',
+    file_path_hash VARCHAR(64) NOT NULL DEFAULT '',
+    issued_before BIGINT NOT NULL DEFAULT 0,
+    expiration BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_auth_ticket_revocations_allocation ON auth_ticket_revocations (allocation_id);
+
+GRANT ALL PRIVILEGES ON ALL TABLES IN SCHEMA public TO blobber_user;
+GRANT ALL PRIVILEGES ON ALL SEQUENCES IN SCHEMA public TO blobber_user;
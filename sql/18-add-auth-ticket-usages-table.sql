@@ -0,0 +1,23 @@
+\connect blobber_meta;
+
+
+CREATE TABLE auth_ticket_usages (
+    signature_hash VARCHAR(64) PRIMARY KEY,
+    allocation_id VARCHAR(64) NOT NULL,
+    file_path_hash VARCHAR(64) NOT NULL,
+    client_id VARCHAR(64) NOT NULL DEFAULT '',
+    max_downloads BIGINT NOT NULL DEFAULT 0,
+    max_blocks BIGINT NOT NULL DEFAULT 0,
+    rate_limit BIGINT NOT NULL DEFAULT 0,
+    downloads BIGINT NOT NULL DEFAULT 0,
+    blocks BIGINT NOT NULL DEFAULT 0,
+    window_start BIGINT NOT NULL DEFAULT 0,
+    window_blocks BIGINT NOT NULL DEFAULT 0,
+    last_read_at BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_auth_ticket_usages_allocation ON auth_ticket_usages (allocation_id);
+
+GRANT ALL PRIVILEGES ON ALL TABLES IN SCHEMA public TO blobber_user;
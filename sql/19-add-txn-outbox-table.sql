@@ -0,0 +1,23 @@
+\connect blobber_meta;
+
+
+CREATE TABLE txn_outbox (
+    hash VARCHAR(64) PRIMARY KEY,
+    kind VARCHAR(64) NOT NULL,
+    ref_key VARCHAR(64) NOT NULL,
+    payload JSONB,
+    status INTEGER NOT NULL DEFAULT 0,
+    status_message TEXT,
+    output TEXT,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    submitted_at BIGINT NOT NULL,
+    next_check_at BIGINT NOT NULL,
+    confirmed_at BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_txn_outbox_due ON txn_outbox (status, next_check_at);
+CREATE INDEX idx_txn_outbox_ref ON txn_outbox (kind, ref_key, status);
+
+GRANT ALL PRIVILEGES ON ALL TABLES IN SCHEMA public TO blobber_user;
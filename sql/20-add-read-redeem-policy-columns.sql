@@ -0,0 +1,10 @@
+\connect blobber_meta;
+
+
+-- since when the reads of a client are waiting to be redeemed, 0 if none
+ALTER TABLE read_markers ADD COLUMN pending_since BIGINT NOT NULL DEFAULT 0;
+UPDATE read_markers SET pending_since = EXTRACT(EPOCH FROM updated_at)::BIGINT
+    WHERE redeem_required;
+
+-- value of the read markers of the allocation redeemed so far
+ALTER TABLE allocations ADD COLUMN read_redeemed BIGINT NOT NULL DEFAULT 0;
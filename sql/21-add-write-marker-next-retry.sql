@@ -0,0 +1,7 @@
+\connect blobber_meta;
+
+
+-- when a failed write marker redeem is retried
+ALTER TABLE write_markers ADD COLUMN next_retry_at BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_write_markers_status ON write_markers (status);
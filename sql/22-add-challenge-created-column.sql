@@ -0,0 +1,6 @@
+\connect blobber_meta;
+
+
+-- creation time of the challenge on chain, its deadline is
+-- challenge_completion_time later
+ALTER TABLE challenges ADD COLUMN created BIGINT NOT NULL DEFAULT 0;